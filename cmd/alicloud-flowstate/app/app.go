@@ -0,0 +1,335 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app
+
+import (
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"slices"
+	"strings"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/spf13/cobra"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/yaml"
+
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// NewFlowStateCommand creates a new command to inspect and convert the flow state of an Alicloud infrastructure.
+// All subcommands work offline on an `Infrastructure` manifest, e.g. the output of
+// `kubectl get infrastructure <name> -o yaml`, and never access the cloud.
+func NewFlowStateCommand() *cobra.Command {
+	var file string
+
+	cmd := &cobra.Command{
+		Use:           "alicloud-flowstate",
+		Short:         "Inspect and convert the flow state of an Alicloud infrastructure offline",
+		SilenceUsage:  true,
+		SilenceErrors: true,
+	}
+	cmd.PersistentFlags().StringVarP(&file, "file", "f", "-", "Infrastructure manifest in YAML or JSON, '-' reads from stdin")
+
+	cmd.AddCommand(
+		newShowCommand(&file),
+		newValidateCommand(&file),
+		newConvertCommand(&file),
+		newPatchCommand(&file),
+	)
+	return cmd
+}
+
+func newShowCommand(file *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "show",
+		Short: "Print the flow state as a tree of whiteboard children",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			infra, err := readInfrastructure(cmd.InOrStdin(), *file)
+			if err != nil {
+				return err
+			}
+			state, err := flowState(infra)
+			if err != nil {
+				return err
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "%s %s\n", state.Kind, state.APIVersion)
+			printTree(cmd.OutOrStdout(), state.ToFlatMap())
+			return nil
+		},
+	}
+}
+
+func newValidateCommand(file *string) *cobra.Command {
+	return &cobra.Command{
+		Use:   "validate",
+		Short: "Validate the version of the state and its zones against the infrastructure config",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			infra, err := readInfrastructure(cmd.InOrStdin(), *file)
+			if err != nil {
+				return err
+			}
+			if infra.Status.State == nil || len(infra.Status.State.Raw) == 0 {
+				return fmt.Errorf("infrastructure has no state")
+			}
+			state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+			if err != nil {
+				return fmt.Errorf("invalid flow state: %w", err)
+			}
+			if state == nil {
+				fmt.Fprintln(cmd.OutOrStdout(), "state is a Terraformer state, run 'convert' to convert it into a flow state")
+				return nil
+			}
+
+			config, err := infrastructureConfig(infra)
+			if err != nil {
+				return err
+			}
+			problems := validateZones(state.ToFlatMap(), config.Networks.Zones)
+			for _, problem := range problems {
+				fmt.Fprintln(cmd.OutOrStdout(), problem)
+			}
+			if len(problems) > 0 {
+				return fmt.Errorf("flow state has %d problem(s)", len(problems))
+			}
+			fmt.Fprintf(cmd.OutOrStdout(), "flow state %s is valid\n", state.APIVersion)
+			return nil
+		},
+	}
+}
+
+func newConvertCommand(file *string) *cobra.Command {
+	var asPatch bool
+
+	cmd := &cobra.Command{
+		Use:   "convert",
+		Short: "Convert a Terraformer raw state into a flow state",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			infra, err := readInfrastructure(cmd.InOrStdin(), *file)
+			if err != nil {
+				return err
+			}
+			if infra.Status.State != nil && len(infra.Status.State.Raw) > 0 {
+				state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+				if err != nil {
+					return fmt.Errorf("invalid state: %w", err)
+				}
+				if state != nil {
+					return fmt.Errorf("state is already a flow state")
+				}
+			}
+			config, err := infrastructureConfig(infra)
+			if err != nil {
+				return err
+			}
+			state, err := infrastructure.MigrateTerraformStateToFlowState(infra.Status.State, config.Networks.Zones)
+			if err != nil {
+				return err
+			}
+			if asPatch {
+				return writePatch(cmd.OutOrStdout(), state, nil)
+			}
+			return writeJSON(cmd.OutOrStdout(), state)
+		},
+	}
+	cmd.Flags().BoolVar(&asPatch, "patch", false, "Print a merge patch for the status of the infrastructure instead of the flow state")
+	return cmd
+}
+
+func newPatchCommand(file *string) *cobra.Command {
+	var (
+		set    []string
+		remove []string
+	)
+
+	cmd := &cobra.Command{
+		Use:   "patch",
+		Short: "Print a merge patch for the status of the infrastructure fixing individual entries of the flow state",
+		Long: "Print a merge patch for the status of the infrastructure fixing individual entries of the flow state.\n" +
+			"Keys are path-like, e.g. 'Zones/cn-hangzhou-i/VSwitch'. Apply the patch with\n" +
+			"'kubectl patch infrastructure <name> --subresource=status --type=merge --patch-file <file>'.",
+		RunE: func(cmd *cobra.Command, _ []string) error {
+			infra, err := readInfrastructure(cmd.InOrStdin(), *file)
+			if err != nil {
+				return err
+			}
+			state, err := flowState(infra)
+			if err != nil {
+				return err
+			}
+			for _, entry := range set {
+				key, value, ok := strings.Cut(entry, "=")
+				if !ok || key == "" || value == "" {
+					return fmt.Errorf("invalid entry %q, expected <key>=<value>", entry)
+				}
+				state.Data[key] = value
+			}
+			for _, key := range remove {
+				if _, ok := state.Data[key]; !ok {
+					return fmt.Errorf("key %q not found in flow state", key)
+				}
+				delete(state.Data, key)
+			}
+			return writePatch(cmd.OutOrStdout(), state, remove)
+		},
+	}
+	cmd.Flags().StringArrayVar(&set, "set", nil, "Set an entry of the flow state, as <key>=<value>")
+	cmd.Flags().StringArrayVar(&remove, "delete", nil, "Delete an entry of the flow state by its key")
+	return cmd
+}
+
+func readInfrastructure(stdin io.Reader, file string) (*extensionsv1alpha1.Infrastructure, error) {
+	var (
+		data []byte
+		err  error
+	)
+	if file == "-" {
+		data, err = io.ReadAll(stdin)
+	} else {
+		data, err = os.ReadFile(file) // #nosec G304 -- the file is given by the operator
+	}
+	if err != nil {
+		return nil, err
+	}
+	data, err = yaml.ToJSON(data)
+	if err != nil {
+		return nil, err
+	}
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := json.Unmarshal(data, infra); err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure: %w", err)
+	}
+	if infra.Kind != extensionsv1alpha1.InfrastructureResource {
+		return nil, fmt.Errorf("expected kind %s, got %q", extensionsv1alpha1.InfrastructureResource, infra.Kind)
+	}
+	return infra, nil
+}
+
+func flowState(infra *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
+	if infra.Status.State == nil || len(infra.Status.State.Raw) == 0 {
+		return nil, fmt.Errorf("infrastructure has no state")
+	}
+	state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+	if err != nil {
+		return nil, err
+	}
+	if state == nil {
+		return nil, fmt.Errorf("state is a Terraformer state, run 'convert' to convert it into a flow state")
+	}
+	return state, nil
+}
+
+func infrastructureConfig(infra *extensionsv1alpha1.Infrastructure) (*aliapi.InfrastructureConfig, error) {
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, fmt.Errorf("could not decode infrastructure config: %w", err)
+	}
+	return config, nil
+}
+
+// validateZones returns the zone children of the flow state which are not configured and the configured zones
+// without suffix, which would be recreated by the next reconciliation.
+func validateZones(data shared.FlatMap, zones []aliapi.Zone) []string {
+	configured := sets.New[string]()
+	for _, zone := range zones {
+		configured.Insert(zone.Name)
+	}
+	inState := sets.New[string]()
+	prefix := infraflow.ChildIdZones + shared.Separator
+	for key := range data {
+		if rest, ok := strings.CutPrefix(key, prefix); ok {
+			zoneName, _, _ := strings.Cut(rest, shared.Separator)
+			inState.Insert(zoneName)
+		}
+	}
+
+	var problems []string
+	for _, zoneName := range sets.List(inState.Difference(configured)) {
+		problems = append(problems, fmt.Sprintf("zone %s is in the flow state, but not in the infrastructure config", zoneName))
+	}
+	for _, zoneName := range sets.List(configured) {
+		if !shared.IsValidValue(data[prefix+zoneName+shared.Separator+infraflow.IdentifierZoneSuffix]) {
+			problems = append(problems, fmt.Sprintf("zone %s has no suffix in the flow state", zoneName))
+		}
+	}
+	return problems
+}
+
+// printTree prints the flat map as tree of whiteboard children. Deleted entries are kept to show what the flow
+// has cleaned up.
+func printTree(w io.Writer, data shared.FlatMap) {
+	keys := make([]string, 0, len(data))
+	for key := range data {
+		keys = append(keys, key)
+	}
+	// print the values of a level before its children
+	slices.SortFunc(keys, func(a, b string) int {
+		partsA, partsB := strings.Split(a, shared.Separator), strings.Split(b, shared.Separator)
+		for i := 0; i < len(partsA) && i < len(partsB); i++ {
+			lastA, lastB := i == len(partsA)-1, i == len(partsB)-1
+			if lastA != lastB {
+				if lastA {
+					return -1
+				}
+				return 1
+			}
+			if c := strings.Compare(partsA[i], partsB[i]); c != 0 {
+				return c
+			}
+		}
+		return len(partsA) - len(partsB)
+	})
+
+	var printed []string
+	for _, key := range keys {
+		parts := strings.Split(key, shared.Separator)
+		children := parts[:len(parts)-1]
+		common := 0
+		for common < len(children) && common < len(printed) && children[common] == printed[common] {
+			common++
+		}
+		for i := common; i < len(children); i++ {
+			fmt.Fprintf(w, "%s%s/\n", strings.Repeat("  ", i+1), children[i])
+		}
+		printed = children
+		fmt.Fprintf(w, "%s%s: %s\n", strings.Repeat("  ", len(parts)), parts[len(parts)-1], data[key])
+	}
+}
+
+// writePatch writes a merge patch for the status of the infrastructure replacing its state by the given flow state.
+// A merge patch keeps all keys missing in the patch, so the deleted keys of the flow state are explicitly set to null.
+func writePatch(w io.Writer, state *infraflow.PersistentState, deleted []string) error {
+	data := make(map[string]any, len(state.Data)+len(deleted))
+	for key, value := range state.Data {
+		data[key] = value
+	}
+	for _, key := range deleted {
+		data[key] = nil
+	}
+	return writeJSON(w, map[string]any{
+		"status": map[string]any{
+			"state": map[string]any{
+				"apiVersion": state.APIVersion,
+				"kind":       state.Kind,
+				"data":       data,
+				// the encoding of a Terraformer state
+				"encoding": nil,
+			},
+		},
+	})
+}
+
+func writeJSON(w io.Writer, out any) error {
+	// keep the marker of deleted entries readable
+	encoder := json.NewEncoder(w)
+	encoder.SetEscapeHTML(false)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(out)
+}
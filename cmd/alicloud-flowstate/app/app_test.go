@@ -0,0 +1,219 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package app_test
+
+import (
+	"bytes"
+	"encoding/json"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	. "github.com/gardener/gardener-extension-provider-alicloud/cmd/alicloud-flowstate/app"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+)
+
+var _ = Describe("alicloud-flowstate", func() {
+	var (
+		state  []byte
+		config *v1alpha1.InfrastructureConfig
+	)
+
+	encode := func(obj any) []byte {
+		data, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		return data
+	}
+
+	run := func(args ...string) (string, error) {
+		infra := &extensionsv1alpha1.Infrastructure{
+			TypeMeta:   metav1.TypeMeta{APIVersion: extensionsv1alpha1.SchemeGroupVersion.String(), Kind: extensionsv1alpha1.InfrastructureResource},
+			ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "shoot--foo--bar"},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           "alicloud",
+					ProviderConfig: &runtime.RawExtension{Raw: encode(config)},
+				},
+			},
+		}
+		if state != nil {
+			infra.Status.State = &runtime.RawExtension{Raw: state}
+		}
+
+		out := &bytes.Buffer{}
+		cmd := NewFlowStateCommand()
+		cmd.SetIn(bytes.NewReader(encode(infra)))
+		cmd.SetOut(out)
+		cmd.SetArgs(args)
+		err := cmd.Execute()
+		return out.String(), err
+	}
+
+	BeforeEach(func() {
+		config = &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.Networks{
+				Zones: []v1alpha1.Zone{
+					{Name: "cn-beijing-a", Workers: "10.0.0.0/19"},
+					{Name: "cn-beijing-b", Workers: "10.0.32.0/19"},
+				},
+			},
+		}
+		state = []byte(`{
+  "apiVersion": "alicloud.provider.extensions.gardener.cloud/v1alpha1",
+  "kind": "FlowState",
+  "data": {
+    "VPC": "vpc-1",
+    "Zones/cn-beijing-b/VSwitch": "vsw-b",
+    "Zones/cn-beijing-b/Suffix": "z1",
+    "NatGateway": "ngw-1",
+    "Zones/cn-beijing-a/VSwitch": "vsw-a",
+    "Zones/cn-beijing-a/Suffix": "z0",
+    "Zones/cn-beijing-a/NATGatewayElasticIP": "<deleted>"
+  }
+}`)
+	})
+
+	Describe("show", func() {
+		It("should print the values of a level before its children", func() {
+			out, err := run("show")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(`FlowState alicloud.provider.extensions.gardener.cloud/v1alpha1
+  NatGateway: ngw-1
+  VPC: vpc-1
+  Zones/
+    cn-beijing-a/
+      NATGatewayElasticIP: <deleted>
+      Suffix: z0
+      VSwitch: vsw-a
+    cn-beijing-b/
+      Suffix: z1
+      VSwitch: vsw-b
+`))
+		})
+	})
+
+	Describe("validate", func() {
+		It("should accept a state matching the zones", func() {
+			out, err := run("validate")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal("flow state alicloud.provider.extensions.gardener.cloud/v1alpha1 is valid\n"))
+		})
+
+		It("should report unknown zones and zones without suffix", func() {
+			config.Networks.Zones = []v1alpha1.Zone{
+				{Name: "cn-beijing-a", Workers: "10.0.0.0/19"},
+				{Name: "cn-beijing-c", Workers: "10.0.64.0/19"},
+			}
+
+			out, err := run("validate")
+			Expect(err).To(MatchError("flow state has 2 problem(s)"))
+			Expect(out).To(Equal("zone cn-beijing-b is in the flow state, but not in the infrastructure config\n" +
+				"zone cn-beijing-c has no suffix in the flow state\n"))
+		})
+
+		It("should fail for an unsupported state", func() {
+			state = []byte(`{"apiVersion": "foo/v1", "kind": "FlowState"}`)
+
+			_, err := run("validate")
+			Expect(err).To(MatchError(ContainSubstring("invalid flow state: unknown kind or group")))
+		})
+	})
+
+	Describe("convert", func() {
+		BeforeEach(func() {
+			tfState := `{
+  "version": 4,
+  "outputs": {
+    "vpc_id": {"value": "vpc-1", "type": "string"},
+    "sg_id": {"value": "sg-1", "type": "string"}
+  },
+  "resources": [
+    {"mode": "managed", "type": "alicloud_nat_gateway", "name": "nat_gateway", "instances": [{"attributes": {"id": "ngw-1"}}]},
+    {"mode": "managed", "type": "alicloud_vswitch", "name": "vsw_z0", "instances": [{"attributes": {"id": "vsw-a"}}]},
+    {"mode": "managed", "type": "alicloud_eip", "name": "eip_natgw_z0", "instances": [{"attributes": {"id": "eip-a"}}]},
+    {"mode": "managed", "type": "alicloud_vswitch", "name": "vsw_z1", "instances": [{"attributes": {"id": "vsw-b"}}]}
+  ]
+}`
+			state = encode(map[string]string{"data": tfState, "encoding": "none"})
+		})
+
+		It("should convert a Terraformer state into a flow state", func() {
+			out, err := run("convert")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(`{
+  "kind": "FlowState",
+  "apiVersion": "alicloud.provider.extensions.gardener.cloud/v1alpha1",
+  "data": {
+    "MigratedFromTerraform": "true",
+    "NatGateway": "ngw-1",
+    "NodesSecurityGroup": "sg-1",
+    "VPC": "vpc-1",
+    "Zones/cn-beijing-a/NATGatewayElasticIP": "eip-a",
+    "Zones/cn-beijing-a/Suffix": "z0",
+    "Zones/cn-beijing-a/VSwitch": "vsw-a",
+    "Zones/cn-beijing-b/Suffix": "z1",
+    "Zones/cn-beijing-b/VSwitch": "vsw-b"
+  }
+}
+`))
+		})
+
+		It("should print a patch dropping the encoding of the Terraformer state", func() {
+			out, err := run("convert", "--patch")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(ContainSubstring(`"encoding": null`))
+			Expect(out).To(ContainSubstring(`"Zones/cn-beijing-b/VSwitch": "vsw-b"`))
+		})
+
+		It("should refuse to convert a flow state", func() {
+			state = []byte(`{"apiVersion": "alicloud.provider.extensions.gardener.cloud/v1alpha1", "kind": "FlowState"}`)
+
+			_, err := run("convert")
+			Expect(err).To(MatchError("state is already a flow state"))
+		})
+
+		It("should return the error for an invalid state", func() {
+			state = []byte(`{"apiVersion": "alicloud.provider.extensions.gardener.cloud/v0", "kind": "FlowState"}`)
+
+			_, err := run("convert")
+			Expect(err).To(MatchError(ContainSubstring("invalid state: ")))
+		})
+	})
+
+	Describe("patch", func() {
+		It("should set entries and delete entries with null", func() {
+			out, err := run("patch", "--set", "Zones/cn-beijing-b/VSwitch=vsw-c", "--delete", "Zones/cn-beijing-a/NATGatewayElasticIP")
+			Expect(err).NotTo(HaveOccurred())
+			Expect(out).To(Equal(`{
+  "status": {
+    "state": {
+      "apiVersion": "alicloud.provider.extensions.gardener.cloud/v1alpha1",
+      "data": {
+        "NatGateway": "ngw-1",
+        "VPC": "vpc-1",
+        "Zones/cn-beijing-a/NATGatewayElasticIP": null,
+        "Zones/cn-beijing-a/Suffix": "z0",
+        "Zones/cn-beijing-a/VSwitch": "vsw-a",
+        "Zones/cn-beijing-b/Suffix": "z1",
+        "Zones/cn-beijing-b/VSwitch": "vsw-c"
+      },
+      "encoding": null,
+      "kind": "FlowState"
+    }
+  }
+}
+`))
+		})
+
+		It("should fail for unknown keys", func() {
+			_, err := run("patch", "--delete", "Zones/cn-beijing-c/VSwitch")
+			Expect(err).To(MatchError(`key "Zones/cn-beijing-c/VSwitch" not found in flow state`))
+		})
+	})
+})
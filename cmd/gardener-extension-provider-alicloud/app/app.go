@@ -104,8 +104,10 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 		}
 
 		// options for the infrastructure controller
-		infraCtrlOpts = &controllercmd.ControllerOptions{
-			MaxConcurrentReconciles: 5,
+		infraCtrlOpts = &alicloudcmd.InfrastructureControllerOptions{
+			ControllerOptions: controllercmd.ControllerOptions{
+				MaxConcurrentReconciles: 5,
+			},
 		}
 		reconcileOpts = &controllercmd.ReconcilerOptions{}
 
@@ -220,6 +222,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			configFileOpts.Completed().ApplyToBeSharedImageIDs(&alicloudinfrastructure.DefaultAddOptions.ToBeSharedImageIDs)
 			configFileOpts.Completed().ApplyETCDStorage(&alicloudseedprovider.DefaultAddOptions.ETCDStorage)
 			configFileOpts.Completed().ApplyService(&shoot.DefaultAddOptions.Service)
+			configFileOpts.Completed().ApplyService(&alicloudcontrolplane.DefaultAddOptions.Service)
 			configFileOpts.Completed().ApplyHealthCheckConfig(&healthcheck.DefaultAddOptions.HealthCheckConfig)
 			configFileOpts.Completed().ApplyCSI(&alicloudcontrolplane.DefaultAddOptions.CSI)
 			healthCheckCtrlOpts.Completed().Apply(&healthcheck.DefaultAddOptions.Controller)
@@ -230,7 +233,7 @@ func NewControllerManagerCommand(ctx context.Context) *cobra.Command {
 			controlPlaneCtrlOpts.Completed().Apply(&alicloudcontrolplane.DefaultAddOptions.Controller)
 			dnsRecordCtrlOpts.Completed().Apply(&aliclouddnsrecord.DefaultAddOptions.Controller)
 			dnsRecordCtrlOpts.Completed().ApplyRateLimiter(&aliclouddnsrecord.DefaultAddOptions.RateLimiter)
-			infraCtrlOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions.Controller)
+			infraCtrlOpts.Completed().Apply(&alicloudinfrastructure.DefaultAddOptions)
 			applyReconcileOptions(reconcileOpts)
 			applyGeneralOptions(generalOpts)
 			workerCtrlOpts.Completed().Apply(&alicloudworker.DefaultAddOptions.Controller)
@@ -88,8 +88,14 @@ func (s *shootMutator) Mutate(ctx context.Context, newObj, oldObj client.Object)
 		if !ok {
 			return fmt.Errorf("wrong object type %T for old object", oldObj)
 		}
+		if err := s.mutateZoneCIDRs(ctx, shoot, oldShoot); err != nil {
+			return err
+		}
 		return s.mutateShootUpdate(ctx, shoot, oldShoot)
 	} else {
+		if err := s.mutateZoneCIDRs(ctx, shoot, nil); err != nil {
+			return err
+		}
 		return s.mutateShootCreation(ctx, shoot)
 	}
 }
@@ -240,8 +246,27 @@ func (s *shootMutator) isCustomizedImage(ctx context.Context, shoot *corev1beta1
 }
 
 func (s *shootMutator) isOwnedByAliCloud(ctx context.Context, shoot *corev1beta1.Shoot, imageId string, region string) (bool, error) {
+	credentials, err := s.getCredentials(ctx, shoot)
+	if err != nil {
+		return false, err
+	}
+	shootECSClient, err := s.alicloudClientFactory.NewECSClient(region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return false, err
+	}
+	if exist, err := shootECSClient.CheckIfImageExists(imageId); err != nil {
+		return false, err
+	} else if exist {
+		return shootECSClient.CheckIfImageOwnedByAliCloud(imageId)
+	}
+	return false, nil
+}
+
+// getCredentials reads the Alicloud credentials of the shoot from the secret referenced by its SecretBinding or
+// CredentialsBinding.
+func (s *shootMutator) getCredentials(ctx context.Context, shoot *corev1beta1.Shoot) (*alicloud.Credentials, error) {
 	if shoot.Spec.SecretBindingName == nil && shoot.Spec.CredentialsBindingName == nil {
-		return false, fmt.Errorf("secretBindingName and credentialsBindingName cannot be both nil")
+		return nil, fmt.Errorf("secretBindingName and credentialsBindingName cannot be both nil")
 	}
 
 	var secretKey client.ObjectKey
@@ -249,40 +274,31 @@ func (s *shootMutator) isOwnedByAliCloud(ctx context.Context, shoot *corev1beta1
 		bindingKey := client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.SecretBindingName}
 		secretBinding := &corev1beta1.SecretBinding{}
 		if err := kutil.LookupObject(ctx, s.client, s.apiReader, bindingKey, secretBinding); err != nil {
-			return false, err
+			return nil, err
 		}
 		secretKey = client.ObjectKey{Namespace: secretBinding.SecretRef.Namespace, Name: secretBinding.SecretRef.Name}
 	} else {
 		bindingKey := client.ObjectKey{Namespace: shoot.Namespace, Name: *shoot.Spec.CredentialsBindingName}
 		credentialsBinding := &securityv1alpha1.CredentialsBinding{}
 		if err := kutil.LookupObject(ctx, s.client, s.apiReader, bindingKey, credentialsBinding); err != nil {
-			return false, err
+			return nil, err
 		}
 		secretKey = client.ObjectKey{Namespace: credentialsBinding.CredentialsRef.Namespace, Name: credentialsBinding.CredentialsRef.Name}
 	}
 
 	secret := &corev1.Secret{}
 	if err := s.apiReader.Get(ctx, secretKey, secret); err != nil {
-		return false, err
+		return nil, err
 	}
 	accessKeyID, ok := secret.Data[alicloud.AccessKeyID]
 	if !ok {
-		return false, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeyID, secret.Name)
+		return nil, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeyID, secret.Name)
 	}
 	accessKeySecret, ok := secret.Data[alicloud.AccessKeySecret]
 	if !ok {
-		return false, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeySecret, secret.Name)
-	}
-	shootECSClient, err := s.alicloudClientFactory.NewECSClient(region, string(accessKeyID), string(accessKeySecret))
-	if err != nil {
-		return false, err
+		return nil, fmt.Errorf("missing %q field in secret %s", alicloud.AccessKeySecret, secret.Name)
 	}
-	if exist, err := shootECSClient.CheckIfImageExists(imageId); err != nil {
-		return false, err
-	} else if exist {
-		return shootECSClient.CheckIfImageOwnedByAliCloud(imageId)
-	}
-	return false, nil
+	return &alicloud.Credentials{AccessKeyID: string(accessKeyID), AccessKeySecret: string(accessKeySecret)}, nil
 }
 
 func (s *shootMutator) getImageId(_ context.Context, imageName string, imageVersion *string, imageRegion string, cloudProfileSpec *corev1beta1.CloudProfile, worker *corev1beta1.Worker) (string, error) {
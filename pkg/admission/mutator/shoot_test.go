@@ -9,6 +9,7 @@ import (
 	encodingjson "encoding/json"
 	"time"
 
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/gardener/gardener/extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
@@ -482,6 +483,203 @@ var _ = Describe("Mutating Shoot", func() {
 
 	})
 
+	Context("#Zone CIDRs", func() {
+		var (
+			infraConfig *apisalicloudv1alpha1.InfrastructureConfig
+			vpcClient   *mockalicloudclient.MockVPC
+		)
+
+		encodeInfraConfig := func(config *apisalicloudv1alpha1.InfrastructureConfig) *runtime.RawExtension {
+			config.TypeMeta = metav1.TypeMeta{APIVersion: apisalicloudv1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"}
+			return &runtime.RawExtension{Raw: expectEncode(runtime.Encode(serializer, config))}
+		}
+
+		decodeZones := func(shoot *corev1beta1.Shoot) []apisalicloudv1alpha1.Zone {
+			config := &apisalicloudv1alpha1.InfrastructureConfig{}
+			_, _, err := serializer.Decode(shoot.Spec.Provider.InfrastructureConfig.Raw, nil, config)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(config.Kind).To(Equal("InfrastructureConfig"))
+			return config.Networks.Zones
+		}
+
+		describeVSwitch := func(id, cidr string) func(*vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+			return func(request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+				Expect(request.VSwitchId).To(Equal(id))
+				response := vpc.CreateDescribeVSwitchesResponse()
+				response.VSwitches.VSwitch = []vpc.VSwitch{{VSwitchId: id, CidrBlock: cidr}}
+				return response, nil
+			}
+		}
+
+		BeforeEach(func() {
+			vpcClient = mockalicloudclient.NewMockVPC(ctrl)
+			newShoot.Spec.Networking.Pods = ptr.To("100.96.0.0/11")
+			newShoot.Spec.Networking.Services = ptr.To("100.64.0.0/13")
+			infraConfig = &apisalicloudv1alpha1.InfrastructureConfig{
+				Networks: apisalicloudv1alpha1.Networks{
+					VPC: apisalicloudv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []apisalicloudv1alpha1.Zone{
+						{Name: "cn-shanghai-a", Workers: "10.250.0.0/24"},
+						{Name: "cn-shanghai-b"},
+						{Name: "cn-shanghai-c"},
+					},
+				},
+			}
+			oldShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(&apisalicloudv1alpha1.InfrastructureConfig{
+				Networks: apisalicloudv1alpha1.Networks{
+					VPC:   apisalicloudv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []apisalicloudv1alpha1.Zone{{Name: "cn-shanghai-a", Workers: "10.250.0.0/24"}},
+				},
+			})
+		})
+
+		It("should allocate non-overlapping CIDRs for zones without workers CIDR", func() {
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[0].Workers).To(Equal("10.250.0.0/24"))
+			Expect(zones[1].Workers).To(Equal("10.250.1.0/24"))
+			Expect(zones[2].Workers).To(Equal("10.250.2.0/24"))
+		})
+
+		It("should allocate CIDRs of the configured prefix length", func() {
+			infraConfig.Networks.WorkersPrefixLength = ptr.To[int32](20)
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.16.0/20"))
+			Expect(zones[2].Workers).To(Equal("10.250.32.0/20"))
+		})
+
+		It("should keep the CIDRs allocated before", func() {
+			oldShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(&apisalicloudv1alpha1.InfrastructureConfig{
+				Networks: apisalicloudv1alpha1.Networks{
+					VPC: apisalicloudv1alpha1.VPC{CIDR: ptr.To("10.250.0.0/16")},
+					Zones: []apisalicloudv1alpha1.Zone{
+						{Name: "cn-shanghai-a", Workers: "10.250.0.0/24"},
+						{Name: "cn-shanghai-b", Workers: "10.250.5.0/24"},
+					},
+				},
+			})
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.5.0/24"))
+			Expect(zones[2].Workers).To(Equal("10.250.1.0/24"))
+		})
+
+		It("should not overlap with the vswitches of an existing VPC", func() {
+			newShoot.Spec.Networking.Nodes = nil
+			infraConfig.Networks.VPC = apisalicloudv1alpha1.VPC{ID: ptr.To("vpc-123")}
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1beta1.SecretBinding{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1beta1.SecretBinding, _ ...client.GetOption) error {
+						*obj = *secretBinding
+						return nil
+					},
+				),
+				apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						*obj = *secret
+						return nil
+					},
+				),
+				alicloudClientFactory.EXPECT().NewVPCClient(regionId, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+				vpcClient.EXPECT().GetVPCWithID(ctx, "vpc-123").Return([]vpc.Vpc{{VpcId: "vpc-123", CidrBlock: "10.250.0.0/16"}}, nil),
+				vpcClient.EXPECT().DescribeVSwitches(gomock.Any()).DoAndReturn(func(request *vpc.DescribeVSwitchesRequest) (*vpc.DescribeVSwitchesResponse, error) {
+					Expect(request.VpcId).To(Equal("vpc-123"))
+					response := vpc.CreateDescribeVSwitchesResponse()
+					response.TotalCount = 2
+					response.VSwitches.VSwitch = []vpc.VSwitch{
+						{VSwitchId: "vsw-1", CidrBlock: "10.250.1.0/24"},
+						{VSwitchId: "vsw-2", CidrBlock: "10.250.2.128/25"},
+					}
+					return response, nil
+				}),
+			)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.3.0/24"))
+			Expect(zones[2].Workers).To(Equal("10.250.4.0/24"))
+		})
+
+		It("should allocate CIDRs from the secondary VPC CIDRs", func() {
+			newShoot.Spec.Networking.Nodes = nil
+			infraConfig.Networks.VPC.CIDR = ptr.To("10.250.0.0/23")
+			infraConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.1.0/24"))
+			Expect(zones[2].Workers).To(Equal("172.16.0.0/24"))
+		})
+
+		It("should allocate CIDRs from the secondary VPC CIDRs if the nodes CIDR is exhausted", func() {
+			newShoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/23")
+			infraConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.1.0/24"))
+			Expect(zones[2].Workers).To(Equal("172.16.0.0/24"))
+		})
+
+		It("should use the CIDRs of existing vswitches", func() {
+			infraConfig.Networks.VPC = apisalicloudv1alpha1.VPC{ID: ptr.To("vpc-123")}
+			infraConfig.Networks.Zones[1].VSwitchID = ptr.To("vsw-1")
+			infraConfig.Networks.Zones[2].VSwitchID = ptr.To("vsw-2")
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			gomock.InOrder(
+				c.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1beta1.SecretBinding{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1beta1.SecretBinding, _ ...client.GetOption) error {
+						*obj = *secretBinding
+						return nil
+					},
+				),
+				apiReader.EXPECT().Get(ctx, client.ObjectKey{Namespace: namespace, Name: name}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(
+					func(_ context.Context, _ client.ObjectKey, obj *corev1.Secret, _ ...client.GetOption) error {
+						*obj = *secret
+						return nil
+					},
+				),
+				alicloudClientFactory.EXPECT().NewVPCClient(regionId, accessKeyID, accessKeySecret).Return(vpcClient, nil),
+				vpcClient.EXPECT().DescribeVSwitches(gomock.Any()).DoAndReturn(describeVSwitch("vsw-1", "10.250.16.0/20")),
+				vpcClient.EXPECT().DescribeVSwitches(gomock.Any()).DoAndReturn(describeVSwitch("vsw-2", "10.250.32.0/20")),
+			)
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			zones := decodeZones(newShoot)
+			Expect(zones[1].Workers).To(Equal("10.250.16.0/20"))
+			Expect(zones[2].Workers).To(Equal("10.250.32.0/20"))
+		})
+
+		It("should fail if the pool is exhausted", func() {
+			newShoot.Spec.Networking.Nodes = ptr.To("10.250.0.0/23")
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+
+			err := mutator.Mutate(ctx, newShoot, oldShoot)
+			Expect(err).To(MatchError(ContainSubstring(`cannot allocate worker CIDR for zone "cn-shanghai-c": no free /24 subnet left in 10.250.0.0/23`)))
+		})
+
+		It("should not touch the infrastructure config if all zones specify a CIDR", func() {
+			infraConfig.Networks.Zones = infraConfig.Networks.Zones[:1]
+			newShoot.Spec.Provider.InfrastructureConfig = encodeInfraConfig(infraConfig)
+			expected := newShoot.Spec.Provider.InfrastructureConfig.DeepCopy()
+
+			Expect(mutator.Mutate(ctx, newShoot, oldShoot)).To(Succeed())
+			Expect(newShoot.Spec.Provider.InfrastructureConfig).To(Equal(expected))
+		})
+	})
+
 	Context("Workerless Shoot", func() {
 		BeforeEach(func() {
 			newShoot.Spec.Provider.Workers = nil
@@ -0,0 +1,323 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package mutator
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/netip"
+	"slices"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	apisalicloudv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+)
+
+// DefaultWorkersPrefixLength is the prefix length of the worker CIDRs allocated for zones without a worker CIDR
+// if `networks.workersPrefixLength` is not set in the InfrastructureConfig.
+const DefaultWorkersPrefixLength int32 = 24
+
+// mutateZoneCIDRs allocates worker CIDRs for all zones of the InfrastructureConfig which do not specify one.
+// CIDRs of zones which have already been allocated are kept from the old shoot, so that they are stable. New CIDRs are
+// allocated from the nodes CIDR and the secondary CIDRs of the VPC, or the VPC CIDR and its secondary CIDRs if the
+// nodes CIDR is not set, and do not overlap with the CIDRs of the other zones, the pods and services CIDRs and the
+// vswitches already existing in a shared VPC. Zones using an existing vswitch get the CIDR of the vswitch.
+func (s *shootMutator) mutateZoneCIDRs(ctx context.Context, shoot, oldShoot *corev1beta1.Shoot) error {
+	if shoot.DeletionTimestamp != nil || shoot.Spec.Provider.InfrastructureConfig == nil || isShootInMigrationOrRestorePhase(shoot) {
+		return nil
+	}
+
+	infraConfig, err := s.decodeInfrastructureConfig(shoot.Spec.Provider.InfrastructureConfig)
+	if err != nil {
+		return err
+	}
+
+	var oldZones []apisalicloudv1alpha1.Zone
+	if oldShoot != nil && oldShoot.Spec.Provider.InfrastructureConfig != nil {
+		oldInfraConfig, err := s.decodeInfrastructureConfig(oldShoot.Spec.Provider.InfrastructureConfig)
+		if err != nil {
+			return err
+		}
+		oldZones = oldInfraConfig.Networks.Zones
+	}
+
+	var (
+		missing  []int
+		existing []int
+		changed  bool
+	)
+	for i := range infraConfig.Networks.Zones {
+		zone := &infraConfig.Networks.Zones[i]
+		if zone.Workers != "" || zone.Worker != "" {
+			continue
+		}
+		// zones can neither be removed nor reordered, keep the CIDR allocated before
+		if i < len(oldZones) && oldZones[i].Name == zone.Name && (oldZones[i].Workers != "" || oldZones[i].Worker != "") {
+			zone.Workers = oldZones[i].Workers
+			zone.Worker = oldZones[i].Worker
+			changed = true
+			continue
+		}
+		if zone.VSwitchID != nil && *zone.VSwitchID != "" {
+			existing = append(existing, i)
+			continue
+		}
+		missing = append(missing, i)
+	}
+
+	if len(existing) > 0 {
+		if err := s.setExistingVSwitchCIDRs(ctx, shoot, infraConfig, existing); err != nil {
+			return err
+		}
+		changed = true
+	}
+	if len(missing) > 0 {
+		if err := s.allocateZoneCIDRs(ctx, shoot, infraConfig, missing); err != nil {
+			return err
+		}
+		changed = true
+	}
+
+	if !changed {
+		return nil
+	}
+	infraConfig.TypeMeta = metav1.TypeMeta{
+		APIVersion: apisalicloudv1alpha1.SchemeGroupVersion.String(),
+		Kind:       "InfrastructureConfig",
+	}
+	raw, err := s.convertToRawExtension(infraConfig)
+	if err != nil {
+		return err
+	}
+	shoot.Spec.Provider.InfrastructureConfig = raw
+	return nil
+}
+
+func (s *shootMutator) allocateZoneCIDRs(ctx context.Context, shoot *corev1beta1.Shoot, infraConfig *apisalicloudv1alpha1.InfrastructureConfig, missing []int) error {
+	prefixLength := DefaultWorkersPrefixLength
+	if infraConfig.Networks.WorkersPrefixLength != nil {
+		prefixLength = *infraConfig.Networks.WorkersPrefixLength
+	}
+
+	var (
+		pools   []string
+		used    []netip.Prefix
+		network = shoot.Spec.Networking
+	)
+	if network != nil && network.Nodes != nil {
+		// the nodes CIDR cannot be changed, new zones are placed in the secondary CIDRs once it is exhausted
+		pools = append([]string{*network.Nodes}, infraConfig.Networks.VPC.SecondaryCIDRs...)
+	} else if infraConfig.Networks.VPC.CIDR != nil {
+		pools = append([]string{*infraConfig.Networks.VPC.CIDR}, infraConfig.Networks.VPC.SecondaryCIDRs...)
+	}
+
+	if vpcID := infraConfig.Networks.VPC.ID; vpcID != nil {
+		vpcCIDRs, vswitchCIDRs, err := s.getVPCCIDRs(ctx, shoot, *vpcID)
+		if err != nil {
+			return err
+		}
+		if len(pools) == 0 {
+			pools = vpcCIDRs
+			for _, cidr := range infraConfig.Networks.VPC.SecondaryCIDRs {
+				if !slices.Contains(pools, cidr) {
+					pools = append(pools, cidr)
+				}
+			}
+		}
+		for _, cidr := range vswitchCIDRs {
+			if prefix, err := netip.ParsePrefix(cidr); err == nil {
+				used = append(used, prefix.Masked())
+			}
+		}
+	}
+	if len(pools) == 0 {
+		return fmt.Errorf("cannot allocate worker CIDRs for zones: neither networking.nodes nor a VPC CIDR is available")
+	}
+	var poolPrefixes []netip.Prefix
+	for _, pool := range pools {
+		poolPrefix, err := netip.ParsePrefix(pool)
+		if err != nil {
+			return fmt.Errorf("cannot allocate worker CIDRs for zones from %q: %w", pool, err)
+		}
+		poolPrefixes = append(poolPrefixes, poolPrefix.Masked())
+	}
+
+	for _, zone := range infraConfig.Networks.Zones {
+		for _, cidr := range []string{zone.Workers, zone.Worker} {
+			if prefix, err := netip.ParsePrefix(cidr); err == nil {
+				used = append(used, prefix.Masked())
+			}
+		}
+	}
+	if network != nil {
+		for _, cidr := range []*string{network.Pods, network.Services} {
+			if cidr == nil {
+				continue
+			}
+			if prefix, err := netip.ParsePrefix(*cidr); err == nil {
+				used = append(used, prefix.Masked())
+			}
+		}
+	}
+
+	for _, i := range missing {
+		allocated, err := allocateCIDRFromPools(poolPrefixes, int(prefixLength), used)
+		if err != nil {
+			return fmt.Errorf("cannot allocate worker CIDR for zone %q: %w", infraConfig.Networks.Zones[i].Name, err)
+		}
+		logger.Info("Allocated worker CIDR for zone", "name", shoot.Name, "namespace", shoot.Namespace, "zone", infraConfig.Networks.Zones[i].Name, "cidr", allocated.String())
+		infraConfig.Networks.Zones[i].Workers = allocated.String()
+		used = append(used, allocated)
+	}
+	return nil
+}
+
+// setExistingVSwitchCIDRs sets the worker CIDRs of the zones with the given indices to the CIDRs of their existing vswitches.
+func (s *shootMutator) setExistingVSwitchCIDRs(ctx context.Context, shoot *corev1beta1.Shoot, infraConfig *apisalicloudv1alpha1.InfrastructureConfig, indices []int) error {
+	vpcClient, err := s.newVPCClient(ctx, shoot)
+	if err != nil {
+		return err
+	}
+	for _, i := range indices {
+		zone := &infraConfig.Networks.Zones[i]
+		request := vpc.CreateDescribeVSwitchesRequest()
+		request.VSwitchId = *zone.VSwitchID
+		response, err := vpcClient.DescribeVSwitches(request)
+		if err != nil {
+			return fmt.Errorf("could not get vswitch %s of zone %q: %w", *zone.VSwitchID, zone.Name, err)
+		}
+		if len(response.VSwitches.VSwitch) == 0 {
+			return fmt.Errorf("vswitch %s of zone %q not found", *zone.VSwitchID, zone.Name)
+		}
+		zone.Workers = response.VSwitches.VSwitch[0].CidrBlock
+	}
+	return nil
+}
+
+// getVPCCIDRs returns the CIDR and the secondary CIDRs of the existing VPC with the given ID and the CIDRs of its vswitches.
+func (s *shootMutator) getVPCCIDRs(ctx context.Context, shoot *corev1beta1.Shoot, vpcID string) ([]string, []string, error) {
+	vpcClient, err := s.newVPCClient(ctx, shoot)
+	if err != nil {
+		return nil, nil, err
+	}
+	vpcs, err := vpcClient.GetVPCWithID(ctx, vpcID)
+	if err != nil {
+		return nil, nil, fmt.Errorf("could not get VPC %s: %w", vpcID, err)
+	}
+	if len(vpcs) == 0 {
+		return nil, nil, fmt.Errorf("VPC %s not found", vpcID)
+	}
+
+	var (
+		cidrs      []string
+		pageNumber = 1
+		pageSize   = 50
+		request    = vpc.CreateDescribeVSwitchesRequest()
+	)
+	request.VpcId = vpcID
+	request.PageSize = requests.NewInteger(pageSize)
+	for {
+		request.PageNumber = requests.NewInteger(pageNumber)
+		response, err := vpcClient.DescribeVSwitches(request)
+		if err != nil {
+			return nil, nil, fmt.Errorf("could not list vswitches in VPC %s: %w", vpcID, err)
+		}
+		for _, vsw := range response.VSwitches.VSwitch {
+			cidrs = append(cidrs, vsw.CidrBlock)
+		}
+		if pageNumber*pageSize >= response.TotalCount {
+			break
+		}
+		pageNumber++
+	}
+	return append([]string{vpcs[0].CidrBlock}, vpcs[0].SecondaryCidrBlocks.SecondaryCidrBlock...), cidrs, nil
+}
+
+func (s *shootMutator) newVPCClient(ctx context.Context, shoot *corev1beta1.Shoot) (alicloudclient.VPC, error) {
+	credentials, err := s.getCredentials(ctx, shoot)
+	if err != nil {
+		return nil, fmt.Errorf("could not get Alicloud credentials: %w", err)
+	}
+	vpcClient, err := s.alicloudClientFactory.NewVPCClient(shoot.Spec.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return nil, fmt.Errorf("could not create Alicloud VPC client: %w", err)
+	}
+	return vpcClient, nil
+}
+
+// allocateCIDRFromPools allocates a CIDR from the first of the given pools which has a free subnet left.
+func allocateCIDRFromPools(pools []netip.Prefix, prefixLength int, used []netip.Prefix) (netip.Prefix, error) {
+	var errs []error
+	for _, pool := range pools {
+		allocated, err := allocateCIDR(pool, prefixLength, used)
+		if err == nil {
+			return allocated, nil
+		}
+		errs = append(errs, err)
+	}
+	return netip.Prefix{}, errors.Join(errs...)
+}
+
+// allocateCIDR returns the first subnet of the given prefix length within the pool which does not overlap with any
+// of the used prefixes.
+func allocateCIDR(pool netip.Prefix, prefixLength int, used []netip.Prefix) (netip.Prefix, error) {
+	if !pool.Addr().Is4() {
+		return netip.Prefix{}, fmt.Errorf("only IPv4 CIDRs are supported, got %s", pool)
+	}
+	if prefixLength < pool.Bits() || prefixLength > 32 {
+		return netip.Prefix{}, fmt.Errorf("prefix length %d does not fit into %s", prefixLength, pool)
+	}
+
+	var (
+		size      = uint64(1) << (32 - prefixLength)
+		poolStart = ipv4ToUint(pool.Addr())
+		poolEnd   = poolStart + (uint64(1) << (32 - pool.Bits()))
+	)
+	for start := poolStart; start+size <= poolEnd; {
+		end := start + size
+		next := end
+		free := true
+		for _, prefix := range used {
+			if !prefix.Addr().Is4() {
+				continue
+			}
+			usedStart := ipv4ToUint(prefix.Addr())
+			usedEnd := usedStart + (uint64(1) << (32 - prefix.Bits()))
+			if usedStart < end && start < usedEnd {
+				free = false
+				// continue behind the used prefix, aligned to the prefix length
+				next = max(next, (usedEnd+size-1)/size*size)
+			}
+		}
+		if free {
+			return netip.PrefixFrom(uintToIPv4(start), prefixLength), nil
+		}
+		start = next
+	}
+	return netip.Prefix{}, fmt.Errorf("no free /%d subnet left in %s", prefixLength, pool)
+}
+
+func ipv4ToUint(addr netip.Addr) uint64 {
+	b := addr.As4()
+	return uint64(b[0])<<24 | uint64(b[1])<<16 | uint64(b[2])<<8 | uint64(b[3])
+}
+
+func uintToIPv4(v uint64) netip.Addr {
+	return netip.AddrFrom4([4]byte{byte(v >> 24), byte(v >> 16), byte(v >> 8), byte(v)})
+}
+
+func (s *shootMutator) decodeInfrastructureConfig(infraConfig *runtime.RawExtension) (*apisalicloudv1alpha1.InfrastructureConfig, error) {
+	config := &apisalicloudv1alpha1.InfrastructureConfig{}
+	if _, _, err := s.codec.Decode(infraConfig.Raw, nil, config); err != nil {
+		return nil, fmt.Errorf("could not decode infrastructureConfig of shoot: %w", err)
+	}
+	return config, nil
+}
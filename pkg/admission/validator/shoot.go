@@ -101,11 +101,35 @@ func (s *shoot) validateShoot(_ context.Context, shoot *core.Shoot, infraConfig
 		if errList := alicloudvalidation.ValidateControlPlaneConfig(cpConfig, shoot.Spec.Kubernetes.Version, cpConfigFldPath); len(errList) != 0 {
 			return errList.ToAggregate()
 		}
+		if errList := validateOSSCredentialsRef(cpConfig, shoot.Spec.Resources); len(errList) != 0 {
+			return errList.ToAggregate()
+		}
 	}
 
 	return nil
 }
 
+// validateOSSCredentialsRef validates that the credentials of the OSS CSI driver refer to a Secret in the resources of the shoot.
+func validateOSSCredentialsRef(cpConfig *alicloud.ControlPlaneConfig, resources []core.NamedResourceReference) field.ErrorList {
+	if cpConfig.CSI == nil || cpConfig.CSI.OSS == nil || cpConfig.CSI.OSS.CredentialsRef == nil || len(*cpConfig.CSI.OSS.CredentialsRef) == 0 {
+		return nil
+	}
+
+	credentialsRef := *cpConfig.CSI.OSS.CredentialsRef
+	credentialsRefPath := cpConfigFldPath.Child("csi", "oss", "credentialsRef")
+	for _, resource := range resources {
+		if resource.Name != credentialsRef {
+			continue
+		}
+		if resource.ResourceRef.APIVersion != "v1" || resource.ResourceRef.Kind != "Secret" {
+			return field.ErrorList{field.Invalid(credentialsRefPath, credentialsRef, "must refer to a resource of kind Secret")}
+		}
+		return nil
+	}
+
+	return field.ErrorList{field.NotFound(credentialsRefPath, credentialsRef)}
+}
+
 func (s *shoot) validateShootUpdate(ctx context.Context, oldShoot, shoot *core.Shoot) error {
 	// Decode the new infrastructure config
 	infraConfig, err := checkAndDecodeInfrastructureConfig(s.decoder, shoot.Spec.Provider.InfrastructureConfig, infraConfigFldPath)
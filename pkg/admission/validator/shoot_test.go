@@ -18,8 +18,10 @@ import (
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/util/validation/field"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
@@ -233,3 +235,45 @@ var _ = Describe("shoot.validateVSwitchCIDRConflict", func() {
 		})
 	})
 })
+
+var _ = Describe("validateOSSCredentialsRef", func() {
+	var cpConfig *apisalicloud.ControlPlaneConfig
+
+	BeforeEach(func() {
+		cpConfig = &apisalicloud.ControlPlaneConfig{
+			CSI: &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{
+				Enabled:        true,
+				CredentialsRef: ptr.To("oss-credentials"),
+			}},
+		}
+	})
+
+	It("should allow credentials referring to a Secret of the shoot", func() {
+		resources := []core.NamedResourceReference{{
+			Name:        "oss-credentials",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-oss-secret"},
+		}}
+
+		Expect(validateOSSCredentialsRef(cpConfig, resources)).To(BeEmpty())
+	})
+
+	It("should fail if the credentials do not refer to a resource of the shoot", func() {
+		errList := validateOSSCredentialsRef(cpConfig, nil)
+
+		Expect(errList).To(HaveLen(1))
+		Expect(errList[0].Type).To(Equal(field.ErrorTypeNotFound))
+		Expect(errList[0].Field).To(Equal("spec.provider.controlPlaneConfig.csi.oss.credentialsRef"))
+	})
+
+	It("should fail if the credentials refer to a resource which is no Secret", func() {
+		resources := []core.NamedResourceReference{{
+			Name:        "oss-credentials",
+			ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "ConfigMap", Name: "my-oss-config"},
+		}}
+
+		errList := validateOSSCredentialsRef(cpConfig, resources)
+
+		Expect(errList).To(HaveLen(1))
+		Expect(errList[0].Type).To(Equal(field.ErrorTypeInvalid))
+	})
+})
@@ -16,6 +16,7 @@ import (
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
 	ram "github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -29,6 +30,7 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/ros"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/sls"
 )
 
 // ComputeStorageEndpoint computes the OSS storage endpoint based on the given region.
@@ -320,6 +322,31 @@ func (c *ecsClient) GetAvailableInstanceType(cores int, zoneID string) (*ecs.Des
 	return c.DescribeAvailableResource(request)
 }
 
+// IsInstanceTypeAvailable returns whether the given instance type can be created in the zone.
+func (c *ecsClient) IsInstanceTypeAvailable(instanceTypeID, zoneID string) (bool, error) {
+	request := ecs.CreateDescribeAvailableResourceRequest()
+	request.SetScheme("HTTPS")
+	request.DestinationResource = "InstanceType"
+	request.InstanceChargeType = "PostPaid"
+	request.NetworkCategory = "vpc"
+	request.InstanceType = instanceTypeID
+	request.ZoneId = zoneID
+	response, err := c.DescribeAvailableResource(request)
+	if err != nil {
+		return false, err
+	}
+	for _, zone := range response.AvailableZones.AvailableZone {
+		for _, resource := range zone.AvailableResources.AvailableResource {
+			for _, supported := range resource.SupportedResources.SupportedResource {
+				if supported.Value == instanceTypeID && supported.Status == "Available" {
+					return true, nil
+				}
+			}
+		}
+	}
+	return false, nil
+}
+
 // ListAllInstanceType return metadata of instance type
 func (c *ecsClient) ListAllInstanceType() (*ecs.DescribeInstanceTypesResponse, error) {
 	request := ecs.CreateDescribeInstanceTypesRequest()
@@ -379,6 +406,39 @@ func (c *ecsClient) AllocatePublicIp(id string) (*ecs.AllocatePublicIpAddressRes
 	return c.AllocatePublicIpAddress(request)
 }
 
+// GetCloudAssistantStatus returns the status of the Cloud Assistant agent of the instance or nil if it is unknown.
+func (c *ecsClient) GetCloudAssistantStatus(instanceID string) (*ecs.InstanceCloudAssistantStatus, error) {
+	request := ecs.CreateDescribeCloudAssistantStatusRequest()
+	request.SetScheme("HTTPS")
+	request.InstanceId = &[]string{instanceID}
+	response, err := c.DescribeCloudAssistantStatus(request)
+	if err != nil {
+		return nil, err
+	}
+	for _, status := range response.InstanceCloudAssistantStatusSet.InstanceCloudAssistantStatus {
+		if status.InstanceId == instanceID {
+			return &status, nil
+		}
+	}
+	return nil, nil
+}
+
+// InstallCloudAssistant installs the Cloud Assistant agent on the instance and reboots it to start the agent.
+func (c *ecsClient) InstallCloudAssistant(instanceID string) error {
+	request := ecs.CreateInstallCloudAssistantRequest()
+	request.SetScheme("HTTPS")
+	request.InstanceId = &[]string{instanceID}
+	if _, err := c.Client.InstallCloudAssistant(request); err != nil {
+		return err
+	}
+
+	rebootRequest := ecs.CreateRebootInstanceRequest()
+	rebootRequest.SetScheme("HTTPS")
+	rebootRequest.InstanceId = instanceID
+	_, err := c.RebootInstance(rebootRequest)
+	return err
+}
+
 // CreateIngressRule create ingress rule
 func (c *ecsClient) CreateIngressRule(request *ecs.AuthorizeSecurityGroupRequest) error {
 	_, err := c.AuthorizeSecurityGroup(request)
@@ -786,6 +846,23 @@ func (f *clientFactory) NewNLBClient(region, accessKeyID, accessKeySecret string
 	}, nil
 }
 
+// NewNASClient creates a new NAS client with given region, accessKeyID, and accessKeySecret.
+func (f *clientFactory) NewNASClient(region, accessKeyID, accessKeySecret string) (NAS, error) {
+	client, err := nas.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+	if err != nil {
+		return nil, err
+	}
+
+	return &nasClient{
+		*client,
+	}, nil
+}
+
+// NewSLSClient creates a new SLS client with given region, accessKeyID, and accessKeySecret.
+func (f *clientFactory) NewSLSClient(region, accessKeyID, accessKeySecret string) (SLS, error) {
+	return sls.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
+}
+
 // NewROSClient creates a new ROS client with given region, accessKeyID, and accessKeySecret.
 func (f *clientFactory) NewROSClient(region, accessKeyID, accessKeySecret string) (ROS, error) {
 	return ros.NewClientWithAccessKey(region, accessKeyID, accessKeySecret)
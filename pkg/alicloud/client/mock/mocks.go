@@ -155,6 +155,21 @@ func (mr *MockClientFactoryMockRecorder) NewECSClient(region, accessKeyID, acces
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, accessKeyID, accessKeySecret)
 }
 
+// NewNASClient mocks base method.
+func (m *MockClientFactory) NewNASClient(region, accessKeyID, accessKeySecret string) (client.NAS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewNASClient", region, accessKeyID, accessKeySecret)
+	ret0, _ := ret[0].(client.NAS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewNASClient indicates an expected call of NewNASClient.
+func (mr *MockClientFactoryMockRecorder) NewNASClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewNASClient", reflect.TypeOf((*MockClientFactory)(nil).NewNASClient), region, accessKeyID, accessKeySecret)
+}
+
 // NewNLBClient mocks base method.
 func (m *MockClientFactory) NewNLBClient(region, accessKeyID, accessKeySecret string) (client.NLB, error) {
 	m.ctrl.T.Helper()
@@ -245,6 +260,21 @@ func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, accessKeyID, acces
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, accessKeyID, accessKeySecret)
 }
 
+// NewSLSClient mocks base method.
+func (m *MockClientFactory) NewSLSClient(region, accessKeyID, accessKeySecret string) (client.SLS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSLSClient", region, accessKeyID, accessKeySecret)
+	ret0, _ := ret[0].(client.SLS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSLSClient indicates an expected call of NewSLSClient.
+func (mr *MockClientFactoryMockRecorder) NewSLSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLSClient), region, accessKeyID, accessKeySecret)
+}
+
 // NewSTSClient mocks base method.
 func (m *MockClientFactory) NewSTSClient(region, accessKeyID, accessKeySecret string) (client.STS, error) {
 	m.ctrl.T.Helper()
@@ -0,0 +1,37 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sls
+
+import "net/http"
+
+// LogStore is a logstore of an SLS project.
+type LogStore struct {
+	LogStoreName string `json:"logstoreName"`
+	// TTL is the retention period of the logs in days.
+	TTL        int `json:"ttl"`
+	ShardCount int `json:"shardCount"`
+}
+
+// CreateLogStore invokes the SLS CreateLogStore API.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-createlogstore
+func (client *Client) CreateLogStore(projectName string, logStore *LogStore) error {
+	return client.doRequest(http.MethodPost, projectName, "/logstores", logStore, nil)
+}
+
+// GetLogStore invokes the SLS GetLogStore API.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-getlogstore
+func (client *Client) GetLogStore(projectName, logStoreName string) (*LogStore, error) {
+	logStore := &LogStore{}
+	if err := client.doRequest(http.MethodGet, projectName, "/logstores/"+logStoreName, nil, logStore); err != nil {
+		return nil, err
+	}
+	return logStore, nil
+}
+
+// DeleteLogStore invokes the SLS DeleteLogStore API.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-deletelogstore
+func (client *Client) DeleteLogStore(projectName, logStoreName string) error {
+	return client.doRequest(http.MethodDelete, projectName, "/logstores/"+logStoreName, nil, nil)
+}
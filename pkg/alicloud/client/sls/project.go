@@ -0,0 +1,64 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sls
+
+import "net/http"
+
+const (
+	// ErrorCodeProjectNotExist is the error code returned if the SLS project does not exist.
+	ErrorCodeProjectNotExist = "ProjectNotExist"
+	// ErrorCodeLogStoreNotExist is the error code returned if the logstore does not exist.
+	ErrorCodeLogStoreNotExist = "LogStoreNotExist"
+)
+
+// Project is an SLS project.
+type Project struct {
+	ProjectName string `json:"projectName"`
+	Description string `json:"description,omitempty"`
+	Status      string `json:"status,omitempty"`
+	Region      string `json:"region,omitempty"`
+}
+
+// CreateProject invokes the SLS CreateProject API.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-createproject
+func (client *Client) CreateProject(project *Project) error {
+	body := &Project{ProjectName: project.ProjectName, Description: project.Description}
+	return client.doRequest(http.MethodPost, project.ProjectName, "/", body, nil)
+}
+
+// GetProject invokes the SLS GetProject API.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-getproject
+func (client *Client) GetProject(projectName string) (*Project, error) {
+	project := &Project{}
+	if err := client.doRequest(http.MethodGet, projectName, "/", nil, project); err != nil {
+		return nil, err
+	}
+	return project, nil
+}
+
+// DeleteProject invokes the SLS DeleteProject API. All logstores of the project are deleted with it.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-deleteproject
+func (client *Client) DeleteProject(projectName string) error {
+	return client.doRequest(http.MethodDelete, projectName, "/", nil, nil)
+}
+
+// Tag is a tag of an SLS resource.
+type Tag struct {
+	Key   string `json:"key"`
+	Value string `json:"value"`
+}
+
+type tagResourcesRequest struct {
+	ResourceType string   `json:"resourceType"`
+	ResourceId   []string `json:"resourceId"`
+	Tags         []Tag    `json:"tags"`
+}
+
+// TagProject invokes the SLS TagResources API for the given project.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/api-sls-2020-12-30-tagresources
+func (client *Client) TagProject(projectName string, tags []Tag) error {
+	body := &tagResourcesRequest{ResourceType: "project", ResourceId: []string{projectName}, Tags: tags}
+	return client.doRequest(http.MethodPost, "", "/tag", body, nil)
+}
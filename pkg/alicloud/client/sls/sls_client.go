@@ -0,0 +1,149 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sls
+
+import (
+	"bytes"
+	"crypto/hmac"
+	"crypto/md5"  // #nosec: G501
+	"crypto/sha1" // #nosec: G505
+	"encoding/base64"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"net/url"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+)
+
+const (
+	apiVersion      = "0.6.0"
+	signatureMethod = "hmac-sha1"
+)
+
+// Client is the client of the Simple Log Service (SLS) API. The Alibaba Cloud SDK does not support the project and
+// logstore APIs of SLS, which are signed with the SLS specific signature.
+// api document: https://www.alibabacloud.com/help/en/sls/developer-reference/request-signatures
+type Client struct {
+	// Endpoint is the regional SLS endpoint, e.g. "cn-beijing.log.aliyuncs.com".
+	Endpoint   string
+	Scheme     string
+	HTTPClient *http.Client
+
+	accessKeyId     string
+	accessKeySecret string
+}
+
+// Error is an error returned by the SLS API.
+type Error struct {
+	HTTPCode  int    `json:"-"`
+	Code      string `json:"errorCode"`
+	Message   string `json:"errorMessage"`
+	RequestId string `json:"-"`
+}
+
+// Error implements the error interface.
+func (e *Error) Error() string {
+	return fmt.Sprintf("SLS error: status %d, code %s, message %s, request id %s", e.HTTPCode, e.Code, e.Message, e.RequestId)
+}
+
+// NewClientWithAccessKey creates a client for the SLS endpoint of the given region.
+func NewClientWithAccessKey(regionId, accessKeyId, accessKeySecret string) (*Client, error) {
+	if regionId == "" {
+		return nil, fmt.Errorf("region must not be empty")
+	}
+	return &Client{
+		Endpoint:        regionId + ".log.aliyuncs.com",
+		Scheme:          "https",
+		HTTPClient:      &http.Client{Timeout: 30 * time.Second},
+		accessKeyId:     accessKeyId,
+		accessKeySecret: accessKeySecret,
+	}, nil
+}
+
+// doRequest sends a signed request to the SLS API. If project is not empty, the request is sent to the endpoint of
+// the project. If out is not nil, the response body is decoded into it.
+func (client *Client) doRequest(method, project, path string, body, out any) error {
+	var data []byte
+	if body != nil {
+		var err error
+		if data, err = json.Marshal(body); err != nil {
+			return err
+		}
+	}
+
+	host := client.Endpoint
+	if project != "" {
+		host = project + "." + host
+	}
+	u := url.URL{Scheme: client.Scheme, Host: host, Path: path}
+	req, err := http.NewRequest(method, u.String(), bytes.NewReader(data))
+	if err != nil {
+		return err
+	}
+	req.Header.Set("Date", time.Now().UTC().Format(http.TimeFormat))
+	req.Header.Set("x-log-apiversion", apiVersion)
+	req.Header.Set("x-log-signaturemethod", signatureMethod)
+	req.Header.Set("x-log-bodyrawsize", strconv.Itoa(len(data)))
+	if len(data) > 0 {
+		req.Header.Set("Content-Type", "application/json")
+		req.Header.Set("Content-MD5", fmt.Sprintf("%X", md5.Sum(data))) // #nosec: G401
+	}
+	req.Header.Set("Authorization", fmt.Sprintf("LOG %s:%s", client.accessKeyId, client.sign(req)))
+
+	resp, err := client.HTTPClient.Do(req)
+	if err != nil {
+		return err
+	}
+	defer resp.Body.Close()
+	respBody, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return err
+	}
+	if resp.StatusCode < 200 || resp.StatusCode >= 300 {
+		slsErr := &Error{HTTPCode: resp.StatusCode, RequestId: resp.Header.Get("x-log-requestid")}
+		if err := json.Unmarshal(respBody, slsErr); err != nil {
+			slsErr.Message = string(respBody)
+		}
+		return slsErr
+	}
+	if out != nil && len(respBody) > 0 {
+		return json.Unmarshal(respBody, out)
+	}
+	return nil
+}
+
+// sign returns the signature of the request, i.e. the HMAC-SHA1 of the method, the Content-MD5, Content-Type and
+// Date headers, the canonicalized SLS headers and the resource path.
+func (client *Client) sign(req *http.Request) string {
+	var logHeaders []string
+	for key := range req.Header {
+		lower := strings.ToLower(key)
+		if strings.HasPrefix(lower, "x-log-") || strings.HasPrefix(lower, "x-acs-") {
+			logHeaders = append(logHeaders, lower+":"+req.Header.Get(key))
+		}
+	}
+	sort.Strings(logHeaders)
+
+	resource := req.URL.EscapedPath()
+	if resource == "" {
+		resource = "/"
+	}
+
+	stringToSign := strings.Join([]string{
+		req.Method,
+		req.Header.Get("Content-MD5"),
+		req.Header.Get("Content-Type"),
+		req.Header.Get("Date"),
+		strings.Join(logHeaders, "\n"),
+		resource,
+	}, "\n")
+	mac := hmac.New(sha1.New, []byte(client.accessKeySecret))
+	mac.Write([]byte(stringToSign))
+	return base64.StdEncoding.EncodeToString(mac.Sum(nil))
+}
@@ -0,0 +1,98 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package sls_test
+
+import (
+	"context"
+	"io"
+	"net"
+	"net/http"
+	"net/http/httptest"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/sls"
+)
+
+var _ = Describe("Client", func() {
+	var (
+		server   *httptest.Server
+		requests []*http.Request
+		bodies   []string
+		handler  http.HandlerFunc
+		client   *Client
+	)
+
+	BeforeEach(func() {
+		requests, bodies = nil, nil
+		handler = func(w http.ResponseWriter, _ *http.Request) {
+			w.WriteHeader(http.StatusOK)
+		}
+		server = httptest.NewServer(http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+			body, _ := io.ReadAll(r.Body)
+			requests = append(requests, r)
+			bodies = append(bodies, string(body))
+			handler(w, r)
+		}))
+		DeferCleanup(server.Close)
+
+		var err error
+		client, err = NewClientWithAccessKey("cn-beijing", "ak", "secret")
+		Expect(err).NotTo(HaveOccurred())
+		client.Scheme = "http"
+		// all hosts are resolved to the test server, so that the project endpoints can be checked
+		client.HTTPClient = &http.Client{Transport: &http.Transport{
+			DialContext: func(ctx context.Context, network, _ string) (net.Conn, error) {
+				return (&net.Dialer{}).DialContext(ctx, network, server.Listener.Addr().String())
+			},
+		}}
+	})
+
+	It("should send signed requests to the endpoint of the project", func() {
+		Expect(client.CreateLogStore("my-project", &LogStore{LogStoreName: "flowlogs", TTL: 30, ShardCount: 2})).To(Succeed())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Method).To(Equal(http.MethodPost))
+		Expect(requests[0].Host).To(Equal("my-project.cn-beijing.log.aliyuncs.com"))
+		Expect(requests[0].URL.Path).To(Equal("/logstores"))
+		Expect(bodies[0]).To(Equal(`{"logstoreName":"flowlogs","ttl":30,"shardCount":2}`))
+		Expect(requests[0].Header.Get("Content-Type")).To(Equal("application/json"))
+		Expect(requests[0].Header.Get("Content-MD5")).To(MatchRegexp("^[0-9A-F]{32}$"))
+		Expect(requests[0].Header.Get("x-log-bodyrawsize")).To(Equal("51"))
+		Expect(requests[0].Header.Get("x-log-apiversion")).To(Equal("0.6.0"))
+		Expect(requests[0].Header.Get("Authorization")).To(MatchRegexp(`^LOG ak:[A-Za-z0-9+/]{27}=$`))
+	})
+
+	It("should send tag requests to the regional endpoint", func() {
+		Expect(client.TagProject("my-project", []Tag{{Key: "Name", Value: "shoot--foo--bar"}})).To(Succeed())
+
+		Expect(requests).To(HaveLen(1))
+		Expect(requests[0].Host).To(Equal("cn-beijing.log.aliyuncs.com"))
+		Expect(requests[0].URL.Path).To(Equal("/tag"))
+		Expect(bodies[0]).To(Equal(`{"resourceType":"project","resourceId":["my-project"],"tags":[{"key":"Name","value":"shoot--foo--bar"}]}`))
+	})
+
+	It("should decode the response", func() {
+		handler = func(w http.ResponseWriter, _ *http.Request) {
+			_, _ = w.Write([]byte(`{"projectName":"my-project","status":"Normal","region":"cn-beijing"}`))
+		}
+
+		Expect(client.GetProject("my-project")).To(Equal(&Project{ProjectName: "my-project", Status: "Normal", Region: "cn-beijing"}))
+		Expect(requests[0].Method).To(Equal(http.MethodGet))
+		Expect(requests[0].Header.Get("Content-MD5")).To(BeEmpty())
+	})
+
+	It("should return the SLS error", func() {
+		handler = func(w http.ResponseWriter, _ *http.Request) {
+			w.Header().Set("x-log-requestid", "req-1")
+			w.WriteHeader(http.StatusNotFound)
+			_, _ = w.Write([]byte(`{"errorCode":"ProjectNotExist","errorMessage":"The Project does not exist : my-project"}`))
+		}
+
+		_, err := client.GetProject("my-project")
+		Expect(err).To(Equal(&Error{HTTPCode: http.StatusNotFound, Code: ErrorCodeProjectNotExist, Message: "The Project does not exist : my-project", RequestId: "req-1"}))
+	})
+})
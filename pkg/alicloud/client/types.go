@@ -11,6 +11,7 @@ import (
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/alidns"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
 	ram "github.com/aliyun/alibaba-cloud-sdk-go/services/resourcemanager"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/slb"
@@ -24,6 +25,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	ros "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/ros"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/sls"
 )
 
 const (
@@ -63,6 +65,8 @@ type ClientFactory interface {
 	NewOSSClientFromSecretRef(ctx context.Context, c client.Client, secretRef *corev1.SecretReference, region string) (OSS, error)
 	NewDNSClient(region, accessKeyID, accessKeySecret string) (DNS, error)
 	NewNLBClient(region, accessKeyID, accessKeySecret string) (NLB, error)
+	NewNASClient(region, accessKeyID, accessKeySecret string) (NAS, error)
+	NewSLSClient(region, accessKeyID, accessKeySecret string) (SLS, error)
 }
 
 // ecsClient implements the ECS interface.
@@ -84,12 +88,15 @@ type ECS interface {
 	DetachECSInstancesFromSSHKeyPair(keyName string) error
 	GetInstances(name string) (*ecs.DescribeInstancesResponse, error)
 	GetAvailableInstanceType(core int, zoneID string) (*ecs.DescribeAvailableResourceResponse, error)
+	IsInstanceTypeAvailable(instanceTypeID, zoneID string) (bool, error)
 	ListAllInstanceType() (*ecs.DescribeInstanceTypesResponse, error)
 	CreateInstances(instanceName, securityGroupID, imageID, vSwitchId, zoneID, instanceTypeID, userData string) (*ecs.RunInstancesResponse, error)
 	DeleteInstances(id string, force bool) error
 	CreateSecurityGroups(vpcId, name string) (*ecs.CreateSecurityGroupResponse, error)
 	DeleteSecurityGroups(id string) error
 	AllocatePublicIp(id string) (*ecs.AllocatePublicIpAddressResponse, error)
+	GetCloudAssistantStatus(instanceID string) (*ecs.InstanceCloudAssistantStatus, error)
+	InstallCloudAssistant(instanceID string) error
 	CreateIngressRule(request *ecs.AuthorizeSecurityGroupRequest) error
 	CreateEgressRule(request *ecs.AuthorizeSecurityGroupEgressRequest) error
 	RevokeIngressRule(request *ecs.RevokeSecurityGroupRequest) error
@@ -147,6 +154,29 @@ type NLB interface {
 	DeleteLoadBalancer(request *nlb.DeleteLoadBalancerRequest) (response *nlb.DeleteLoadBalancerResponse, err error)
 }
 
+// nasClient implements the NAS interface.
+type nasClient struct {
+	nas.Client
+}
+
+// NAS is an interface which declares NAS (File Storage) related methods.
+type NAS interface {
+	// CreateFileSystem creates a NAS file system.
+	CreateFileSystem(request *nas.CreateFileSystemRequest) (response *nas.CreateFileSystemResponse, err error)
+	// DescribeFileSystems returns the NAS file systems matching the request.
+	DescribeFileSystems(request *nas.DescribeFileSystemsRequest) (response *nas.DescribeFileSystemsResponse, err error)
+	// DeleteFileSystem deletes the NAS file system with the given ID.
+	DeleteFileSystem(request *nas.DeleteFileSystemRequest) (response *nas.DeleteFileSystemResponse, err error)
+	// TagResources tags NAS file systems.
+	TagResources(request *nas.TagResourcesRequest) (response *nas.TagResourcesResponse, err error)
+	// CreateMountTarget creates a mount target of a NAS file system in a vswitch.
+	CreateMountTarget(request *nas.CreateMountTargetRequest) (response *nas.CreateMountTargetResponse, err error)
+	// DescribeMountTargets returns the mount targets of a NAS file system.
+	DescribeMountTargets(request *nas.DescribeMountTargetsRequest) (response *nas.DescribeMountTargetsResponse, err error)
+	// DeleteMountTarget deletes a mount target of a NAS file system.
+	DeleteMountTarget(request *nas.DeleteMountTargetRequest) (response *nas.DeleteMountTargetResponse, err error)
+}
+
 // vpcClient implements the VPC interface.
 type vpcClient struct {
 	vpc.Client
@@ -187,6 +217,9 @@ type VPC interface {
 	UnassociateEipAddress(request *vpc.UnassociateEipAddressRequest) (response *vpc.UnassociateEipAddressResponse, err error)
 	CreateSnatEntry(request *vpc.CreateSnatEntryRequest) (response *vpc.CreateSnatEntryResponse, err error)
 	DeleteSnatEntry(request *vpc.DeleteSnatEntryRequest) (response *vpc.DeleteSnatEntryResponse, err error)
+	CreateNatIp(request *vpc.CreateNatIpRequest) (response *vpc.CreateNatIpResponse, err error)
+	ListNatIps(request *vpc.ListNatIpsRequest) (response *vpc.ListNatIpsResponse, err error)
+	DeleteNatIp(request *vpc.DeleteNatIpRequest) (response *vpc.DeleteNatIpResponse, err error)
 
 	CreateRouteTable(request *vpc.CreateRouteTableRequest) (response *vpc.CreateRouteTableResponse, err error)
 	DescribeRouteTableList(request *vpc.DescribeRouteTableListRequest) (response *vpc.DescribeRouteTableListResponse, err error)
@@ -198,10 +231,23 @@ type VPC interface {
 	DescribeRouteEntryList(request *vpc.DescribeRouteEntryListRequest) (response *vpc.DescribeRouteEntryListResponse, err error)
 
 	ModifyVpcAttribute(request *vpc.ModifyVpcAttributeRequest) (response *vpc.ModifyVpcAttributeResponse, err error)
+	AssociateVpcCidrBlock(request *vpc.AssociateVpcCidrBlockRequest) (response *vpc.AssociateVpcCidrBlockResponse, err error)
+	UnassociateVpcCidrBlock(request *vpc.UnassociateVpcCidrBlockRequest) (response *vpc.UnassociateVpcCidrBlockResponse, err error)
+	DeletionProtection(request *vpc.DeletionProtectionRequest) (response *vpc.DeletionProtectionResponse, err error)
 	CreateIpv6Gateway(request *vpc.CreateIpv6GatewayRequest) (response *vpc.CreateIpv6GatewayResponse, err error)
 	DescribeIpv6Gateways(request *vpc.DescribeIpv6GatewaysRequest) (response *vpc.DescribeIpv6GatewaysResponse, err error)
 	DeleteIpv6Gateway(request *vpc.DeleteIpv6GatewayRequest) (response *vpc.DeleteIpv6GatewayResponse, err error)
 	ModifyVSwitchAttribute(request *vpc.ModifyVSwitchAttributeRequest) (response *vpc.ModifyVSwitchAttributeResponse, err error)
+	CreateFlowLog(request *vpc.CreateFlowLogRequest) (response *vpc.CreateFlowLogResponse, err error)
+	DescribeFlowLogs(request *vpc.DescribeFlowLogsRequest) (response *vpc.DescribeFlowLogsResponse, err error)
+	DeleteFlowLog(request *vpc.DeleteFlowLogRequest) (response *vpc.DeleteFlowLogResponse, err error)
+	DescribeIpv6Addresses(request *vpc.DescribeIpv6AddressesRequest) (response *vpc.DescribeIpv6AddressesResponse, err error)
+	AllocateIpv6InternetBandwidth(request *vpc.AllocateIpv6InternetBandwidthRequest) (response *vpc.AllocateIpv6InternetBandwidthResponse, err error)
+	ModifyIpv6InternetBandwidth(request *vpc.ModifyIpv6InternetBandwidthRequest) (response *vpc.ModifyIpv6InternetBandwidthResponse, err error)
+	DeleteIpv6InternetBandwidth(request *vpc.DeleteIpv6InternetBandwidthRequest) (response *vpc.DeleteIpv6InternetBandwidthResponse, err error)
+	CreateIpv6EgressOnlyRule(request *vpc.CreateIpv6EgressOnlyRuleRequest) (response *vpc.CreateIpv6EgressOnlyRuleResponse, err error)
+	DescribeIpv6EgressOnlyRules(request *vpc.DescribeIpv6EgressOnlyRulesRequest) (response *vpc.DescribeIpv6EgressOnlyRulesResponse, err error)
+	DeleteIpv6EgressOnlyRule(request *vpc.DeleteIpv6EgressOnlyRuleRequest) (response *vpc.DeleteIpv6EgressOnlyRuleResponse, err error)
 }
 
 // ramClient implements the RAM interface.
@@ -223,6 +269,24 @@ type ROS interface {
 	DeleteStack(request *ros.DeleteStackRequest) (response *ros.DeleteStackResponse, err error)
 }
 
+// SLS is an interface which declares SLS (Simple Log Service) related methods.
+type SLS interface {
+	// CreateProject creates an SLS project.
+	CreateProject(project *sls.Project) error
+	// GetProject returns the SLS project with the given name.
+	GetProject(projectName string) (*sls.Project, error)
+	// DeleteProject deletes the SLS project with the given name together with its logstores.
+	DeleteProject(projectName string) error
+	// TagProject tags the SLS project with the given name.
+	TagProject(projectName string, tags []sls.Tag) error
+	// CreateLogStore creates a logstore in the SLS project.
+	CreateLogStore(projectName string, logStore *sls.LogStore) error
+	// GetLogStore returns the logstore of the SLS project.
+	GetLogStore(projectName, logStoreName string) (*sls.LogStore, error)
+	// DeleteLogStore deletes the logstore of the SLS project.
+	DeleteLogStore(projectName, logStoreName string) error
+}
+
 // ossClient implements the OSS interface.
 type ossClient struct {
 	oss.Client
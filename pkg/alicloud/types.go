@@ -41,12 +41,31 @@ const (
 	// CSIPluginInitImageName is the name of the CSI plugin init image.
 	CSIPluginInitImageName = "csi-plugin-alicloud-init"
 
+	// CSINASPluginImageName is the name of the CSI plugin image serving the NAS driver.
+	CSINASPluginImageName = "csi-nas-plugin-alicloud"
+	// CSIOSSPluginImageName is the name of the CSI plugin image serving the OSS driver.
+	CSIOSSPluginImageName = "csi-oss-plugin-alicloud"
+
 	// StorageEndpoint is the data field in a secret where the storage endpoint is stored at.
 	StorageEndpoint = "storageEndpoint"
 	// CloudControllerManagerName is the a constant for the name of the CloudController.
 	CloudControllerManagerName = "cloud-controller-manager"
 	// CSIPluginController is the a constant for the name of the csi-plugin-controller Deployment in the Seed.
 	CSIPluginController = "csi-plugin-controller"
+	// CSINASPluginController is the a constant for the name of the csi-nas-plugin-controller Deployment in the Seed.
+	CSINASPluginController = "csi-nas-plugin-controller"
+	// CSINASPluginNodeName is a constant for the name of the csi-nas-plugin-alicloud DaemonSet in the Shoot.
+	CSINASPluginNodeName = "csi-nas-plugin-alicloud"
+	// CSINASDriverName is a constant for the name of the CSI driver provisioning NAS volumes.
+	CSINASDriverName = "nasplugin.csi.alibabacloud.com"
+	// CSIOSSPluginController is the a constant for the name of the csi-oss-plugin-controller Deployment in the Seed.
+	CSIOSSPluginController = "csi-oss-plugin-controller"
+	// CSIOSSPluginNodeName is a constant for the name of the csi-oss-plugin-alicloud DaemonSet in the Shoot.
+	CSIOSSPluginNodeName = "csi-oss-plugin-alicloud"
+	// CSIOSSDriverName is a constant for the name of the CSI driver mounting OSS buckets.
+	CSIOSSDriverName = "ossplugin.csi.alibabacloud.com"
+	// CSIOSSCredentialsSecretName is a constant for the name of the Secret in the Shoot with the credentials of the OSS driver.
+	CSIOSSCredentialsSecretName = "csi-oss-credentials"
 	// CSISnapshotControllerName is a constant for the name of the csi-snapshot-controller Deployment in the Seed.
 	CSISnapshotControllerName = "csi-snapshot-controller"
 
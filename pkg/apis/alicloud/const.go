@@ -7,4 +7,7 @@ package alicloud
 const (
 	// AnnotationKeyFlowReconcileCanDeleteResource is the annotation used to enable the deletion of resources during reconciliation with flow.
 	AnnotationKeyFlowReconcileCanDeleteResource = "alicloud.provider.extensions.gardener.cloud/flow-reconcile-can-delete-resource"
+	// AnnotationKeyFlowAdoptResources is the annotation used to rebuild the flow state from the resources tagged for the
+	// shoot before the next reconciliation. It is removed once the resources have been adopted.
+	AnnotationKeyFlowAdoptResources = "alicloud.provider.extensions.gardener.cloud/flow-adopt-resources"
 )
@@ -40,6 +40,15 @@ func FindVSwitchForPurpose(vswitches []api.VSwitch, purpose api.Purpose) (*api.V
 	return nil, fmt.Errorf("no vswitch with purpose %q found", purpose)
 }
 
+// NatGatewayTypeOfZone returns the type of the NAT gateway used by the given zone. It defaults to
+// the internet NAT gateway.
+func NatGatewayTypeOfZone(zone api.Zone) api.NatGatewayType {
+	if zone.NatGateway == nil || zone.NatGateway.Type == nil {
+		return api.NatGatewayTypeInternet
+	}
+	return *zone.NatGateway.Type
+}
+
 // FindSecurityGroupByPurpose takes a list of security groups and tries to find the first entry
 // whose purpose matches with the given purpose. If no such entry is found then an error will be
 // returned.
@@ -52,6 +61,28 @@ func FindSecurityGroupByPurpose(securityGroups []api.SecurityGroup, purpose api.
 	return nil, fmt.Errorf("cannot find security group with purpose %q", purpose)
 }
 
+// LoadBalancerType returns the type of the load balancers of the Services of a shoot. The type of the
+// ControlPlaneConfig takes precedence over the given default of the provider extension, which falls back to CLB.
+func LoadBalancerType(cpConfig *api.ControlPlaneConfig, defaultType string) api.LoadBalancerType {
+	if cpConfig != nil && cpConfig.LoadBalancer != nil && cpConfig.LoadBalancer.Type != nil {
+		return *cpConfig.LoadBalancer.Type
+	}
+	if defaultType != "" {
+		return api.LoadBalancerType(defaultType)
+	}
+	return api.LoadBalancerTypeCLB
+}
+
+// IsCSINASEnabled returns true if the NAS CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSINASEnabled(cpConfig *api.ControlPlaneConfig) bool {
+	return cpConfig != nil && cpConfig.CSI != nil && cpConfig.CSI.NAS != nil && cpConfig.CSI.NAS.Enabled
+}
+
+// IsCSIOSSEnabled returns true if the OSS CSI driver is enabled in the given ControlPlaneConfig.
+func IsCSIOSSEnabled(cpConfig *api.ControlPlaneConfig) bool {
+	return cpConfig != nil && cpConfig.CSI != nil && cpConfig.CSI.OSS != nil && cpConfig.CSI.OSS.Enabled
+}
+
 func matchEncryptedFlag(encrypted *bool, expectEncrypted bool) bool {
 	checkedVal := encrypted
 	if checkedVal == nil {
@@ -62,6 +62,43 @@ func InfrastructureStatusFromRaw(raw *runtime.RawExtension) (*api.Infrastructure
 	return nil, fmt.Errorf("provider status is not set on the infrastructure resource")
 }
 
+// BastionStatusFromRaw extracts the BastionStatus from the ProviderStatus section of a Bastion. It returns nil if
+// the provider status is not set yet.
+func BastionStatusFromRaw(raw *runtime.RawExtension) (*api.BastionStatus, error) {
+	if raw == nil || raw.Raw == nil {
+		return nil, nil
+	}
+	status := &api.BastionStatus{}
+	if _, _, err := lenientDecoder.Decode(raw.Raw, nil, status); err != nil {
+		return nil, err
+	}
+	return status, nil
+}
+
+// InfrastructureConfigFromCluster decodes the provider specific infrastructure configuration of the shoot of a cluster.
+func InfrastructureConfigFromCluster(cluster *controller.Cluster) (*api.InfrastructureConfig, error) {
+	var infraConfig *api.InfrastructureConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig != nil && cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw != nil {
+		infraConfig = &api.InfrastructureConfig{}
+		if _, _, err := lenientDecoder.Decode(cluster.Shoot.Spec.Provider.InfrastructureConfig.Raw, nil, infraConfig); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureConfig of shoot '%s': %w", client.ObjectKeyFromObject(cluster.Shoot), err)
+		}
+	}
+	return infraConfig, nil
+}
+
+// ControlPlaneConfigFromCluster decodes the provider specific control plane configuration of the shoot of a cluster.
+func ControlPlaneConfigFromCluster(cluster *controller.Cluster) (*api.ControlPlaneConfig, error) {
+	var cpConfig *api.ControlPlaneConfig
+	if cluster != nil && cluster.Shoot != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig != nil && cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw != nil {
+		cpConfig = &api.ControlPlaneConfig{}
+		if _, _, err := lenientDecoder.Decode(cluster.Shoot.Spec.Provider.ControlPlaneConfig.Raw, nil, cpConfig); err != nil {
+			return nil, fmt.Errorf("could not decode controlPlaneConfig of shoot '%s': %w", client.ObjectKeyFromObject(cluster.Shoot), err)
+		}
+	}
+	return cpConfig, nil
+}
+
 // CloudProfileConfigFromCluster decodes the provider specific cloud profile configuration for a cluster
 func CloudProfileConfigFromCluster(cluster *controller.Cluster) (*api.CloudProfileConfig, error) {
 	var cloudProfileConfig *api.CloudProfileConfig
@@ -0,0 +1,25 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package alicloud
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionStatus contains information about the created bastion host.
+type BastionStatus struct {
+	metav1.TypeMeta
+
+	// Zone is the zone of the bastion host.
+	Zone string
+	// VSwitchID is the id of the vswitch of the bastion host.
+	VSwitchID string
+	// InstanceID is the id of the bastion instance. It is only set for Cloud Assistant sessions.
+	InstanceID string
+	// Region is the region of the bastion instance. It is only set for Cloud Assistant sessions.
+	Region string
+}
@@ -18,6 +18,55 @@ type CloudProfileConfig struct {
 	// MachineImages is the list of machine images that are understood by the controller. It maps
 	// logical names and versions to provider-specific identifiers.
 	MachineImages []MachineImages
+	// Bastion contains the images and instance types used for the bastion hosts of the shoots.
+	Bastion *BastionConfig
+}
+
+// BastionConfig contains the images and instance types used for the bastion hosts.
+type BastionConfig struct {
+	// Images are the bastion images per region and architecture.
+	Images []BastionImage
+	// InstanceTypes are the instance types of the bastion hosts in the order of preference. The first instance type
+	// which is available in the zone of the bastion host and for which an image of its architecture exists is used.
+	InstanceTypes []string
+	// EIP configures an elastic IP address for the bastion hosts instead of a public IP allocated by ECS.
+	EIP *BastionEIP
+	// AccessMode is the way operators connect to the bastion hosts, either "SSH" or "CloudAssistant". Defaults to "SSH".
+	AccessMode *BastionAccessMode
+}
+
+// BastionAccessMode is the way operators connect to the bastion hosts.
+type BastionAccessMode string
+
+const (
+	// BastionAccessModeSSH exposes the bastion hosts with a public IP and allows SSH from the ingress CIDRs.
+	BastionAccessModeSSH BastionAccessMode = "SSH"
+	// BastionAccessModeCloudAssistant creates no public endpoint and no SSH ingress rule. Operators connect with
+	// Cloud Assistant sessions instead. The instance ID is published as hostname in the status.ingress of the Bastion,
+	// the region of the instance is the region of the shoot.
+	BastionAccessModeCloudAssistant BastionAccessMode = "CloudAssistant"
+)
+
+// BastionEIP configures the elastic IP address of the bastion hosts.
+type BastionEIP struct {
+	// PoolTags select a pool of pre-approved EIPs of the account. If set, an available EIP with all of these tags is
+	// associated with the bastion host and returned to the pool on deletion. Otherwise, an EIP is allocated for the
+	// bastion host and released on deletion.
+	PoolTags map[string]string
+	// Tags are the tags of the allocated EIPs.
+	Tags map[string]string
+	// Bandwidth is the maximum bandwidth of the allocated EIPs in Mbps. Defaults to 5.
+	Bandwidth *int32
+}
+
+// BastionImage is the id of the bastion image for a region and architecture.
+type BastionImage struct {
+	// Region is the name of the region.
+	Region string
+	// Architecture is the CPU architecture of the image, either "amd64" or "arm64". Defaults to "amd64".
+	Architecture *string
+	// ID is the id of the image.
+	ID string
 }
 
 // MachineImages is a mapping from logical names and versions to provider-specific identifiers.
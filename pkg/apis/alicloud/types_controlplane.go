@@ -19,6 +19,12 @@ type ControlPlaneConfig struct {
 
 	// CSI is the config for CSI plugin components.
 	CSI *CSI
+
+	// LoadBalancer contains the defaults for the load balancers of the Services of the shoot.
+	LoadBalancer *LoadBalancerConfig
+
+	// Storage contains the configuration of the storage of the shoot.
+	Storage *Storage
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -31,4 +37,123 @@ type CloudControllerManagerConfig struct {
 type CSI struct {
 	// EnableADController enables disks to be attached/detached from controller server of CSI Plugin.
 	EnableADController *bool
+	// NAS contains the configuration of the NAS CSI driver for file storage volumes.
+	NAS *CSINAS
+	// OSS contains the configuration of the OSS CSI driver for volumes backed by OSS buckets.
+	OSS *CSIOSS
+}
+
+// CSINAS contains the configuration of the NAS CSI driver.
+type CSINAS struct {
+	// Enabled deploys the NAS CSI driver and a managed StorageClass for ReadWriteMany volumes.
+	Enabled bool
+}
+
+// CSIOSS contains the configuration of the OSS CSI driver.
+type CSIOSS struct {
+	// Enabled deploys the OSS CSI driver which mounts OSS buckets with ossfs.
+	Enabled bool
+	// Bucket is the name of an OSS bucket in the region of the shoot. If set, a StorageClass "oss" is deployed whose
+	// volumes are directories of the bucket.
+	Bucket *string
+	// CredentialsRef is the name of a resource reference in the resources of the shoot which points to a Secret with
+	// the fields "accessKeyID" and "accessKeySecret". These credentials are used by the OSS CSI driver instead of the
+	// credentials of the cloudprovider secret.
+	CredentialsRef *string
+}
+
+// LoadBalancerConfig contains the defaults for the load balancers of the Services of the shoot.
+type LoadBalancerConfig struct {
+	// Type is the type of the load balancers of Services without load balancer class, either "CLB" or "NLB".
+	// Defaults to the load balancer type of the provider extension.
+	Type *LoadBalancerType
+	// AddressType is the default address type of the load balancers, either "internet" or "intranet".
+	AddressType *LoadBalancerAddressType
+	// Bandwidth is the default maximum bandwidth of the CLBs in Mbit/s. It requires the payment type "PayByBandwidth".
+	Bandwidth *int32
+	// PaymentType is the default metering method of the internet traffic of the CLBs, either "PayByTraffic" or
+	// "PayByBandwidth".
+	PaymentType *LoadBalancerPaymentType
+	// Spec is the default specification of the CLBs, e.g. "slb.s1.small".
+	Spec *string
+}
+
+// LoadBalancerType is the type of an Alicloud load balancer.
+type LoadBalancerType string
+
+const (
+	// LoadBalancerTypeCLB is the Classic Load Balancer.
+	LoadBalancerTypeCLB LoadBalancerType = "CLB"
+	// LoadBalancerTypeNLB is the Network Load Balancer.
+	LoadBalancerTypeNLB LoadBalancerType = "NLB"
+)
+
+// LoadBalancerAddressType is the address type of an Alicloud load balancer.
+type LoadBalancerAddressType string
+
+const (
+	// LoadBalancerAddressTypeInternet is the address type of load balancers reachable from the internet.
+	LoadBalancerAddressTypeInternet LoadBalancerAddressType = "internet"
+	// LoadBalancerAddressTypeIntranet is the address type of load balancers only reachable from the VPC.
+	LoadBalancerAddressTypeIntranet LoadBalancerAddressType = "intranet"
+)
+
+// LoadBalancerPaymentType is the metering method of the internet traffic of an Alicloud CLB.
+type LoadBalancerPaymentType string
+
+const (
+	// LoadBalancerPaymentTypePayByTraffic meters the internet traffic of a CLB by data transfer.
+	LoadBalancerPaymentTypePayByTraffic LoadBalancerPaymentType = "PayByTraffic"
+	// LoadBalancerPaymentTypePayByBandwidth meters the internet traffic of a CLB by its maximum bandwidth.
+	LoadBalancerPaymentTypePayByBandwidth LoadBalancerPaymentType = "PayByBandwidth"
+)
+
+// Storage contains the configuration of the storage of the shoot.
+type Storage struct {
+	// StorageClasses are the StorageClasses deployed to the shoot. If not set, a default StorageClass for encrypted
+	// ESSDs is deployed.
+	StorageClasses []StorageClass
+	// VolumeSnapshotClass is the default VolumeSnapshotClass of the disks deployed to the shoot. If not set, no
+	// VolumeSnapshotClass is deployed.
+	VolumeSnapshotClass *VolumeSnapshotClass
+}
+
+// VolumeSnapshotClass is the default VolumeSnapshotClass of the disks of the shoot.
+type VolumeSnapshotClass struct {
+	// Name is the name of the VolumeSnapshotClass. Defaults to "default".
+	Name *string
+	// DeletionPolicy is the deletion policy of the snapshots, either "Delete" or "Retain". Defaults to "Delete".
+	DeletionPolicy *string
+	// InstantAccess enables the instant access of the snapshots, so that they can be used right after their creation.
+	InstantAccess *bool
+	// InstantAccessRetentionDays is the number of days the instant access of the snapshots is retained. Defaults to
+	// the retention of the snapshots.
+	InstantAccessRetentionDays *int32
+	// RetentionDays is the number of days after which the snapshots are released automatically. If not set, the
+	// snapshots are retained until they are deleted.
+	RetentionDays *int32
+}
+
+// StorageClass is a StorageClass of the disks of the shoot.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string
+	// Default marks the StorageClass as the default StorageClass of the shoot. At most one StorageClass can be the
+	// default.
+	Default *bool
+	// ReclaimPolicy is the reclaim policy of the volumes of the StorageClass, either "Delete" or "Retain". Defaults
+	// to "Delete".
+	ReclaimPolicy *string
+	// Type is the category of the disks, e.g. "cloud_essd" or "cloud_auto". Defaults to "cloud_essd".
+	Type *string
+	// PerformanceLevel is the performance level of ESSDs, one of "PL0", "PL1", "PL2" or "PL3".
+	PerformanceLevel *string
+	// ProvisionedIOPS are the IOPS provisioned in addition to the baseline performance of ESSD AutoPL disks.
+	ProvisionedIOPS *int64
+	// FSType is the file system of the volumes, one of "ext4", "ext3" or "xfs". Defaults to "ext4".
+	FSType *string
+	// Encrypted specifies whether the disks are encrypted. Defaults to true.
+	Encrypted *bool
+	// KMSKeyID is the ID of the KMS key the disks are encrypted with. Defaults to the default service key of ECS.
+	KMSKeyID *string
 }
@@ -19,6 +19,41 @@ type InfrastructureConfig struct {
 
 	// Networks specifies the networks for an infrastructure.
 	Networks Networks
+
+	// DeletionProtection enables the deletion protection of the NAT gateway and the EIPs created for the shoot.
+	// The protection is lifted right before the resources are deleted together with the shoot.
+	DeletionProtection *bool
+
+	// RetainEIPs keeps the EIPs of the NAT gateway of deleted zones in a pool of the shoot instead of releasing them.
+	// The pooled EIPs are reused when zones are created again and are released together with the shoot.
+	RetainEIPs *bool
+
+	// FlowLogs enables VPC flow logs for the vswitches of the shoot.
+	FlowLogs *FlowLogs
+
+	// NAS creates a NAS file system with a mount target in every zone of the shoot, which backs the volumes of the
+	// NAS CSI driver.
+	NAS *NAS
+}
+
+// NAS contains the configuration of the NAS file system of the shoot.
+type NAS struct {
+	// StorageType is the storage type of the file system, either "Capacity" or "Performance". Defaults to "Capacity".
+	StorageType *string
+}
+
+// FlowLogs contains the configuration of the VPC flow logs of the shoot vswitches.
+type FlowLogs struct {
+	// Project is the name of the Simple Log Service (SLS) project the flow logs are delivered to. It must already
+	// exist unless Create is set.
+	Project string
+	// Logstore is the name of the logstore in the SLS project. It must already exist unless Create is set.
+	Logstore string
+	// TrafficType is the type of the logged traffic, one of "All", "Allow" or "Drop". Defaults to "All".
+	TrafficType *string
+	// Create creates the SLS project and the logstore if they do not exist. They are tagged like the other resources
+	// of the shoot and deleted together with the shoot.
+	Create *bool
 }
 
 // Networks specifies the networks for an infrastructure.
@@ -28,6 +63,10 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone
+
+	// WorkersPrefixLength is the prefix length of the worker CIDRs which are allocated for zones without a worker CIDR.
+	// +optional
+	WorkersPrefixLength *int32
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -45,8 +84,29 @@ type VPC struct {
 	// UseCustomRouteTable indicates whether Gardener should create a custom route table for this shoot.
 	// +optional
 	UseCustomRouteTable *bool
+	// SecondaryCIDRs are additional IPv4 CIDR blocks which are associated with the VPC.
+	// +optional
+	SecondaryCIDRs []string
+	// EgressMode is the mode of the egress traffic. Defaults to "NATGateway".
+	// "None" can only be used with an existing VPC and relies on its default route.
+	// +optional
+	EgressMode *EgressMode
+	// EgressCIDRs are the CIDRs of the egress traffic reported in the status if the egress mode is "None".
+	// +optional
+	EgressCIDRs []string
 }
 
+// EgressMode is the mode of the egress traffic of the shoot.
+type EgressMode string
+
+const (
+	// EgressModeNATGateway translates the egress traffic by NAT gateways.
+	EgressModeNATGateway EgressMode = "NATGateway"
+	// EgressModeNone creates no NAT gateways and relies on an existing default route of the VPC, e.g. to a central
+	// firewall VPC.
+	EgressModeNone EgressMode = "None"
+)
+
 // VPCStatus contains output information about the VPC.
 type VPCStatus struct {
 	// ID is the ID of the VPC.
@@ -103,14 +163,33 @@ type Zone struct {
 	Ipv6CidrBlock *int
 	// NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 	NatGateway *NatGatewayConfig
+	// VSwitchID is the ID of an existing vswitch which is used for the zone instead of creating one.
+	// It can only be used with an existing VPC. The vswitch is never modified or deleted.
+	VSwitchID *string
 }
 
 // NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 type NatGatewayConfig struct {
+	// Type is the type of the NAT gateway used for the egress traffic of this zone. Defaults to "Internet".
+	Type *NatGatewayType
 	// EIPAllocationID specifies the EIP to bind on NatGateway.
 	EIPAllocationID *string
+	// SNATIPAddress is the private IP address the egress traffic of this zone is translated to by a NAT gateway of
+	// type VPC. It must be in the workers CIDR of the first zone of type VPC, which hosts the VPC NAT gateway.
+	// Defaults to the private IP address of the VPC NAT gateway.
+	SNATIPAddress *string
 }
 
+// NatGatewayType is the type of a NAT gateway.
+type NatGatewayType string
+
+const (
+	// NatGatewayTypeInternet is a NAT gateway which translates the egress traffic to the addresses of EIPs.
+	NatGatewayTypeInternet NatGatewayType = "Internet"
+	// NatGatewayTypeVPC is a NAT gateway which translates the egress traffic to a private IP address of the VPC.
+	NatGatewayTypeVPC NatGatewayType = "VPC"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -130,10 +209,43 @@ type InfrastructureStatus struct {
 	// it cannot reconcile anymore existing `Infrastructure` resources that are still using this version. Hence, it stores
 	// the used versions in the provider status to ensure reconciliation is possible.
 	MachineImages []MachineImage
+
+	// NAS contains information about the NAS file system of the shoot.
+	NAS *NASStatus
+}
+
+// NASStatus contains information about the NAS file system of the shoot.
+type NASStatus struct {
+	// FileSystemID is the ID of the NAS file system.
+	FileSystemID string
+	// MountTargets are the mount targets of the file system in the zones of the shoot.
+	MountTargets []NASMountTarget
+}
+
+// NASMountTarget contains information about a mount target of the NAS file system.
+type NASMountTarget struct {
+	// Zone is the name of the zone.
+	Zone string
+	// Domain is the domain name of the mount target.
+	Domain string
 }
 
 // DualStack specifies whether dual-stack or IPv4-only should be supported.
 type DualStack struct {
 	// Enabled specifies if dual-stack is enabled or not.
 	Enabled bool
+	// InternetBandwidth opens IPv6 internet bandwidth for the IPv6 addresses of the nodes.
+	InternetBandwidth *IPv6InternetBandwidth
+}
+
+// IPv6InternetBandwidth contains the configuration of the IPv6 internet bandwidth of the nodes.
+type IPv6InternetBandwidth struct {
+	// Bandwidth is the IPv6 internet bandwidth of each node address in Mbit/s.
+	Bandwidth int32
+	// InternetChargeType is the billing method of the bandwidth, one of "PayByTraffic" or "PayByBandwidth".
+	// Defaults to "PayByTraffic".
+	InternetChargeType *string
+	// EgressOnly restricts the node addresses to outbound IPv6 internet traffic with egress-only rules on the
+	// IPv6 gateway. Defaults to true.
+	EgressOnly *bool
 }
@@ -44,6 +44,7 @@ func addKnownTypes(scheme *runtime.Scheme) error {
 		&ControlPlaneConfig{},
 		&WorkerStatus{},
 		&BackupBucketConfig{},
+		&BastionStatus{},
 	)
 	return nil
 }
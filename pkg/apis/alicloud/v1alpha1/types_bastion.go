@@ -0,0 +1,27 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package v1alpha1
+
+import (
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+)
+
+// +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
+
+// BastionStatus contains information about the created bastion host.
+type BastionStatus struct {
+	metav1.TypeMeta `json:",inline"`
+
+	// Zone is the zone of the bastion host.
+	Zone string `json:"zone"`
+	// VSwitchID is the id of the vswitch of the bastion host.
+	VSwitchID string `json:"vswitchID"`
+	// InstanceID is the id of the bastion instance. It is only set for Cloud Assistant sessions.
+	// +optional
+	InstanceID string `json:"instanceID,omitempty"`
+	// Region is the region of the bastion instance. It is only set for Cloud Assistant sessions.
+	// +optional
+	Region string `json:"region,omitempty"`
+}
@@ -22,6 +22,14 @@ type ControlPlaneConfig struct {
 	// CSI is the config for CSI plugin components.
 	// +optional
 	CSI *CSI `json:"csi,omitempty"`
+
+	// LoadBalancer contains the defaults for the load balancers of the Services of the shoot.
+	// +optional
+	LoadBalancer *LoadBalancerConfig `json:"loadBalancer,omitempty"`
+
+	// Storage contains the configuration of the storage of the shoot.
+	// +optional
+	Storage *Storage `json:"storage,omitempty"`
 }
 
 // CloudControllerManagerConfig contains configuration settings for the cloud-controller-manager.
@@ -36,4 +44,147 @@ type CSI struct {
 	// EnableADController enables disks to be attached/detached from controller server of CSI Plugin.
 	// +optional
 	EnableADController *bool `json:"enableADController,omitempty"`
+	// NAS contains the configuration of the NAS CSI driver for file storage volumes.
+	// +optional
+	NAS *CSINAS `json:"nas,omitempty"`
+	// OSS contains the configuration of the OSS CSI driver for volumes backed by OSS buckets.
+	// +optional
+	OSS *CSIOSS `json:"oss,omitempty"`
+}
+
+// CSINAS contains the configuration of the NAS CSI driver.
+type CSINAS struct {
+	// Enabled deploys the NAS CSI driver and a managed StorageClass for ReadWriteMany volumes.
+	Enabled bool `json:"enabled"`
+}
+
+// CSIOSS contains the configuration of the OSS CSI driver.
+type CSIOSS struct {
+	// Enabled deploys the OSS CSI driver which mounts OSS buckets with ossfs.
+	Enabled bool `json:"enabled"`
+	// Bucket is the name of an OSS bucket in the region of the shoot. If set, a StorageClass "oss" is deployed whose
+	// volumes are directories of the bucket.
+	// +optional
+	Bucket *string `json:"bucket,omitempty"`
+	// CredentialsRef is the name of a resource reference in the resources of the shoot which points to a Secret with
+	// the fields "accessKeyID" and "accessKeySecret". These credentials are used by the OSS CSI driver instead of the
+	// credentials of the cloudprovider secret.
+	// +optional
+	CredentialsRef *string `json:"credentialsRef,omitempty"`
+}
+
+// LoadBalancerConfig contains the defaults for the load balancers of the Services of the shoot.
+type LoadBalancerConfig struct {
+	// Type is the type of the load balancers of Services without load balancer class, either "CLB" or "NLB".
+	// Defaults to the load balancer type of the provider extension.
+	// +optional
+	Type *LoadBalancerType `json:"type,omitempty"`
+	// AddressType is the default address type of the load balancers, either "internet" or "intranet".
+	// +optional
+	AddressType *LoadBalancerAddressType `json:"addressType,omitempty"`
+	// Bandwidth is the default maximum bandwidth of the CLBs in Mbit/s. It requires the payment type "PayByBandwidth".
+	// +optional
+	Bandwidth *int32 `json:"bandwidth,omitempty"`
+	// PaymentType is the default metering method of the internet traffic of the CLBs, either "PayByTraffic" or
+	// "PayByBandwidth".
+	// +optional
+	PaymentType *LoadBalancerPaymentType `json:"paymentType,omitempty"`
+	// Spec is the default specification of the CLBs, e.g. "slb.s1.small".
+	// +optional
+	Spec *string `json:"spec,omitempty"`
+}
+
+// LoadBalancerType is the type of an Alicloud load balancer.
+type LoadBalancerType string
+
+const (
+	// LoadBalancerTypeCLB is the Classic Load Balancer.
+	LoadBalancerTypeCLB LoadBalancerType = "CLB"
+	// LoadBalancerTypeNLB is the Network Load Balancer.
+	LoadBalancerTypeNLB LoadBalancerType = "NLB"
+)
+
+// LoadBalancerAddressType is the address type of an Alicloud load balancer.
+type LoadBalancerAddressType string
+
+const (
+	// LoadBalancerAddressTypeInternet is the address type of load balancers reachable from the internet.
+	LoadBalancerAddressTypeInternet LoadBalancerAddressType = "internet"
+	// LoadBalancerAddressTypeIntranet is the address type of load balancers only reachable from the VPC.
+	LoadBalancerAddressTypeIntranet LoadBalancerAddressType = "intranet"
+)
+
+// LoadBalancerPaymentType is the metering method of the internet traffic of an Alicloud CLB.
+type LoadBalancerPaymentType string
+
+const (
+	// LoadBalancerPaymentTypePayByTraffic meters the internet traffic of a CLB by data transfer.
+	LoadBalancerPaymentTypePayByTraffic LoadBalancerPaymentType = "PayByTraffic"
+	// LoadBalancerPaymentTypePayByBandwidth meters the internet traffic of a CLB by its maximum bandwidth.
+	LoadBalancerPaymentTypePayByBandwidth LoadBalancerPaymentType = "PayByBandwidth"
+)
+
+// Storage contains the configuration of the storage of the shoot.
+type Storage struct {
+	// StorageClasses are the StorageClasses deployed to the shoot. If not set, a default StorageClass for encrypted
+	// ESSDs is deployed.
+	// +optional
+	StorageClasses []StorageClass `json:"storageClasses,omitempty"`
+	// VolumeSnapshotClass is the default VolumeSnapshotClass of the disks deployed to the shoot. If not set, no
+	// VolumeSnapshotClass is deployed.
+	// +optional
+	VolumeSnapshotClass *VolumeSnapshotClass `json:"volumeSnapshotClass,omitempty"`
+}
+
+// VolumeSnapshotClass is the default VolumeSnapshotClass of the disks of the shoot.
+type VolumeSnapshotClass struct {
+	// Name is the name of the VolumeSnapshotClass. Defaults to "default".
+	// +optional
+	Name *string `json:"name,omitempty"`
+	// DeletionPolicy is the deletion policy of the snapshots, either "Delete" or "Retain". Defaults to "Delete".
+	// +optional
+	DeletionPolicy *string `json:"deletionPolicy,omitempty"`
+	// InstantAccess enables the instant access of the snapshots, so that they can be used right after their creation.
+	// +optional
+	InstantAccess *bool `json:"instantAccess,omitempty"`
+	// InstantAccessRetentionDays is the number of days the instant access of the snapshots is retained. Defaults to
+	// the retention of the snapshots.
+	// +optional
+	InstantAccessRetentionDays *int32 `json:"instantAccessRetentionDays,omitempty"`
+	// RetentionDays is the number of days after which the snapshots are released automatically. If not set, the
+	// snapshots are retained until they are deleted.
+	// +optional
+	RetentionDays *int32 `json:"retentionDays,omitempty"`
+}
+
+// StorageClass is a StorageClass of the disks of the shoot.
+type StorageClass struct {
+	// Name is the name of the StorageClass.
+	Name string `json:"name"`
+	// Default marks the StorageClass as the default StorageClass of the shoot. At most one StorageClass can be the
+	// default.
+	// +optional
+	Default *bool `json:"default,omitempty"`
+	// ReclaimPolicy is the reclaim policy of the volumes of the StorageClass, either "Delete" or "Retain". Defaults
+	// to "Delete".
+	// +optional
+	ReclaimPolicy *string `json:"reclaimPolicy,omitempty"`
+	// Type is the category of the disks, e.g. "cloud_essd" or "cloud_auto". Defaults to "cloud_essd".
+	// +optional
+	Type *string `json:"type,omitempty"`
+	// PerformanceLevel is the performance level of ESSDs, one of "PL0", "PL1", "PL2" or "PL3".
+	// +optional
+	PerformanceLevel *string `json:"performanceLevel,omitempty"`
+	// ProvisionedIOPS are the IOPS provisioned in addition to the baseline performance of ESSD AutoPL disks.
+	// +optional
+	ProvisionedIOPS *int64 `json:"provisionedIOPS,omitempty"`
+	// FSType is the file system of the volumes, one of "ext4", "ext3" or "xfs". Defaults to "ext4".
+	// +optional
+	FSType *string `json:"fsType,omitempty"`
+	// Encrypted specifies whether the disks are encrypted. Defaults to true.
+	// +optional
+	Encrypted *bool `json:"encrypted,omitempty"`
+	// KMSKeyID is the ID of the KMS key the disks are encrypted with. Defaults to the default service key of ECS.
+	// +optional
+	KMSKeyID *string `json:"kmsKeyID,omitempty"`
 }
@@ -20,6 +20,48 @@ type InfrastructureConfig struct {
 
 	// Networks specifies the networks for an infrastructure.
 	Networks Networks `json:"networks"`
+
+	// DeletionProtection enables the deletion protection of the NAT gateway and the EIPs created for the shoot.
+	// The protection is lifted right before the resources are deleted together with the shoot.
+	// +optional
+	DeletionProtection *bool `json:"deletionProtection,omitempty"`
+
+	// RetainEIPs keeps the EIPs of the NAT gateway of deleted zones in a pool of the shoot instead of releasing them.
+	// The pooled EIPs are reused when zones are created again and are released together with the shoot.
+	// +optional
+	RetainEIPs *bool `json:"retainEIPs,omitempty"`
+
+	// FlowLogs enables VPC flow logs for the vswitches of the shoot.
+	// +optional
+	FlowLogs *FlowLogs `json:"flowLogs,omitempty"`
+
+	// NAS creates a NAS file system with a mount target in every zone of the shoot, which backs the volumes of the
+	// NAS CSI driver.
+	// +optional
+	NAS *NAS `json:"nas,omitempty"`
+}
+
+// NAS contains the configuration of the NAS file system of the shoot.
+type NAS struct {
+	// StorageType is the storage type of the file system, either "Capacity" or "Performance". Defaults to "Capacity".
+	// +optional
+	StorageType *string `json:"storageType,omitempty"`
+}
+
+// FlowLogs contains the configuration of the VPC flow logs of the shoot vswitches.
+type FlowLogs struct {
+	// Project is the name of the Simple Log Service (SLS) project the flow logs are delivered to. It must already
+	// exist unless Create is set.
+	Project string `json:"project"`
+	// Logstore is the name of the logstore in the SLS project. It must already exist unless Create is set.
+	Logstore string `json:"logstore"`
+	// TrafficType is the type of the logged traffic, one of "All", "Allow" or "Drop". Defaults to "All".
+	// +optional
+	TrafficType *string `json:"trafficType,omitempty"`
+	// Create creates the SLS project and the logstore if they do not exist. They are tagged like the other resources
+	// of the shoot and deleted together with the shoot.
+	// +optional
+	Create *bool `json:"create,omitempty"`
 }
 
 // Networks specifies the networks for an infrastructure.
@@ -29,6 +71,11 @@ type Networks struct {
 
 	// Zones are the network zones for an infrastructure.
 	Zones []Zone `json:"zones"`
+
+	// WorkersPrefixLength is the prefix length of the worker CIDRs which are allocated for zones without a worker CIDR.
+	// Defaults to 24.
+	// +optional
+	WorkersPrefixLength *int32 `json:"workersPrefixLength,omitempty"`
 }
 
 // VPC contains information about whether to create a new or use an existing VPC.
@@ -46,8 +93,30 @@ type VPC struct {
 	// UseCustomRouteTable indicates whether Gardener should create a custom route table for this shoot.
 	// +optional
 	UseCustomRouteTable *bool `json:"useCustomRouteTable,omitempty"`
+	// SecondaryCIDRs are additional IPv4 CIDR blocks which are associated with the VPC.
+	// Zone CIDRs may be taken from the VPC CIDR or any of the secondary CIDRs.
+	// +optional
+	SecondaryCIDRs []string `json:"secondaryCIDRs,omitempty"`
+	// EgressMode is the mode of the egress traffic. Defaults to "NATGateway".
+	// "None" can only be used with an existing VPC and relies on its default route.
+	// +optional
+	EgressMode *EgressMode `json:"egressMode,omitempty"`
+	// EgressCIDRs are the CIDRs of the egress traffic reported in the status if the egress mode is "None".
+	// +optional
+	EgressCIDRs []string `json:"egressCIDRs,omitempty"`
 }
 
+// EgressMode is the mode of the egress traffic of the shoot.
+type EgressMode string
+
+const (
+	// EgressModeNATGateway translates the egress traffic by NAT gateways.
+	EgressModeNATGateway EgressMode = "NATGateway"
+	// EgressModeNone creates no NAT gateways and relies on an existing default route of the VPC, e.g. to a central
+	// firewall VPC.
+	EgressModeNone EgressMode = "None"
+)
+
 // VPCStatus contains output information about the VPC.
 type VPCStatus struct {
 	// ID is the ID of the VPC.
@@ -106,15 +175,37 @@ type Zone struct {
 	// NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 	// +optional
 	NatGateway *NatGatewayConfig `json:"natGateway,omitempty"`
+	// VSwitchID is the ID of an existing vswitch which is used for the zone instead of creating one.
+	// It can only be used with an existing VPC. The vswitch is never modified or deleted.
+	// +optional
+	VSwitchID *string `json:"vswitchID,omitempty"`
 }
 
 // NatGatewayConfig specifies configuration for the NAT gateway in this zone.
 type NatGatewayConfig struct {
+	// Type is the type of the NAT gateway used for the egress traffic of this zone. Defaults to "Internet".
+	// +optional
+	Type *NatGatewayType `json:"type,omitempty"`
 	// EIPAllocationID specifies the EIP id to bind on NatGateway.
 	// +optional
 	EIPAllocationID *string `json:"eipAllocationID,omitempty"`
+	// SNATIPAddress is the private IP address the egress traffic of this zone is translated to by a NAT gateway of
+	// type VPC. It must be in the workers CIDR of the first zone of type VPC, which hosts the VPC NAT gateway.
+	// Defaults to the private IP address of the VPC NAT gateway.
+	// +optional
+	SNATIPAddress *string `json:"snatIPAddress,omitempty"`
 }
 
+// NatGatewayType is the type of a NAT gateway.
+type NatGatewayType string
+
+const (
+	// NatGatewayTypeInternet is a NAT gateway which translates the egress traffic to the addresses of EIPs.
+	NatGatewayTypeInternet NatGatewayType = "Internet"
+	// NatGatewayTypeVPC is a NAT gateway which translates the egress traffic to a private IP address of the VPC.
+	NatGatewayTypeVPC NatGatewayType = "VPC"
+)
+
 // +k8s:deepcopy-gen:interfaces=k8s.io/apimachinery/pkg/runtime.Object
 
 // InfrastructureStatus contains information about created infrastructure resources.
@@ -135,10 +226,48 @@ type InfrastructureStatus struct {
 	// the used versions in the provider status to ensure reconciliation is possible.
 	// +optional
 	MachineImages []MachineImage `json:"machineImages,omitempty"`
+
+	// NAS contains information about the NAS file system of the shoot.
+	// +optional
+	NAS *NASStatus `json:"nas,omitempty"`
+}
+
+// NASStatus contains information about the NAS file system of the shoot.
+type NASStatus struct {
+	// FileSystemID is the ID of the NAS file system.
+	FileSystemID string `json:"fileSystemID"`
+	// MountTargets are the mount targets of the file system in the zones of the shoot.
+	// +optional
+	MountTargets []NASMountTarget `json:"mountTargets,omitempty"`
+}
+
+// NASMountTarget contains information about a mount target of the NAS file system.
+type NASMountTarget struct {
+	// Zone is the name of the zone.
+	Zone string `json:"zone"`
+	// Domain is the domain name of the mount target.
+	Domain string `json:"domain"`
 }
 
 // DualStack specifies whether dual-stack or IPv4-only should be supported.
 type DualStack struct {
 	// Enabled specifies if dual-stack is enabled or not.
 	Enabled bool `json:"enabled"`
+	// InternetBandwidth opens IPv6 internet bandwidth for the IPv6 addresses of the nodes.
+	// +optional
+	InternetBandwidth *IPv6InternetBandwidth `json:"internetBandwidth,omitempty"`
+}
+
+// IPv6InternetBandwidth contains the configuration of the IPv6 internet bandwidth of the nodes.
+type IPv6InternetBandwidth struct {
+	// Bandwidth is the IPv6 internet bandwidth of each node address in Mbit/s.
+	Bandwidth int32 `json:"bandwidth"`
+	// InternetChargeType is the billing method of the bandwidth, one of "PayByTraffic" or "PayByBandwidth".
+	// Defaults to "PayByTraffic".
+	// +optional
+	InternetChargeType *string `json:"internetChargeType,omitempty"`
+	// EgressOnly restricts the node addresses to outbound IPv6 internet traffic with egress-only rules on the
+	// IPv6 gateway. Defaults to true.
+	// +optional
+	EgressOnly *bool `json:"egressOnly,omitempty"`
 }
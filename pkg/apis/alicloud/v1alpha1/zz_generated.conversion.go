@@ -35,6 +35,46 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*BastionConfig)(nil), (*alicloud.BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionConfig_To_alicloud_BastionConfig(a.(*BastionConfig), b.(*alicloud.BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BastionConfig)(nil), (*BastionConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BastionConfig_To_v1alpha1_BastionConfig(a.(*alicloud.BastionConfig), b.(*BastionConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionEIP)(nil), (*alicloud.BastionEIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionEIP_To_alicloud_BastionEIP(a.(*BastionEIP), b.(*alicloud.BastionEIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BastionEIP)(nil), (*BastionEIP)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BastionEIP_To_v1alpha1_BastionEIP(a.(*alicloud.BastionEIP), b.(*BastionEIP), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionImage)(nil), (*alicloud.BastionImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionImage_To_alicloud_BastionImage(a.(*BastionImage), b.(*alicloud.BastionImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BastionImage)(nil), (*BastionImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BastionImage_To_v1alpha1_BastionImage(a.(*alicloud.BastionImage), b.(*BastionImage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*BastionStatus)(nil), (*alicloud.BastionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_BastionStatus_To_alicloud_BastionStatus(a.(*BastionStatus), b.(*alicloud.BastionStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.BastionStatus)(nil), (*BastionStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_BastionStatus_To_v1alpha1_BastionStatus(a.(*alicloud.BastionStatus), b.(*BastionStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CSI)(nil), (*alicloud.CSI)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CSI_To_alicloud_CSI(a.(*CSI), b.(*alicloud.CSI), scope)
 	}); err != nil {
@@ -45,6 +85,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*CSINAS)(nil), (*alicloud.CSINAS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSINAS_To_alicloud_CSINAS(a.(*CSINAS), b.(*alicloud.CSINAS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.CSINAS)(nil), (*CSINAS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_CSINAS_To_v1alpha1_CSINAS(a.(*alicloud.CSINAS), b.(*CSINAS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*CSIOSS)(nil), (*alicloud.CSIOSS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_CSIOSS_To_alicloud_CSIOSS(a.(*CSIOSS), b.(*alicloud.CSIOSS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.CSIOSS)(nil), (*CSIOSS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_CSIOSS_To_v1alpha1_CSIOSS(a.(*alicloud.CSIOSS), b.(*CSIOSS), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*CloudControllerManagerConfig)(nil), (*alicloud.CloudControllerManagerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(a.(*CloudControllerManagerConfig), b.(*alicloud.CloudControllerManagerConfig), scope)
 	}); err != nil {
@@ -85,6 +145,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*FlowLogs)(nil), (*alicloud.FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_FlowLogs_To_alicloud_FlowLogs(a.(*FlowLogs), b.(*alicloud.FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.FlowLogs)(nil), (*FlowLogs)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_FlowLogs_To_v1alpha1_FlowLogs(a.(*alicloud.FlowLogs), b.(*FlowLogs), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*IPv6InternetBandwidth)(nil), (*alicloud.IPv6InternetBandwidth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_IPv6InternetBandwidth_To_alicloud_IPv6InternetBandwidth(a.(*IPv6InternetBandwidth), b.(*alicloud.IPv6InternetBandwidth), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.IPv6InternetBandwidth)(nil), (*IPv6InternetBandwidth)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_IPv6InternetBandwidth_To_v1alpha1_IPv6InternetBandwidth(a.(*alicloud.IPv6InternetBandwidth), b.(*IPv6InternetBandwidth), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*ImmutableConfig)(nil), (*alicloud.ImmutableConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_ImmutableConfig_To_alicloud_ImmutableConfig(a.(*ImmutableConfig), b.(*alicloud.ImmutableConfig), scope)
 	}); err != nil {
@@ -115,6 +195,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*LoadBalancerConfig)(nil), (*alicloud.LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_LoadBalancerConfig_To_alicloud_LoadBalancerConfig(a.(*LoadBalancerConfig), b.(*alicloud.LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.LoadBalancerConfig)(nil), (*LoadBalancerConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(a.(*alicloud.LoadBalancerConfig), b.(*LoadBalancerConfig), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*MachineImage)(nil), (*alicloud.MachineImage)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_MachineImage_To_alicloud_MachineImage(a.(*MachineImage), b.(*alicloud.MachineImage), scope)
 	}); err != nil {
@@ -155,6 +245,36 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*NAS)(nil), (*alicloud.NAS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NAS_To_alicloud_NAS(a.(*NAS), b.(*alicloud.NAS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.NAS)(nil), (*NAS)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_NAS_To_v1alpha1_NAS(a.(*alicloud.NAS), b.(*NAS), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NASMountTarget)(nil), (*alicloud.NASMountTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NASMountTarget_To_alicloud_NASMountTarget(a.(*NASMountTarget), b.(*alicloud.NASMountTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.NASMountTarget)(nil), (*NASMountTarget)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_NASMountTarget_To_v1alpha1_NASMountTarget(a.(*alicloud.NASMountTarget), b.(*NASMountTarget), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*NASStatus)(nil), (*alicloud.NASStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_NASStatus_To_alicloud_NASStatus(a.(*NASStatus), b.(*alicloud.NASStatus), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.NASStatus)(nil), (*NASStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_NASStatus_To_v1alpha1_NASStatus(a.(*alicloud.NASStatus), b.(*NASStatus), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*NatGatewayConfig)(nil), (*alicloud.NatGatewayConfig)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(a.(*NatGatewayConfig), b.(*alicloud.NatGatewayConfig), scope)
 	}); err != nil {
@@ -195,6 +315,26 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*Storage)(nil), (*alicloud.Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_Storage_To_alicloud_Storage(a.(*Storage), b.(*alicloud.Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.Storage)(nil), (*Storage)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_Storage_To_v1alpha1_Storage(a.(*alicloud.Storage), b.(*Storage), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*StorageClass)(nil), (*alicloud.StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_StorageClass_To_alicloud_StorageClass(a.(*StorageClass), b.(*alicloud.StorageClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.StorageClass)(nil), (*StorageClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_StorageClass_To_v1alpha1_StorageClass(a.(*alicloud.StorageClass), b.(*StorageClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*VPC)(nil), (*alicloud.VPC)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_VPC_To_alicloud_VPC(a.(*VPC), b.(*alicloud.VPC), scope)
 	}); err != nil {
@@ -225,6 +365,16 @@ func RegisterConversions(s *runtime.Scheme) error {
 	}); err != nil {
 		return err
 	}
+	if err := s.AddGeneratedConversionFunc((*VolumeSnapshotClass)(nil), (*alicloud.VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_v1alpha1_VolumeSnapshotClass_To_alicloud_VolumeSnapshotClass(a.(*VolumeSnapshotClass), b.(*alicloud.VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
+	if err := s.AddGeneratedConversionFunc((*alicloud.VolumeSnapshotClass)(nil), (*VolumeSnapshotClass)(nil), func(a, b interface{}, scope conversion.Scope) error {
+		return Convert_alicloud_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(a.(*alicloud.VolumeSnapshotClass), b.(*VolumeSnapshotClass), scope)
+	}); err != nil {
+		return err
+	}
 	if err := s.AddGeneratedConversionFunc((*WorkerStatus)(nil), (*alicloud.WorkerStatus)(nil), func(a, b interface{}, scope conversion.Scope) error {
 		return Convert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(a.(*WorkerStatus), b.(*alicloud.WorkerStatus), scope)
 	}); err != nil {
@@ -268,8 +418,110 @@ func Convert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in *alic
 	return autoConvert_alicloud_BackupBucketConfig_To_v1alpha1_BackupBucketConfig(in, out, s)
 }
 
+func autoConvert_v1alpha1_BastionConfig_To_alicloud_BastionConfig(in *BastionConfig, out *alicloud.BastionConfig, s conversion.Scope) error {
+	out.Images = *(*[]alicloud.BastionImage)(unsafe.Pointer(&in.Images))
+	out.InstanceTypes = *(*[]string)(unsafe.Pointer(&in.InstanceTypes))
+	out.EIP = (*alicloud.BastionEIP)(unsafe.Pointer(in.EIP))
+	out.AccessMode = (*alicloud.BastionAccessMode)(unsafe.Pointer(in.AccessMode))
+	return nil
+}
+
+// Convert_v1alpha1_BastionConfig_To_alicloud_BastionConfig is an autogenerated conversion function.
+func Convert_v1alpha1_BastionConfig_To_alicloud_BastionConfig(in *BastionConfig, out *alicloud.BastionConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionConfig_To_alicloud_BastionConfig(in, out, s)
+}
+
+func autoConvert_alicloud_BastionConfig_To_v1alpha1_BastionConfig(in *alicloud.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	out.Images = *(*[]BastionImage)(unsafe.Pointer(&in.Images))
+	out.InstanceTypes = *(*[]string)(unsafe.Pointer(&in.InstanceTypes))
+	out.EIP = (*BastionEIP)(unsafe.Pointer(in.EIP))
+	out.AccessMode = (*BastionAccessMode)(unsafe.Pointer(in.AccessMode))
+	return nil
+}
+
+// Convert_alicloud_BastionConfig_To_v1alpha1_BastionConfig is an autogenerated conversion function.
+func Convert_alicloud_BastionConfig_To_v1alpha1_BastionConfig(in *alicloud.BastionConfig, out *BastionConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_BastionConfig_To_v1alpha1_BastionConfig(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionEIP_To_alicloud_BastionEIP(in *BastionEIP, out *alicloud.BastionEIP, s conversion.Scope) error {
+	out.PoolTags = *(*map[string]string)(unsafe.Pointer(&in.PoolTags))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	return nil
+}
+
+// Convert_v1alpha1_BastionEIP_To_alicloud_BastionEIP is an autogenerated conversion function.
+func Convert_v1alpha1_BastionEIP_To_alicloud_BastionEIP(in *BastionEIP, out *alicloud.BastionEIP, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionEIP_To_alicloud_BastionEIP(in, out, s)
+}
+
+func autoConvert_alicloud_BastionEIP_To_v1alpha1_BastionEIP(in *alicloud.BastionEIP, out *BastionEIP, s conversion.Scope) error {
+	out.PoolTags = *(*map[string]string)(unsafe.Pointer(&in.PoolTags))
+	out.Tags = *(*map[string]string)(unsafe.Pointer(&in.Tags))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	return nil
+}
+
+// Convert_alicloud_BastionEIP_To_v1alpha1_BastionEIP is an autogenerated conversion function.
+func Convert_alicloud_BastionEIP_To_v1alpha1_BastionEIP(in *alicloud.BastionEIP, out *BastionEIP, s conversion.Scope) error {
+	return autoConvert_alicloud_BastionEIP_To_v1alpha1_BastionEIP(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionImage_To_alicloud_BastionImage(in *BastionImage, out *alicloud.BastionImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_v1alpha1_BastionImage_To_alicloud_BastionImage is an autogenerated conversion function.
+func Convert_v1alpha1_BastionImage_To_alicloud_BastionImage(in *BastionImage, out *alicloud.BastionImage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionImage_To_alicloud_BastionImage(in, out, s)
+}
+
+func autoConvert_alicloud_BastionImage_To_v1alpha1_BastionImage(in *alicloud.BastionImage, out *BastionImage, s conversion.Scope) error {
+	out.Region = in.Region
+	out.Architecture = (*string)(unsafe.Pointer(in.Architecture))
+	out.ID = in.ID
+	return nil
+}
+
+// Convert_alicloud_BastionImage_To_v1alpha1_BastionImage is an autogenerated conversion function.
+func Convert_alicloud_BastionImage_To_v1alpha1_BastionImage(in *alicloud.BastionImage, out *BastionImage, s conversion.Scope) error {
+	return autoConvert_alicloud_BastionImage_To_v1alpha1_BastionImage(in, out, s)
+}
+
+func autoConvert_v1alpha1_BastionStatus_To_alicloud_BastionStatus(in *BastionStatus, out *alicloud.BastionStatus, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.VSwitchID = in.VSwitchID
+	out.InstanceID = in.InstanceID
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_v1alpha1_BastionStatus_To_alicloud_BastionStatus is an autogenerated conversion function.
+func Convert_v1alpha1_BastionStatus_To_alicloud_BastionStatus(in *BastionStatus, out *alicloud.BastionStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_BastionStatus_To_alicloud_BastionStatus(in, out, s)
+}
+
+func autoConvert_alicloud_BastionStatus_To_v1alpha1_BastionStatus(in *alicloud.BastionStatus, out *BastionStatus, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.VSwitchID = in.VSwitchID
+	out.InstanceID = in.InstanceID
+	out.Region = in.Region
+	return nil
+}
+
+// Convert_alicloud_BastionStatus_To_v1alpha1_BastionStatus is an autogenerated conversion function.
+func Convert_alicloud_BastionStatus_To_v1alpha1_BastionStatus(in *alicloud.BastionStatus, out *BastionStatus, s conversion.Scope) error {
+	return autoConvert_alicloud_BastionStatus_To_v1alpha1_BastionStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_CSI_To_alicloud_CSI(in *CSI, out *alicloud.CSI, s conversion.Scope) error {
 	out.EnableADController = (*bool)(unsafe.Pointer(in.EnableADController))
+	out.NAS = (*alicloud.CSINAS)(unsafe.Pointer(in.NAS))
+	out.OSS = (*alicloud.CSIOSS)(unsafe.Pointer(in.OSS))
 	return nil
 }
 
@@ -280,6 +532,8 @@ func Convert_v1alpha1_CSI_To_alicloud_CSI(in *CSI, out *alicloud.CSI, s conversi
 
 func autoConvert_alicloud_CSI_To_v1alpha1_CSI(in *alicloud.CSI, out *CSI, s conversion.Scope) error {
 	out.EnableADController = (*bool)(unsafe.Pointer(in.EnableADController))
+	out.NAS = (*CSINAS)(unsafe.Pointer(in.NAS))
+	out.OSS = (*CSIOSS)(unsafe.Pointer(in.OSS))
 	return nil
 }
 
@@ -288,6 +542,50 @@ func Convert_alicloud_CSI_To_v1alpha1_CSI(in *alicloud.CSI, out *CSI, s conversi
 	return autoConvert_alicloud_CSI_To_v1alpha1_CSI(in, out, s)
 }
 
+func autoConvert_v1alpha1_CSINAS_To_alicloud_CSINAS(in *CSINAS, out *alicloud.CSINAS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_v1alpha1_CSINAS_To_alicloud_CSINAS is an autogenerated conversion function.
+func Convert_v1alpha1_CSINAS_To_alicloud_CSINAS(in *CSINAS, out *alicloud.CSINAS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSINAS_To_alicloud_CSINAS(in, out, s)
+}
+
+func autoConvert_alicloud_CSINAS_To_v1alpha1_CSINAS(in *alicloud.CSINAS, out *CSINAS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	return nil
+}
+
+// Convert_alicloud_CSINAS_To_v1alpha1_CSINAS is an autogenerated conversion function.
+func Convert_alicloud_CSINAS_To_v1alpha1_CSINAS(in *alicloud.CSINAS, out *CSINAS, s conversion.Scope) error {
+	return autoConvert_alicloud_CSINAS_To_v1alpha1_CSINAS(in, out, s)
+}
+
+func autoConvert_v1alpha1_CSIOSS_To_alicloud_CSIOSS(in *CSIOSS, out *alicloud.CSIOSS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Bucket = (*string)(unsafe.Pointer(in.Bucket))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	return nil
+}
+
+// Convert_v1alpha1_CSIOSS_To_alicloud_CSIOSS is an autogenerated conversion function.
+func Convert_v1alpha1_CSIOSS_To_alicloud_CSIOSS(in *CSIOSS, out *alicloud.CSIOSS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_CSIOSS_To_alicloud_CSIOSS(in, out, s)
+}
+
+func autoConvert_alicloud_CSIOSS_To_v1alpha1_CSIOSS(in *alicloud.CSIOSS, out *CSIOSS, s conversion.Scope) error {
+	out.Enabled = in.Enabled
+	out.Bucket = (*string)(unsafe.Pointer(in.Bucket))
+	out.CredentialsRef = (*string)(unsafe.Pointer(in.CredentialsRef))
+	return nil
+}
+
+// Convert_alicloud_CSIOSS_To_v1alpha1_CSIOSS is an autogenerated conversion function.
+func Convert_alicloud_CSIOSS_To_v1alpha1_CSIOSS(in *alicloud.CSIOSS, out *CSIOSS, s conversion.Scope) error {
+	return autoConvert_alicloud_CSIOSS_To_v1alpha1_CSIOSS(in, out, s)
+}
+
 func autoConvert_v1alpha1_CloudControllerManagerConfig_To_alicloud_CloudControllerManagerConfig(in *CloudControllerManagerConfig, out *alicloud.CloudControllerManagerConfig, s conversion.Scope) error {
 	out.FeatureGates = *(*map[string]bool)(unsafe.Pointer(&in.FeatureGates))
 	return nil
@@ -310,6 +608,7 @@ func Convert_alicloud_CloudControllerManagerConfig_To_v1alpha1_CloudControllerMa
 
 func autoConvert_v1alpha1_CloudProfileConfig_To_alicloud_CloudProfileConfig(in *CloudProfileConfig, out *alicloud.CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.Bastion = (*alicloud.BastionConfig)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -320,6 +619,7 @@ func Convert_v1alpha1_CloudProfileConfig_To_alicloud_CloudProfileConfig(in *Clou
 
 func autoConvert_alicloud_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *alicloud.CloudProfileConfig, out *CloudProfileConfig, s conversion.Scope) error {
 	out.MachineImages = *(*[]MachineImages)(unsafe.Pointer(&in.MachineImages))
+	out.Bastion = (*BastionConfig)(unsafe.Pointer(in.Bastion))
 	return nil
 }
 
@@ -331,6 +631,8 @@ func Convert_alicloud_CloudProfileConfig_To_v1alpha1_CloudProfileConfig(in *alic
 func autoConvert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *ControlPlaneConfig, out *alicloud.ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*alicloud.CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.CSI = (*alicloud.CSI)(unsafe.Pointer(in.CSI))
+	out.LoadBalancer = (*alicloud.LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.Storage = (*alicloud.Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -342,6 +644,8 @@ func Convert_v1alpha1_ControlPlaneConfig_To_alicloud_ControlPlaneConfig(in *Cont
 func autoConvert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alicloud.ControlPlaneConfig, out *ControlPlaneConfig, s conversion.Scope) error {
 	out.CloudControllerManager = (*CloudControllerManagerConfig)(unsafe.Pointer(in.CloudControllerManager))
 	out.CSI = (*CSI)(unsafe.Pointer(in.CSI))
+	out.LoadBalancer = (*LoadBalancerConfig)(unsafe.Pointer(in.LoadBalancer))
+	out.Storage = (*Storage)(unsafe.Pointer(in.Storage))
 	return nil
 }
 
@@ -352,6 +656,7 @@ func Convert_alicloud_ControlPlaneConfig_To_v1alpha1_ControlPlaneConfig(in *alic
 
 func autoConvert_v1alpha1_DualStack_To_alicloud_DualStack(in *DualStack, out *alicloud.DualStack, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.InternetBandwidth = (*alicloud.IPv6InternetBandwidth)(unsafe.Pointer(in.InternetBandwidth))
 	return nil
 }
 
@@ -362,6 +667,7 @@ func Convert_v1alpha1_DualStack_To_alicloud_DualStack(in *DualStack, out *aliclo
 
 func autoConvert_alicloud_DualStack_To_v1alpha1_DualStack(in *alicloud.DualStack, out *DualStack, s conversion.Scope) error {
 	out.Enabled = in.Enabled
+	out.InternetBandwidth = (*IPv6InternetBandwidth)(unsafe.Pointer(in.InternetBandwidth))
 	return nil
 }
 
@@ -370,6 +676,56 @@ func Convert_alicloud_DualStack_To_v1alpha1_DualStack(in *alicloud.DualStack, ou
 	return autoConvert_alicloud_DualStack_To_v1alpha1_DualStack(in, out, s)
 }
 
+func autoConvert_v1alpha1_FlowLogs_To_alicloud_FlowLogs(in *FlowLogs, out *alicloud.FlowLogs, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Logstore = in.Logstore
+	out.TrafficType = (*string)(unsafe.Pointer(in.TrafficType))
+	out.Create = (*bool)(unsafe.Pointer(in.Create))
+	return nil
+}
+
+// Convert_v1alpha1_FlowLogs_To_alicloud_FlowLogs is an autogenerated conversion function.
+func Convert_v1alpha1_FlowLogs_To_alicloud_FlowLogs(in *FlowLogs, out *alicloud.FlowLogs, s conversion.Scope) error {
+	return autoConvert_v1alpha1_FlowLogs_To_alicloud_FlowLogs(in, out, s)
+}
+
+func autoConvert_alicloud_FlowLogs_To_v1alpha1_FlowLogs(in *alicloud.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	out.Project = in.Project
+	out.Logstore = in.Logstore
+	out.TrafficType = (*string)(unsafe.Pointer(in.TrafficType))
+	out.Create = (*bool)(unsafe.Pointer(in.Create))
+	return nil
+}
+
+// Convert_alicloud_FlowLogs_To_v1alpha1_FlowLogs is an autogenerated conversion function.
+func Convert_alicloud_FlowLogs_To_v1alpha1_FlowLogs(in *alicloud.FlowLogs, out *FlowLogs, s conversion.Scope) error {
+	return autoConvert_alicloud_FlowLogs_To_v1alpha1_FlowLogs(in, out, s)
+}
+
+func autoConvert_v1alpha1_IPv6InternetBandwidth_To_alicloud_IPv6InternetBandwidth(in *IPv6InternetBandwidth, out *alicloud.IPv6InternetBandwidth, s conversion.Scope) error {
+	out.Bandwidth = in.Bandwidth
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	out.EgressOnly = (*bool)(unsafe.Pointer(in.EgressOnly))
+	return nil
+}
+
+// Convert_v1alpha1_IPv6InternetBandwidth_To_alicloud_IPv6InternetBandwidth is an autogenerated conversion function.
+func Convert_v1alpha1_IPv6InternetBandwidth_To_alicloud_IPv6InternetBandwidth(in *IPv6InternetBandwidth, out *alicloud.IPv6InternetBandwidth, s conversion.Scope) error {
+	return autoConvert_v1alpha1_IPv6InternetBandwidth_To_alicloud_IPv6InternetBandwidth(in, out, s)
+}
+
+func autoConvert_alicloud_IPv6InternetBandwidth_To_v1alpha1_IPv6InternetBandwidth(in *alicloud.IPv6InternetBandwidth, out *IPv6InternetBandwidth, s conversion.Scope) error {
+	out.Bandwidth = in.Bandwidth
+	out.InternetChargeType = (*string)(unsafe.Pointer(in.InternetChargeType))
+	out.EgressOnly = (*bool)(unsafe.Pointer(in.EgressOnly))
+	return nil
+}
+
+// Convert_alicloud_IPv6InternetBandwidth_To_v1alpha1_IPv6InternetBandwidth is an autogenerated conversion function.
+func Convert_alicloud_IPv6InternetBandwidth_To_v1alpha1_IPv6InternetBandwidth(in *alicloud.IPv6InternetBandwidth, out *IPv6InternetBandwidth, s conversion.Scope) error {
+	return autoConvert_alicloud_IPv6InternetBandwidth_To_v1alpha1_IPv6InternetBandwidth(in, out, s)
+}
+
 func autoConvert_v1alpha1_ImmutableConfig_To_alicloud_ImmutableConfig(in *ImmutableConfig, out *alicloud.ImmutableConfig, s conversion.Scope) error {
 	out.RetentionType = alicloud.RetentionType(in.RetentionType)
 	out.RetentionPeriod = in.RetentionPeriod
@@ -399,6 +755,10 @@ func autoConvert_v1alpha1_InfrastructureConfig_To_alicloud_InfrastructureConfig(
 	if err := Convert_v1alpha1_Networks_To_alicloud_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.RetainEIPs = (*bool)(unsafe.Pointer(in.RetainEIPs))
+	out.FlowLogs = (*alicloud.FlowLogs)(unsafe.Pointer(in.FlowLogs))
+	out.NAS = (*alicloud.NAS)(unsafe.Pointer(in.NAS))
 	return nil
 }
 
@@ -412,6 +772,10 @@ func autoConvert_alicloud_InfrastructureConfig_To_v1alpha1_InfrastructureConfig(
 	if err := Convert_alicloud_Networks_To_v1alpha1_Networks(&in.Networks, &out.Networks, s); err != nil {
 		return err
 	}
+	out.DeletionProtection = (*bool)(unsafe.Pointer(in.DeletionProtection))
+	out.RetainEIPs = (*bool)(unsafe.Pointer(in.RetainEIPs))
+	out.FlowLogs = (*FlowLogs)(unsafe.Pointer(in.FlowLogs))
+	out.NAS = (*NAS)(unsafe.Pointer(in.NAS))
 	return nil
 }
 
@@ -426,6 +790,7 @@ func autoConvert_v1alpha1_InfrastructureStatus_To_alicloud_InfrastructureStatus(
 	}
 	out.KeyPairName = in.KeyPairName
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.NAS = (*alicloud.NASStatus)(unsafe.Pointer(in.NAS))
 	return nil
 }
 
@@ -440,6 +805,7 @@ func autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(
 	}
 	out.KeyPairName = in.KeyPairName
 	out.MachineImages = *(*[]MachineImage)(unsafe.Pointer(&in.MachineImages))
+	out.NAS = (*NASStatus)(unsafe.Pointer(in.NAS))
 	return nil
 }
 
@@ -448,6 +814,34 @@ func Convert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in *
 	return autoConvert_alicloud_InfrastructureStatus_To_v1alpha1_InfrastructureStatus(in, out, s)
 }
 
+func autoConvert_v1alpha1_LoadBalancerConfig_To_alicloud_LoadBalancerConfig(in *LoadBalancerConfig, out *alicloud.LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*alicloud.LoadBalancerType)(unsafe.Pointer(in.Type))
+	out.AddressType = (*alicloud.LoadBalancerAddressType)(unsafe.Pointer(in.AddressType))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	out.PaymentType = (*alicloud.LoadBalancerPaymentType)(unsafe.Pointer(in.PaymentType))
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_v1alpha1_LoadBalancerConfig_To_alicloud_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_v1alpha1_LoadBalancerConfig_To_alicloud_LoadBalancerConfig(in *LoadBalancerConfig, out *alicloud.LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_v1alpha1_LoadBalancerConfig_To_alicloud_LoadBalancerConfig(in, out, s)
+}
+
+func autoConvert_alicloud_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *alicloud.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	out.Type = (*LoadBalancerType)(unsafe.Pointer(in.Type))
+	out.AddressType = (*LoadBalancerAddressType)(unsafe.Pointer(in.AddressType))
+	out.Bandwidth = (*int32)(unsafe.Pointer(in.Bandwidth))
+	out.PaymentType = (*LoadBalancerPaymentType)(unsafe.Pointer(in.PaymentType))
+	out.Spec = (*string)(unsafe.Pointer(in.Spec))
+	return nil
+}
+
+// Convert_alicloud_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig is an autogenerated conversion function.
+func Convert_alicloud_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in *alicloud.LoadBalancerConfig, out *LoadBalancerConfig, s conversion.Scope) error {
+	return autoConvert_alicloud_LoadBalancerConfig_To_v1alpha1_LoadBalancerConfig(in, out, s)
+}
+
 func autoConvert_v1alpha1_MachineImage_To_alicloud_MachineImage(in *MachineImage, out *alicloud.MachineImage, s conversion.Scope) error {
 	out.Name = in.Name
 	out.Version = in.Version
@@ -544,8 +938,74 @@ func Convert_alicloud_MachineImages_To_v1alpha1_MachineImages(in *alicloud.Machi
 	return autoConvert_alicloud_MachineImages_To_v1alpha1_MachineImages(in, out, s)
 }
 
+func autoConvert_v1alpha1_NAS_To_alicloud_NAS(in *NAS, out *alicloud.NAS, s conversion.Scope) error {
+	out.StorageType = (*string)(unsafe.Pointer(in.StorageType))
+	return nil
+}
+
+// Convert_v1alpha1_NAS_To_alicloud_NAS is an autogenerated conversion function.
+func Convert_v1alpha1_NAS_To_alicloud_NAS(in *NAS, out *alicloud.NAS, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NAS_To_alicloud_NAS(in, out, s)
+}
+
+func autoConvert_alicloud_NAS_To_v1alpha1_NAS(in *alicloud.NAS, out *NAS, s conversion.Scope) error {
+	out.StorageType = (*string)(unsafe.Pointer(in.StorageType))
+	return nil
+}
+
+// Convert_alicloud_NAS_To_v1alpha1_NAS is an autogenerated conversion function.
+func Convert_alicloud_NAS_To_v1alpha1_NAS(in *alicloud.NAS, out *NAS, s conversion.Scope) error {
+	return autoConvert_alicloud_NAS_To_v1alpha1_NAS(in, out, s)
+}
+
+func autoConvert_v1alpha1_NASMountTarget_To_alicloud_NASMountTarget(in *NASMountTarget, out *alicloud.NASMountTarget, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Domain = in.Domain
+	return nil
+}
+
+// Convert_v1alpha1_NASMountTarget_To_alicloud_NASMountTarget is an autogenerated conversion function.
+func Convert_v1alpha1_NASMountTarget_To_alicloud_NASMountTarget(in *NASMountTarget, out *alicloud.NASMountTarget, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NASMountTarget_To_alicloud_NASMountTarget(in, out, s)
+}
+
+func autoConvert_alicloud_NASMountTarget_To_v1alpha1_NASMountTarget(in *alicloud.NASMountTarget, out *NASMountTarget, s conversion.Scope) error {
+	out.Zone = in.Zone
+	out.Domain = in.Domain
+	return nil
+}
+
+// Convert_alicloud_NASMountTarget_To_v1alpha1_NASMountTarget is an autogenerated conversion function.
+func Convert_alicloud_NASMountTarget_To_v1alpha1_NASMountTarget(in *alicloud.NASMountTarget, out *NASMountTarget, s conversion.Scope) error {
+	return autoConvert_alicloud_NASMountTarget_To_v1alpha1_NASMountTarget(in, out, s)
+}
+
+func autoConvert_v1alpha1_NASStatus_To_alicloud_NASStatus(in *NASStatus, out *alicloud.NASStatus, s conversion.Scope) error {
+	out.FileSystemID = in.FileSystemID
+	out.MountTargets = *(*[]alicloud.NASMountTarget)(unsafe.Pointer(&in.MountTargets))
+	return nil
+}
+
+// Convert_v1alpha1_NASStatus_To_alicloud_NASStatus is an autogenerated conversion function.
+func Convert_v1alpha1_NASStatus_To_alicloud_NASStatus(in *NASStatus, out *alicloud.NASStatus, s conversion.Scope) error {
+	return autoConvert_v1alpha1_NASStatus_To_alicloud_NASStatus(in, out, s)
+}
+
+func autoConvert_alicloud_NASStatus_To_v1alpha1_NASStatus(in *alicloud.NASStatus, out *NASStatus, s conversion.Scope) error {
+	out.FileSystemID = in.FileSystemID
+	out.MountTargets = *(*[]NASMountTarget)(unsafe.Pointer(&in.MountTargets))
+	return nil
+}
+
+// Convert_alicloud_NASStatus_To_v1alpha1_NASStatus is an autogenerated conversion function.
+func Convert_alicloud_NASStatus_To_v1alpha1_NASStatus(in *alicloud.NASStatus, out *NASStatus, s conversion.Scope) error {
+	return autoConvert_alicloud_NASStatus_To_v1alpha1_NASStatus(in, out, s)
+}
+
 func autoConvert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(in *NatGatewayConfig, out *alicloud.NatGatewayConfig, s conversion.Scope) error {
+	out.Type = (*alicloud.NatGatewayType)(unsafe.Pointer(in.Type))
 	out.EIPAllocationID = (*string)(unsafe.Pointer(in.EIPAllocationID))
+	out.SNATIPAddress = (*string)(unsafe.Pointer(in.SNATIPAddress))
 	return nil
 }
 
@@ -555,7 +1015,9 @@ func Convert_v1alpha1_NatGatewayConfig_To_alicloud_NatGatewayConfig(in *NatGatew
 }
 
 func autoConvert_alicloud_NatGatewayConfig_To_v1alpha1_NatGatewayConfig(in *alicloud.NatGatewayConfig, out *NatGatewayConfig, s conversion.Scope) error {
+	out.Type = (*NatGatewayType)(unsafe.Pointer(in.Type))
 	out.EIPAllocationID = (*string)(unsafe.Pointer(in.EIPAllocationID))
+	out.SNATIPAddress = (*string)(unsafe.Pointer(in.SNATIPAddress))
 	return nil
 }
 
@@ -569,6 +1031,7 @@ func autoConvert_v1alpha1_Networks_To_alicloud_Networks(in *Networks, out *alicl
 		return err
 	}
 	out.Zones = *(*[]alicloud.Zone)(unsafe.Pointer(&in.Zones))
+	out.WorkersPrefixLength = (*int32)(unsafe.Pointer(in.WorkersPrefixLength))
 	return nil
 }
 
@@ -582,6 +1045,7 @@ func autoConvert_alicloud_Networks_To_v1alpha1_Networks(in *alicloud.Networks, o
 		return err
 	}
 	out.Zones = *(*[]Zone)(unsafe.Pointer(&in.Zones))
+	out.WorkersPrefixLength = (*int32)(unsafe.Pointer(in.WorkersPrefixLength))
 	return nil
 }
 
@@ -634,11 +1098,72 @@ func Convert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in *alicloud.Secur
 	return autoConvert_alicloud_SecurityGroup_To_v1alpha1_SecurityGroup(in, out, s)
 }
 
+func autoConvert_v1alpha1_Storage_To_alicloud_Storage(in *Storage, out *alicloud.Storage, s conversion.Scope) error {
+	out.StorageClasses = *(*[]alicloud.StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.VolumeSnapshotClass = (*alicloud.VolumeSnapshotClass)(unsafe.Pointer(in.VolumeSnapshotClass))
+	return nil
+}
+
+// Convert_v1alpha1_Storage_To_alicloud_Storage is an autogenerated conversion function.
+func Convert_v1alpha1_Storage_To_alicloud_Storage(in *Storage, out *alicloud.Storage, s conversion.Scope) error {
+	return autoConvert_v1alpha1_Storage_To_alicloud_Storage(in, out, s)
+}
+
+func autoConvert_alicloud_Storage_To_v1alpha1_Storage(in *alicloud.Storage, out *Storage, s conversion.Scope) error {
+	out.StorageClasses = *(*[]StorageClass)(unsafe.Pointer(&in.StorageClasses))
+	out.VolumeSnapshotClass = (*VolumeSnapshotClass)(unsafe.Pointer(in.VolumeSnapshotClass))
+	return nil
+}
+
+// Convert_alicloud_Storage_To_v1alpha1_Storage is an autogenerated conversion function.
+func Convert_alicloud_Storage_To_v1alpha1_Storage(in *alicloud.Storage, out *Storage, s conversion.Scope) error {
+	return autoConvert_alicloud_Storage_To_v1alpha1_Storage(in, out, s)
+}
+
+func autoConvert_v1alpha1_StorageClass_To_alicloud_StorageClass(in *StorageClass, out *alicloud.StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.ReclaimPolicy = (*string)(unsafe.Pointer(in.ReclaimPolicy))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.PerformanceLevel = (*string)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.FSType = (*string)(unsafe.Pointer(in.FSType))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_v1alpha1_StorageClass_To_alicloud_StorageClass is an autogenerated conversion function.
+func Convert_v1alpha1_StorageClass_To_alicloud_StorageClass(in *StorageClass, out *alicloud.StorageClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_StorageClass_To_alicloud_StorageClass(in, out, s)
+}
+
+func autoConvert_alicloud_StorageClass_To_v1alpha1_StorageClass(in *alicloud.StorageClass, out *StorageClass, s conversion.Scope) error {
+	out.Name = in.Name
+	out.Default = (*bool)(unsafe.Pointer(in.Default))
+	out.ReclaimPolicy = (*string)(unsafe.Pointer(in.ReclaimPolicy))
+	out.Type = (*string)(unsafe.Pointer(in.Type))
+	out.PerformanceLevel = (*string)(unsafe.Pointer(in.PerformanceLevel))
+	out.ProvisionedIOPS = (*int64)(unsafe.Pointer(in.ProvisionedIOPS))
+	out.FSType = (*string)(unsafe.Pointer(in.FSType))
+	out.Encrypted = (*bool)(unsafe.Pointer(in.Encrypted))
+	out.KMSKeyID = (*string)(unsafe.Pointer(in.KMSKeyID))
+	return nil
+}
+
+// Convert_alicloud_StorageClass_To_v1alpha1_StorageClass is an autogenerated conversion function.
+func Convert_alicloud_StorageClass_To_v1alpha1_StorageClass(in *alicloud.StorageClass, out *StorageClass, s conversion.Scope) error {
+	return autoConvert_alicloud_StorageClass_To_v1alpha1_StorageClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_VPC_To_alicloud_VPC(in *VPC, out *alicloud.VPC, s conversion.Scope) error {
 	out.ID = (*string)(unsafe.Pointer(in.ID))
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	out.GardenerManagedNATGateway = (*bool)(unsafe.Pointer(in.GardenerManagedNATGateway))
 	out.UseCustomRouteTable = (*bool)(unsafe.Pointer(in.UseCustomRouteTable))
+	out.SecondaryCIDRs = *(*[]string)(unsafe.Pointer(&in.SecondaryCIDRs))
+	out.EgressMode = (*alicloud.EgressMode)(unsafe.Pointer(in.EgressMode))
+	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
 	return nil
 }
 
@@ -652,6 +1177,9 @@ func autoConvert_alicloud_VPC_To_v1alpha1_VPC(in *alicloud.VPC, out *VPC, s conv
 	out.CIDR = (*string)(unsafe.Pointer(in.CIDR))
 	out.GardenerManagedNATGateway = (*bool)(unsafe.Pointer(in.GardenerManagedNATGateway))
 	out.UseCustomRouteTable = (*bool)(unsafe.Pointer(in.UseCustomRouteTable))
+	out.SecondaryCIDRs = *(*[]string)(unsafe.Pointer(&in.SecondaryCIDRs))
+	out.EgressMode = (*EgressMode)(unsafe.Pointer(in.EgressMode))
+	out.EgressCIDRs = *(*[]string)(unsafe.Pointer(&in.EgressCIDRs))
 	return nil
 }
 
@@ -710,6 +1238,34 @@ func Convert_alicloud_VSwitch_To_v1alpha1_VSwitch(in *alicloud.VSwitch, out *VSw
 	return autoConvert_alicloud_VSwitch_To_v1alpha1_VSwitch(in, out, s)
 }
 
+func autoConvert_v1alpha1_VolumeSnapshotClass_To_alicloud_VolumeSnapshotClass(in *VolumeSnapshotClass, out *alicloud.VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.DeletionPolicy = (*string)(unsafe.Pointer(in.DeletionPolicy))
+	out.InstantAccess = (*bool)(unsafe.Pointer(in.InstantAccess))
+	out.InstantAccessRetentionDays = (*int32)(unsafe.Pointer(in.InstantAccessRetentionDays))
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	return nil
+}
+
+// Convert_v1alpha1_VolumeSnapshotClass_To_alicloud_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_v1alpha1_VolumeSnapshotClass_To_alicloud_VolumeSnapshotClass(in *VolumeSnapshotClass, out *alicloud.VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_v1alpha1_VolumeSnapshotClass_To_alicloud_VolumeSnapshotClass(in, out, s)
+}
+
+func autoConvert_alicloud_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *alicloud.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	out.Name = (*string)(unsafe.Pointer(in.Name))
+	out.DeletionPolicy = (*string)(unsafe.Pointer(in.DeletionPolicy))
+	out.InstantAccess = (*bool)(unsafe.Pointer(in.InstantAccess))
+	out.InstantAccessRetentionDays = (*int32)(unsafe.Pointer(in.InstantAccessRetentionDays))
+	out.RetentionDays = (*int32)(unsafe.Pointer(in.RetentionDays))
+	return nil
+}
+
+// Convert_alicloud_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass is an autogenerated conversion function.
+func Convert_alicloud_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in *alicloud.VolumeSnapshotClass, out *VolumeSnapshotClass, s conversion.Scope) error {
+	return autoConvert_alicloud_VolumeSnapshotClass_To_v1alpha1_VolumeSnapshotClass(in, out, s)
+}
+
 func autoConvert_v1alpha1_WorkerStatus_To_alicloud_WorkerStatus(in *WorkerStatus, out *alicloud.WorkerStatus, s conversion.Scope) error {
 	out.MachineImages = *(*[]alicloud.MachineImage)(unsafe.Pointer(&in.MachineImages))
 	return nil
@@ -736,6 +1292,7 @@ func autoConvert_v1alpha1_Zone_To_alicloud_Zone(in *Zone, out *alicloud.Zone, s
 	out.Workers = in.Workers
 	out.Ipv6CidrBlock = (*int)(unsafe.Pointer(in.Ipv6CidrBlock))
 	out.NatGateway = (*alicloud.NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.VSwitchID = (*string)(unsafe.Pointer(in.VSwitchID))
 	return nil
 }
 
@@ -750,6 +1307,7 @@ func autoConvert_alicloud_Zone_To_v1alpha1_Zone(in *alicloud.Zone, out *Zone, s
 	out.Workers = in.Workers
 	out.Ipv6CidrBlock = (*int)(unsafe.Pointer(in.Ipv6CidrBlock))
 	out.NatGateway = (*NatGatewayConfig)(unsafe.Pointer(in.NatGateway))
+	out.VSwitchID = (*string)(unsafe.Pointer(in.VSwitchID))
 	return nil
 }
 
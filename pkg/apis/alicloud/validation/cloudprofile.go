@@ -15,7 +15,9 @@ import (
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	"github.com/gardener/gardener/pkg/utils"
 	gutil "github.com/gardener/gardener/pkg/utils/gardener"
+	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 )
@@ -31,6 +33,88 @@ func ValidateCloudProfileConfig(cpConfig *apisalicloud.CloudProfileConfig, machi
 	// Validate machine image mappings
 	allErrs = append(allErrs, validateMachineImageMapping(machineImages, cpConfig, capabilityDefinitions, field.NewPath("spec").Child("machineImages"))...)
 
+	if cpConfig.Bastion != nil {
+		allErrs = append(allErrs, validateBastionConfig(cpConfig.Bastion, fldPath.Child("bastion"))...)
+	}
+
+	return allErrs
+}
+
+// validateBastionConfig validates the bastion section of CloudProfileConfig
+func validateBastionConfig(bastion *apisalicloud.BastionConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	images := sets.New[string]()
+	for i, image := range bastion.Images {
+		idxPath := fldPath.Child("images").Index(i)
+		if len(image.Region) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("region"), "must provide a region"))
+		}
+		if len(image.ID) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("id"), "must provide an id"))
+		}
+		architecture := ptr.Deref(image.Architecture, v1beta1constants.ArchitectureAMD64)
+		if !slices.Contains(v1beta1constants.ValidArchitectures, architecture) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("architecture"), architecture, v1beta1constants.ValidArchitectures))
+		}
+		key := image.Region + "/" + architecture
+		if images.Has(key) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, fmt.Sprintf("region %q and architecture %q", image.Region, architecture)))
+		}
+		images.Insert(key)
+	}
+
+	instanceTypes := sets.New[string]()
+	for i, instanceType := range bastion.InstanceTypes {
+		idxPath := fldPath.Child("instanceTypes").Index(i)
+		if len(instanceType) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath, "must provide an instance type"))
+			continue
+		}
+		if instanceTypes.Has(instanceType) {
+			allErrs = append(allErrs, field.Duplicate(idxPath, instanceType))
+		}
+		instanceTypes.Insert(instanceType)
+	}
+
+	if eip := bastion.EIP; eip != nil {
+		eipPath := fldPath.Child("eip")
+		for key := range eip.PoolTags {
+			if len(key) == 0 {
+				allErrs = append(allErrs, field.Invalid(eipPath.Child("poolTags"), key, "tag keys must not be empty"))
+			}
+		}
+		for key := range eip.Tags {
+			if len(key) == 0 {
+				allErrs = append(allErrs, field.Invalid(eipPath.Child("tags"), key, "tag keys must not be empty"))
+			}
+		}
+		if len(eip.PoolTags) > 0 {
+			if len(eip.Tags) > 0 {
+				allErrs = append(allErrs, field.Forbidden(eipPath.Child("tags"), "must not be set together with poolTags as EIPs are only allocated without pool"))
+			}
+			if eip.Bandwidth != nil {
+				allErrs = append(allErrs, field.Forbidden(eipPath.Child("bandwidth"), "must not be set together with poolTags as EIPs are only allocated without pool"))
+			}
+		}
+		if eip.Bandwidth != nil && (*eip.Bandwidth < 1 || *eip.Bandwidth > 200) {
+			allErrs = append(allErrs, field.Invalid(eipPath.Child("bandwidth"), *eip.Bandwidth, "must be between 1 and 200 Mbps"))
+		}
+	}
+
+	if accessMode := bastion.AccessMode; accessMode != nil {
+		accessModePath := fldPath.Child("accessMode")
+		switch *accessMode {
+		case apisalicloud.BastionAccessModeSSH:
+		case apisalicloud.BastionAccessModeCloudAssistant:
+			if bastion.EIP != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("eip"), "must not be set together with Cloud Assistant access as bastion hosts have no public endpoint"))
+			}
+		default:
+			allErrs = append(allErrs, field.NotSupported(accessModePath, *accessMode, []apisalicloud.BastionAccessMode{apisalicloud.BastionAccessModeSSH, apisalicloud.BastionAccessModeCloudAssistant}))
+		}
+	}
+
 	return allErrs
 }
 
@@ -13,6 +13,7 @@ import (
 	. "github.com/onsi/gomega/gstruct"
 	"github.com/onsi/gomega/types"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/validation"
@@ -237,6 +238,117 @@ var _ = Describe("CloudProfileConfig validation", func() {
 				}
 			})
 		})
+
+		Context("bastion validation", func() {
+			It("should pass validation with valid bastion config", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					Images: []apisalicloud.BastionImage{
+						{Region: "china", ID: "bastion-amd64"},
+						{Region: "china", Architecture: ptr.To("arm64"), ID: "bastion-arm64"},
+					},
+					InstanceTypes: []string{"ecs.t6-c1m1.large", "ecs.g8y.large"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid incomplete, unsupported and duplicate bastion images", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					Images: []apisalicloud.BastionImage{
+						{Region: "china", ID: "bastion-amd64"},
+						{Region: "china", Architecture: ptr.To("amd64"), ID: "bastion-amd64-2"},
+						{Region: "china", Architecture: ptr.To("x86"), ID: "bastion-x86"},
+						{Region: "", ID: ""},
+					},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("bastion.images[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("bastion.images[2].architecture"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("bastion.images[3].region"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("bastion.images[3].id"),
+				}))))
+			})
+
+			It("should forbid empty and duplicate bastion instance types", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					InstanceTypes: []string{"ecs.t6-c1m1.large", "", "ecs.t6-c1m1.large"},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("bastion.instanceTypes[1]"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("bastion.instanceTypes[2]"),
+				}))))
+			})
+
+			It("should allow an allocated bastion EIP with tags and bandwidth", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					EIP: &apisalicloud.BastionEIP{
+						Tags:      map[string]string{"team": "firewall"},
+						Bandwidth: ptr.To[int32](10),
+					},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid tags and bandwidth together with pool tags and an invalid bandwidth", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					EIP: &apisalicloud.BastionEIP{
+						PoolTags:  map[string]string{"pool": "bastion"},
+						Tags:      map[string]string{"team": "firewall"},
+						Bandwidth: ptr.To[int32](0),
+					},
+				}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("bastion.eip.tags"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("bastion.eip.bandwidth"),
+				})), PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("bastion.eip.bandwidth"),
+				}))))
+			})
+
+			It("should forbid an unknown access mode and an EIP with Cloud Assistant access", func() {
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{AccessMode: ptr.To[apisalicloud.BastionAccessMode]("Telnet")}
+
+				errorList := ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("bastion.accessMode"),
+				}))))
+
+				cloudProfileConfig.Bastion = &apisalicloud.BastionConfig{
+					AccessMode: ptr.To(apisalicloud.BastionAccessModeCloudAssistant),
+					EIP:        &apisalicloud.BastionEIP{},
+				}
+
+				errorList = ValidateCloudProfileConfig(cloudProfileConfig, machineImages, capabilityDefinitions, fldPath)
+				Expect(errorList).To(ConsistOf(PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("bastion.eip"),
+				}))))
+			})
+		})
 	},
 		Entry("CloudProfile uses regions only", false),
 		Entry("CloudProfile uses capabilities", true))
@@ -5,7 +5,14 @@
 package validation
 
 import (
+	"fmt"
+	"regexp"
+	"slices"
+	"strings"
+
 	featurevalidation "github.com/gardener/gardener/pkg/utils/validation/features"
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/apimachinery/pkg/util/validation"
 	"k8s.io/apimachinery/pkg/util/validation/field"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
@@ -19,5 +26,230 @@ func ValidateControlPlaneConfig(controlPlaneConfig *apisalicloud.ControlPlaneCon
 		allErrs = append(allErrs, featurevalidation.ValidateFeatureGates(controlPlaneConfig.CloudControllerManager.FeatureGates, version, fldPath.Child("cloudControllerManager", "featureGates"))...)
 	}
 
+	if lb := controlPlaneConfig.LoadBalancer; lb != nil {
+		lbPath := fldPath.Child("loadBalancer")
+		if lb.Type != nil && *lb.Type != apisalicloud.LoadBalancerTypeCLB && *lb.Type != apisalicloud.LoadBalancerTypeNLB {
+			allErrs = append(allErrs, field.NotSupported(lbPath.Child("type"), *lb.Type, []apisalicloud.LoadBalancerType{apisalicloud.LoadBalancerTypeCLB, apisalicloud.LoadBalancerTypeNLB}))
+		}
+		allErrs = append(allErrs, validateLoadBalancerDefaults(lb, lbPath)...)
+	}
+
+	if controlPlaneConfig.Storage != nil {
+		allErrs = append(allErrs, validateStorageClasses(controlPlaneConfig.Storage.StorageClasses, controlPlaneConfig.CSI, fldPath.Child("storage", "storageClasses"))...)
+		if controlPlaneConfig.Storage.VolumeSnapshotClass != nil {
+			allErrs = append(allErrs, validateVolumeSnapshotClass(controlPlaneConfig.Storage.VolumeSnapshotClass, fldPath.Child("storage", "volumeSnapshotClass"))...)
+		}
+	}
+
+	if controlPlaneConfig.CSI != nil && controlPlaneConfig.CSI.OSS != nil {
+		allErrs = append(allErrs, validateCSIOSS(controlPlaneConfig.CSI.OSS, fldPath.Child("csi", "oss"))...)
+	}
+
+	return allErrs
+}
+
+// supportedCLBSpecs are the specifications of CLBs which can be chosen as default.
+var supportedCLBSpecs = []string{
+	"slb.s1.small",
+	"slb.s2.small",
+	"slb.s2.medium",
+	"slb.s3.small",
+	"slb.s3.medium",
+	"slb.s3.large",
+	"slb.s3.xlarge",
+	"slb.s3.xxlarge",
+}
+
+// maxCLBBandwidth is the maximum bandwidth of a CLB in Mbit/s.
+const maxCLBBandwidth = 5120
+
+func validateLoadBalancerDefaults(lb *apisalicloud.LoadBalancerConfig, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if lb.AddressType != nil && *lb.AddressType != apisalicloud.LoadBalancerAddressTypeInternet && *lb.AddressType != apisalicloud.LoadBalancerAddressTypeIntranet {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("addressType"), *lb.AddressType, []apisalicloud.LoadBalancerAddressType{apisalicloud.LoadBalancerAddressTypeInternet, apisalicloud.LoadBalancerAddressTypeIntranet}))
+	}
+	if lb.PaymentType != nil && *lb.PaymentType != apisalicloud.LoadBalancerPaymentTypePayByTraffic && *lb.PaymentType != apisalicloud.LoadBalancerPaymentTypePayByBandwidth {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("paymentType"), *lb.PaymentType, []apisalicloud.LoadBalancerPaymentType{apisalicloud.LoadBalancerPaymentTypePayByTraffic, apisalicloud.LoadBalancerPaymentTypePayByBandwidth}))
+	}
+	if lb.Bandwidth != nil {
+		if *lb.Bandwidth < 1 || *lb.Bandwidth > maxCLBBandwidth {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("bandwidth"), *lb.Bandwidth, fmt.Sprintf("must be between 1 and %d", maxCLBBandwidth)))
+		}
+		if lb.PaymentType == nil || *lb.PaymentType != apisalicloud.LoadBalancerPaymentTypePayByBandwidth {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("bandwidth"), fmt.Sprintf("bandwidth requires the payment type %q", apisalicloud.LoadBalancerPaymentTypePayByBandwidth)))
+		}
+	}
+	if lb.Spec != nil && !slices.Contains(supportedCLBSpecs, *lb.Spec) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("spec"), *lb.Spec, supportedCLBSpecs))
+	}
+
+	if lb.Type != nil && *lb.Type == apisalicloud.LoadBalancerTypeNLB {
+		if lb.Bandwidth != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("bandwidth"), "only supported for load balancers of type CLB"))
+		}
+		if lb.PaymentType != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("paymentType"), "only supported for load balancers of type CLB"))
+		}
+		if lb.Spec != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("spec"), "only supported for load balancers of type CLB"))
+		}
+	}
+
+	return allErrs
+}
+
+var (
+	supportedDiskCategories    = []string{"cloud_essd", "cloud_essd_entry", "cloud_auto", "cloud_ssd", "cloud_efficiency"}
+	supportedPerformanceLevels = []string{"PL0", "PL1", "PL2", "PL3"}
+	supportedFSTypes           = []string{"ext4", "ext3", "xfs"}
+	supportedReclaimPolicies   = []string{"Delete", "Retain"}
+	supportedDeletionPolicies  = []string{"Delete", "Retain"}
+)
+
+const (
+	// maxSnapshotRetentionDays is the maximum retention of automatically released snapshots.
+	maxSnapshotRetentionDays = 65536
+	// maxInstantAccessRetentionDays is the maximum retention of the instant access of snapshots.
+	maxInstantAccessRetentionDays = 65535
+)
+
+// maxProvisionedIOPS is the maximum of the IOPS which can be provisioned for ESSD AutoPL disks.
+const maxProvisionedIOPS = 50000
+
+// ossBucketNameRegex matches the names of OSS buckets, see https://www.alibabacloud.com/help/en/oss/user-guide/bucket-naming-conventions.
+var ossBucketNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+
+func validateCSIOSS(oss *apisalicloud.CSIOSS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if !oss.Enabled {
+		if oss.Bucket != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("bucket"), "requires the OSS CSI driver to be enabled"))
+		}
+		if oss.CredentialsRef != nil {
+			allErrs = append(allErrs, field.Forbidden(fldPath.Child("credentialsRef"), "requires the OSS CSI driver to be enabled"))
+		}
+	}
+	if oss.Bucket != nil && !ossBucketNameRegex.MatchString(*oss.Bucket) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("bucket"), *oss.Bucket, "must consist of 3 to 63 lowercase letters, digits or hyphens and must start and end with a letter or digit"))
+	}
+	if oss.CredentialsRef != nil && len(*oss.CredentialsRef) == 0 {
+		allErrs = append(allErrs, field.Required(fldPath.Child("credentialsRef"), "must not be empty"))
+	}
+
+	return allErrs
+}
+
+func validateVolumeSnapshotClass(vsc *apisalicloud.VolumeSnapshotClass, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	if vsc.Name != nil {
+		for _, msg := range validation.IsDNS1123Subdomain(*vsc.Name) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("name"), *vsc.Name, msg))
+		}
+	}
+	if vsc.DeletionPolicy != nil && !slices.Contains(supportedDeletionPolicies, *vsc.DeletionPolicy) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("deletionPolicy"), *vsc.DeletionPolicy, supportedDeletionPolicies))
+	}
+	if vsc.RetentionDays != nil && (*vsc.RetentionDays < 1 || *vsc.RetentionDays > maxSnapshotRetentionDays) {
+		allErrs = append(allErrs, field.Invalid(fldPath.Child("retentionDays"), *vsc.RetentionDays, fmt.Sprintf("must be between 1 and %d", maxSnapshotRetentionDays)))
+	}
+	if vsc.InstantAccessRetentionDays != nil {
+		fld := fldPath.Child("instantAccessRetentionDays")
+		switch {
+		case vsc.InstantAccess == nil || !*vsc.InstantAccess:
+			allErrs = append(allErrs, field.Forbidden(fld, "requires instantAccess to be enabled"))
+		case *vsc.InstantAccessRetentionDays < 1 || *vsc.InstantAccessRetentionDays > maxInstantAccessRetentionDays:
+			allErrs = append(allErrs, field.Invalid(fld, *vsc.InstantAccessRetentionDays, fmt.Sprintf("must be between 1 and %d", maxInstantAccessRetentionDays)))
+		case vsc.RetentionDays != nil && *vsc.InstantAccessRetentionDays > *vsc.RetentionDays:
+			allErrs = append(allErrs, field.Invalid(fld, *vsc.InstantAccessRetentionDays, "must not exceed retentionDays"))
+		}
+	}
+
+	return allErrs
+}
+
+// reservedStorageClassName returns why the name is reserved for a storage class deployed by the Alicloud extension for
+// an enabled CSI driver, or an empty string if it is not reserved.
+func reservedStorageClassName(name string, csi *apisalicloud.CSI) string {
+	if csi == nil {
+		return ""
+	}
+	if csi.NAS != nil && csi.NAS.Enabled && (name == "nas" || strings.HasPrefix(name, "nas-")) {
+		return "the name \"nas\" and the prefix \"nas-\" are reserved for the storage classes of the NAS CSI driver"
+	}
+	if csi.OSS != nil && csi.OSS.Enabled && csi.OSS.Bucket != nil && name == "oss" {
+		return "the name \"oss\" is reserved for the storage class of the OSS bucket of the OSS CSI driver"
+	}
+	return ""
+}
+
+func validateStorageClasses(storageClasses []apisalicloud.StorageClass, csi *apisalicloud.CSI, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	names := sets.New[string]()
+	defaultClass := ""
+	for i, sc := range storageClasses {
+		idxPath := fldPath.Index(i)
+
+		if len(sc.Name) == 0 {
+			allErrs = append(allErrs, field.Required(idxPath.Child("name"), "must provide a name"))
+		} else {
+			for _, msg := range validation.IsDNS1123Subdomain(sc.Name) {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("name"), sc.Name, msg))
+			}
+			if names.Has(sc.Name) {
+				allErrs = append(allErrs, field.Duplicate(idxPath.Child("name"), sc.Name))
+			}
+			if reason := reservedStorageClassName(sc.Name, csi); reason != "" {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("name"), reason))
+			}
+			names.Insert(sc.Name)
+		}
+
+		if sc.Default != nil && *sc.Default {
+			if defaultClass != "" {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("default"), fmt.Sprintf("storage class %q is already the default", defaultClass)))
+			} else {
+				defaultClass = sc.Name
+			}
+		}
+
+		if sc.ReclaimPolicy != nil && !slices.Contains(supportedReclaimPolicies, *sc.ReclaimPolicy) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("reclaimPolicy"), *sc.ReclaimPolicy, supportedReclaimPolicies))
+		}
+		if sc.Type != nil && !slices.Contains(supportedDiskCategories, *sc.Type) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("type"), *sc.Type, supportedDiskCategories))
+		}
+		if sc.FSType != nil && !slices.Contains(supportedFSTypes, *sc.FSType) {
+			allErrs = append(allErrs, field.NotSupported(idxPath.Child("fsType"), *sc.FSType, supportedFSTypes))
+		}
+
+		diskCategory := "cloud_essd"
+		if sc.Type != nil {
+			diskCategory = *sc.Type
+		}
+		if sc.PerformanceLevel != nil {
+			if !slices.Contains(supportedPerformanceLevels, *sc.PerformanceLevel) {
+				allErrs = append(allErrs, field.NotSupported(idxPath.Child("performanceLevel"), *sc.PerformanceLevel, supportedPerformanceLevels))
+			}
+			if diskCategory != "cloud_essd" {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("performanceLevel"), "only supported for disks of type cloud_essd"))
+			}
+		}
+		if sc.ProvisionedIOPS != nil {
+			if *sc.ProvisionedIOPS < 0 || *sc.ProvisionedIOPS > maxProvisionedIOPS {
+				allErrs = append(allErrs, field.Invalid(idxPath.Child("provisionedIOPS"), *sc.ProvisionedIOPS, fmt.Sprintf("must be between 0 and %d", maxProvisionedIOPS)))
+			}
+			if diskCategory != "cloud_auto" {
+				allErrs = append(allErrs, field.Forbidden(idxPath.Child("provisionedIOPS"), "only supported for disks of type cloud_auto"))
+			}
+		}
+		if sc.KMSKeyID != nil && sc.Encrypted != nil && !*sc.Encrypted {
+			allErrs = append(allErrs, field.Forbidden(idxPath.Child("kmsKeyID"), "requires encrypted disks"))
+		}
+	}
+
 	return allErrs
 }
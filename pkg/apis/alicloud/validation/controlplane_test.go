@@ -9,6 +9,7 @@ import (
 	. "github.com/onsi/gomega"
 	. "github.com/onsi/gomega/gstruct"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/validation"
@@ -46,5 +47,277 @@ var _ = Describe("ControlPlaneConfig validation", func() {
 				})),
 			))
 		})
+
+		It("should fail with an unsupported load balancer type", func() {
+			controlPlane.LoadBalancer = &apisalicloud.LoadBalancerConfig{Type: ptr.To[apisalicloud.LoadBalancerType]("ALB")}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.type"),
+				})),
+			))
+		})
+
+		It("should allow valid load balancer defaults", func() {
+			controlPlane.LoadBalancer = &apisalicloud.LoadBalancerConfig{
+				AddressType: ptr.To(apisalicloud.LoadBalancerAddressTypeIntranet),
+				Bandwidth:   ptr.To[int32](100),
+				PaymentType: ptr.To(apisalicloud.LoadBalancerPaymentTypePayByBandwidth),
+				Spec:        ptr.To("slb.s2.small"),
+			}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with invalid load balancer defaults", func() {
+			controlPlane.LoadBalancer = &apisalicloud.LoadBalancerConfig{
+				AddressType: ptr.To[apisalicloud.LoadBalancerAddressType]("public"),
+				Bandwidth:   ptr.To[int32](0),
+				PaymentType: ptr.To[apisalicloud.LoadBalancerPaymentType]("PayBySpec"),
+				Spec:        ptr.To("slb.s9.huge"),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.addressType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.paymentType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("loadBalancer.bandwidth"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancer.bandwidth"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("loadBalancer.spec"),
+				})),
+			))
+		})
+
+		It("should allow a valid OSS CSI driver configuration", func() {
+			controlPlane.CSI = &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{
+				Enabled:        true,
+				Bucket:         ptr.To("ml-datasets-01"),
+				CredentialsRef: ptr.To("oss-credentials"),
+			}}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with an invalid OSS bucket", func() {
+			controlPlane.CSI = &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{
+				Enabled: true,
+				Bucket:  ptr.To("ML_Datasets"),
+			}}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("csi.oss.bucket"),
+				})),
+			))
+		})
+
+		It("should forbid the OSS bucket and credentials if the OSS CSI driver is disabled", func() {
+			controlPlane.CSI = &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{
+				Bucket:         ptr.To("ml-datasets"),
+				CredentialsRef: ptr.To("oss-credentials"),
+			}}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("csi.oss.bucket"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("csi.oss.credentialsRef"),
+				})),
+			))
+		})
+
+		It("should allow valid storage classes", func() {
+			controlPlane.Storage = &apisalicloud.Storage{StorageClasses: []apisalicloud.StorageClass{
+				{Name: "essd-pl2", Default: ptr.To(true), PerformanceLevel: ptr.To("PL2"), FSType: ptr.To("xfs")},
+				{Name: "auto", Type: ptr.To("cloud_auto"), ProvisionedIOPS: ptr.To[int64](5000), ReclaimPolicy: ptr.To("Retain"), KMSKeyID: ptr.To("key-1")},
+			}}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with invalid storage classes", func() {
+			controlPlane.Storage = &apisalicloud.Storage{StorageClasses: []apisalicloud.StorageClass{
+				{Name: "essd", Default: ptr.To(true), FSType: ptr.To("btrfs"), ProvisionedIOPS: ptr.To[int64](100)},
+				{Name: "essd", Default: ptr.To(true), Type: ptr.To("cloud_auto"), PerformanceLevel: ptr.To("PL1")},
+				{Name: "Encrypted_Disk", Encrypted: ptr.To(false), KMSKeyID: ptr.To("key-1"), ReclaimPolicy: ptr.To("Recycle")},
+			}}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[0].fsType"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[0].provisionedIOPS"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("storage.storageClasses[1].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[1].default"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[1].performanceLevel"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.storageClasses[2].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.storageClasses[2].reclaimPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[2].kmsKeyID"),
+				})),
+			))
+		})
+
+		It("should forbid the names of the NAS storage classes if the NAS CSI driver is enabled", func() {
+			controlPlane.Storage = &apisalicloud.Storage{StorageClasses: []apisalicloud.StorageClass{
+				{Name: "nas"},
+				{Name: "nas-cn-beijing-a"},
+				{Name: "essd-nas"},
+			}}
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+
+			controlPlane.CSI = &apisalicloud.CSI{NAS: &apisalicloud.CSINAS{Enabled: true}}
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[0].name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[1].name"),
+				})),
+			))
+		})
+
+		It("should forbid the name of the OSS storage class if the OSS CSI driver has a bucket", func() {
+			controlPlane.Storage = &apisalicloud.Storage{StorageClasses: []apisalicloud.StorageClass{
+				{Name: "oss"},
+				{Name: "oss-data"},
+			}}
+			controlPlane.CSI = &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{Enabled: true}}
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+
+			controlPlane.CSI.OSS.Bucket = ptr.To("my-bucket")
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.storageClasses[0].name"),
+				})),
+			))
+		})
+
+		It("should allow a valid volume snapshot class", func() {
+			controlPlane.Storage = &apisalicloud.Storage{VolumeSnapshotClass: &apisalicloud.VolumeSnapshotClass{
+				Name:                       ptr.To("essd-snapshots"),
+				DeletionPolicy:             ptr.To("Retain"),
+				InstantAccess:              ptr.To(true),
+				InstantAccessRetentionDays: ptr.To[int32](1),
+				RetentionDays:              ptr.To[int32](30),
+			}}
+
+			Expect(ValidateControlPlaneConfig(controlPlane, "", fldPath)).To(BeEmpty())
+		})
+
+		It("should fail with an invalid volume snapshot class", func() {
+			controlPlane.Storage = &apisalicloud.Storage{VolumeSnapshotClass: &apisalicloud.VolumeSnapshotClass{
+				Name:                       ptr.To("Snapshots"),
+				DeletionPolicy:             ptr.To("Recycle"),
+				InstantAccessRetentionDays: ptr.To[int32](1),
+				RetentionDays:              ptr.To[int32](0),
+			}}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.name"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("storage.volumeSnapshotClass.deletionPolicy"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.retentionDays"),
+				})),
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("storage.volumeSnapshotClass.instantAccessRetentionDays"),
+				})),
+			))
+		})
+
+		It("should forbid an instant access retention longer than the retention of the snapshots", func() {
+			controlPlane.Storage = &apisalicloud.Storage{VolumeSnapshotClass: &apisalicloud.VolumeSnapshotClass{
+				InstantAccess:              ptr.To(true),
+				InstantAccessRetentionDays: ptr.To[int32](7),
+				RetentionDays:              ptr.To[int32](3),
+			}}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("storage.volumeSnapshotClass.instantAccessRetentionDays"),
+				})),
+			))
+		})
+
+		It("should forbid CLB defaults for NLBs", func() {
+			controlPlane.LoadBalancer = &apisalicloud.LoadBalancerConfig{
+				Type:        ptr.To(apisalicloud.LoadBalancerTypeNLB),
+				AddressType: ptr.To(apisalicloud.LoadBalancerAddressTypeIntranet),
+				Spec:        ptr.To("slb.s1.small"),
+			}
+
+			errorList := ValidateControlPlaneConfig(controlPlane, "", fldPath)
+
+			Expect(errorList).To(ConsistOf(
+				PointTo(MatchFields(IgnoreExtras, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("loadBalancer.spec"),
+				})),
+			))
+		})
 	})
 })
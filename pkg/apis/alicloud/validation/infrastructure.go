@@ -6,6 +6,9 @@ package validation
 
 import (
 	"fmt"
+	"net"
+	"regexp"
+	"slices"
 	"strings"
 
 	"github.com/gardener/gardener/pkg/apis/core"
@@ -13,10 +16,17 @@ import (
 	apivalidation "k8s.io/apimachinery/pkg/api/validation"
 	"k8s.io/apimachinery/pkg/util/sets"
 	"k8s.io/apimachinery/pkg/util/validation/field"
+	"k8s.io/utils/ptr"
 
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 )
 
+const (
+	// minWorkersPrefixLength and maxWorkersPrefixLength are the limits of the IPv4 CIDR block of an Alicloud vswitch.
+	minWorkersPrefixLength = 16
+	maxWorkersPrefixLength = 29
+)
+
 // nlbSupportedRegions lists Alicloud regions that support NLB (last updated: 2026-04).
 // Keep in sync with https://help.aliyun.com/zh/slb/network-load-balancer/product-overview/regions-that-support-nlb
 var nlbSupportedRegions = sets.New[string](
@@ -75,6 +85,7 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 	var (
 		cidrs       = make([]cidrvalidation.CIDR, 0, len(infra.Networks.Zones))
 		workerCIDRs = make([]cidrvalidation.CIDR, 0, len(infra.Networks.Zones))
+		vswitchIDs  = sets.New[string]()
 	)
 
 	for i, zone := range infra.Networks.Zones {
@@ -92,31 +103,76 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 			workerCIDRs = append(workerCIDRs, cidrvalidation.NewCIDR(zone.Workers, workerPath))
 		}
 
+		if zone.Worker == "" && zone.Workers == "" {
+			allErrs = append(allErrs, field.Required(networksPath.Child("zones").Index(i).Child("workers"), "must specify the worker CIDR of the zone"))
+		}
+
+		if zone.VSwitchID != nil {
+			vswitchPath := networksPath.Child("zones").Index(i).Child("vswitchID")
+			switch {
+			case *zone.VSwitchID == "":
+				allErrs = append(allErrs, field.Required(vswitchPath, "must not be empty"))
+			case infra.Networks.VPC.ID == nil:
+				allErrs = append(allErrs, field.Forbidden(vswitchPath, "can only be used with an existing VPC (networks.vpc.id)"))
+			case vswitchIDs.Has(*zone.VSwitchID):
+				allErrs = append(allErrs, field.Duplicate(vswitchPath, *zone.VSwitchID))
+			}
+			vswitchIDs.Insert(*zone.VSwitchID)
+		}
+
 		allErrs = append(allErrs, ValidateNatGatewayConfig(zone.NatGateway, networksPath.Child("zones").Index(i).Child("natGateway"))...)
 	}
 
+	allErrs = append(allErrs, validateSNATIPAddresses(infra.Networks.Zones, networksPath.Child("zones"))...)
+
+	if prefixLength := infra.Networks.WorkersPrefixLength; prefixLength != nil && (*prefixLength < minWorkersPrefixLength || *prefixLength > maxWorkersPrefixLength) {
+		allErrs = append(allErrs, field.Invalid(networksPath.Child("workersPrefixLength"), *prefixLength,
+			fmt.Sprintf("must be between %d and %d", minWorkersPrefixLength, maxWorkersPrefixLength)))
+	}
+
 	allErrs = append(allErrs, cidrvalidation.ValidateCIDRParse(cidrs...)...)
 
 	if nodes != nil {
-		allErrs = append(allErrs, nodes.ValidateSubset(workerCIDRs...)...)
+		allErrs = append(allErrs, validateWorkersInNodes(nodes, infra.Networks.VPC.SecondaryCIDRs, workerCIDRs)...)
 	}
 
 	if (infra.Networks.VPC.ID == nil && infra.Networks.VPC.CIDR == nil) || (infra.Networks.VPC.ID != nil && infra.Networks.VPC.CIDR != nil) {
 		allErrs = append(allErrs, field.Invalid(networksPath.Child("vpc"), infra.Networks.VPC, "must specify either a vpc id or a cidr"))
-	} else if infra.Networks.VPC.CIDR != nil && infra.Networks.VPC.ID == nil {
-		cidrPath := networksPath.Child("vpc", "cidr")
-		vpcCIDR := cidrvalidation.NewCIDR(*infra.Networks.VPC.CIDR, cidrPath)
-		allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, *infra.Networks.VPC.CIDR)...)
-		allErrs = append(allErrs, vpcCIDR.ValidateParse()...)
-		allErrs = append(allErrs, vpcCIDR.ValidateSubset(nodes)...)
-		allErrs = append(allErrs, vpcCIDR.ValidateSubset(cidrs...)...)
-		allErrs = append(allErrs, vpcCIDR.ValidateNotOverlap(pods, services)...)
+	} else {
+		var vpcCIDRs []cidrvalidation.CIDR
+		if infra.Networks.VPC.CIDR != nil {
+			cidrPath := networksPath.Child("vpc", "cidr")
+			vpcCIDR := cidrvalidation.NewCIDR(*infra.Networks.VPC.CIDR, cidrPath)
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, *infra.Networks.VPC.CIDR)...)
+			allErrs = append(allErrs, vpcCIDR.ValidateParse()...)
+			vpcCIDRs = append(vpcCIDRs, vpcCIDR)
+		}
+		for i, secondaryCIDR := range infra.Networks.VPC.SecondaryCIDRs {
+			cidrPath := networksPath.Child("vpc", "secondaryCIDRs").Index(i)
+			vpcCIDR := cidrvalidation.NewCIDR(secondaryCIDR, cidrPath)
+			allErrs = append(allErrs, cidrvalidation.ValidateCIDRIsCanonical(cidrPath, secondaryCIDR)...)
+			allErrs = append(allErrs, vpcCIDR.ValidateParse()...)
+			allErrs = append(allErrs, vpcCIDR.ValidateIPFamily(string(core.IPFamilyIPv4))...)
+			vpcCIDRs = append(vpcCIDRs, vpcCIDR)
+		}
+		// make sure that the VPC cidr and the secondary cidrs don't overlap with each other
+		allErrs = append(allErrs, cidrvalidation.ValidateCIDROverlap(vpcCIDRs, false)...)
+		for _, vpcCIDR := range vpcCIDRs {
+			allErrs = append(allErrs, vpcCIDR.ValidateNotOverlap(pods, services)...)
+		}
+		// the CIDRs of an existing VPC are unknown here, they are validated by the infrastructure controller
+		if infra.Networks.VPC.ID == nil {
+			allErrs = append(allErrs, validateSubsetOfAny(vpcCIDRs, nodes)...)
+			allErrs = append(allErrs, validateSubsetOfAny(vpcCIDRs, cidrs...)...)
+		}
 	}
 
+	allErrs = append(allErrs, validateEgress(infra, networksPath)...)
+
 	// When useCustomRouteTable is enabled with a user-provided VPC, gardenerManagedNATGateway must be true.
 	// This ensures each shoot manages its own NAT Gateway, preventing a multi-shoot VPC scenario where
 	// one shoot's cleanup deletes a shared NAT Gateway that other shoots in the same VPC depend on.
-	if infra.Networks.VPC.ID != nil &&
+	if infra.Networks.VPC.ID != nil && !isEgressModeNone(infra) &&
 		infra.Networks.VPC.UseCustomRouteTable != nil && *infra.Networks.VPC.UseCustomRouteTable &&
 		(infra.Networks.VPC.GardenerManagedNATGateway == nil || !*infra.Networks.VPC.GardenerManagedNATGateway) {
 		allErrs = append(allErrs, field.Required(
@@ -134,6 +190,10 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 		allErrs = append(allErrs, services.ValidateNotOverlap(cidrs...)...)
 	}
 
+	allErrs = append(allErrs, validateFlowLogs(infra.FlowLogs, field.NewPath("flowLogs"))...)
+	allErrs = append(allErrs, validateNAS(infra.NAS, field.NewPath("nas"))...)
+	allErrs = append(allErrs, validateIPv6InternetBandwidth(infra.DualStack, field.NewPath("dualStack"))...)
+
 	// DualStack validation
 	if infra.DualStack != nil && infra.DualStack.Enabled {
 		// NLB region check applies to both VPC types
@@ -182,6 +242,183 @@ func ValidateInfrastructureConfig(infra *apisalicloud.InfrastructureConfig, netw
 	return allErrs
 }
 
+var supportedFlowLogTrafficTypes = sets.New("All", "Allow", "Drop")
+
+var (
+	// slsProjectNameRegex and slsLogstoreNameRegex match the names of SLS projects and logstores,
+	// see https://www.alibabacloud.com/help/en/sls/user-guide/manage-a-project.
+	slsProjectNameRegex  = regexp.MustCompile(`^[a-z0-9][a-z0-9-]{1,61}[a-z0-9]$`)
+	slsLogstoreNameRegex = regexp.MustCompile(`^[a-z0-9][a-z0-9_-]{1,61}[a-z0-9]$`)
+)
+
+func validateFlowLogs(flowLogs *apisalicloud.FlowLogs, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if flowLogs == nil {
+		return allErrs
+	}
+	if flowLogs.Project == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("project"), "must specify the SLS project"))
+	}
+	if flowLogs.Logstore == "" {
+		allErrs = append(allErrs, field.Required(fldPath.Child("logstore"), "must specify the SLS logstore"))
+	}
+	if flowLogs.TrafficType != nil && !supportedFlowLogTrafficTypes.Has(*flowLogs.TrafficType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("trafficType"), *flowLogs.TrafficType, sets.List(supportedFlowLogTrafficTypes)))
+	}
+	if ptr.Deref(flowLogs.Create, false) {
+		if flowLogs.Project != "" && !slsProjectNameRegex.MatchString(flowLogs.Project) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("project"), flowLogs.Project, "must consist of 3 to 63 lowercase letters, digits or hyphens and must start and end with a letter or digit"))
+		}
+		if flowLogs.Logstore != "" && !slsLogstoreNameRegex.MatchString(flowLogs.Logstore) {
+			allErrs = append(allErrs, field.Invalid(fldPath.Child("logstore"), flowLogs.Logstore, "must consist of 3 to 63 lowercase letters, digits, hyphens or underscores and must start and end with a letter or digit"))
+		}
+	}
+	return allErrs
+}
+
+var supportedNASStorageTypes = sets.New("Capacity", "Performance")
+
+func validateNAS(nas *apisalicloud.NAS, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if nas == nil {
+		return allErrs
+	}
+	if nas.StorageType != nil && !supportedNASStorageTypes.Has(*nas.StorageType) {
+		allErrs = append(allErrs, field.NotSupported(fldPath.Child("storageType"), *nas.StorageType, sets.List(supportedNASStorageTypes)))
+	}
+	return allErrs
+}
+
+// maxIPv6InternetBandwidths are the maximum IPv6 internet bandwidths in Mbit/s per charge type.
+var maxIPv6InternetBandwidths = map[string]int32{
+	"PayByTraffic":   1000,
+	"PayByBandwidth": 5000,
+}
+
+func validateIPv6InternetBandwidth(dualStack *apisalicloud.DualStack, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	if dualStack == nil || dualStack.InternetBandwidth == nil {
+		return allErrs
+	}
+	bwPath := fldPath.Child("internetBandwidth")
+	if !dualStack.Enabled {
+		allErrs = append(allErrs, field.Forbidden(bwPath, "IPv6 internet bandwidth requires dualStack.enabled"))
+	}
+	chargeType := "PayByTraffic"
+	if dualStack.InternetBandwidth.InternetChargeType != nil {
+		chargeType = *dualStack.InternetBandwidth.InternetChargeType
+	}
+	maxBandwidth, ok := maxIPv6InternetBandwidths[chargeType]
+	if !ok {
+		allErrs = append(allErrs, field.NotSupported(bwPath.Child("internetChargeType"), chargeType, []string{"PayByTraffic", "PayByBandwidth"}))
+		return allErrs
+	}
+	if bandwidth := dualStack.InternetBandwidth.Bandwidth; bandwidth < 1 || bandwidth > maxBandwidth {
+		allErrs = append(allErrs, field.Invalid(bwPath.Child("bandwidth"), bandwidth,
+			fmt.Sprintf("bandwidth must be in range 1-%d Mbit/s for internet charge type %s", maxBandwidth, chargeType)))
+	}
+	return allErrs
+}
+
+func isEgressModeNone(infra *apisalicloud.InfrastructureConfig) bool {
+	return infra.Networks.VPC.EgressMode != nil && *infra.Networks.VPC.EgressMode == apisalicloud.EgressModeNone
+}
+
+// validateEgress validates the egress mode and the egress CIDRs. Without NAT gateways, the egress traffic relies on
+// the default route of an existing VPC, so no Gardener-managed NAT gateway, custom route table or zone NAT gateway
+// configuration may be specified.
+func validateEgress(infra *apisalicloud.InfrastructureConfig, networksPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	vpc := infra.Networks.VPC
+	vpcPath := networksPath.Child("vpc")
+
+	if vpc.EgressMode != nil && *vpc.EgressMode != apisalicloud.EgressModeNATGateway && *vpc.EgressMode != apisalicloud.EgressModeNone {
+		allErrs = append(allErrs, field.NotSupported(vpcPath.Child("egressMode"), *vpc.EgressMode,
+			[]string{string(apisalicloud.EgressModeNATGateway), string(apisalicloud.EgressModeNone)}))
+		return allErrs
+	}
+
+	if !isEgressModeNone(infra) {
+		if len(vpc.EgressCIDRs) > 0 {
+			allErrs = append(allErrs, field.Forbidden(vpcPath.Child("egressCIDRs"), "can only be used with egress mode None"))
+		}
+		return allErrs
+	}
+
+	if vpc.ID == nil {
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("egressMode"), "egress mode None can only be used with an existing VPC (networks.vpc.id)"))
+	}
+	if vpc.GardenerManagedNATGateway != nil && *vpc.GardenerManagedNATGateway {
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("gardenerManagedNATGateway"), "cannot be used with egress mode None"))
+	}
+	if vpc.UseCustomRouteTable != nil && *vpc.UseCustomRouteTable {
+		allErrs = append(allErrs, field.Forbidden(vpcPath.Child("useCustomRouteTable"), "cannot be used with egress mode None"))
+	}
+	for i, zone := range infra.Networks.Zones {
+		if zone.NatGateway != nil {
+			allErrs = append(allErrs, field.Forbidden(networksPath.Child("zones").Index(i).Child("natGateway"), "cannot be used with egress mode None"))
+		}
+	}
+	for i, cidr := range vpc.EgressCIDRs {
+		allErrs = append(allErrs, cidrvalidation.NewCIDR(cidr, vpcPath.Child("egressCIDRs").Index(i)).ValidateParse()...)
+	}
+
+	return allErrs
+}
+
+// validateSubsetOfAny returns errors for all subsets which are not a subset of any of the given VPC CIDRs.
+// validateWorkersInNodes checks that the worker CIDRs are in the nodes CIDR. As the nodes CIDR cannot be changed, the
+// worker CIDRs of zones may also be in a secondary CIDR of the VPC outside of the nodes CIDR, which are published as
+// additional node CIDRs in the status of the infrastructure.
+func validateWorkersInNodes(nodes cidrvalidation.CIDR, secondaryCIDRs []string, workerCIDRs []cidrvalidation.CIDR) field.ErrorList {
+	if len(secondaryCIDRs) == 0 {
+		return nodes.ValidateSubset(workerCIDRs...)
+	}
+
+	allErrs := field.ErrorList{}
+	for _, workerCIDR := range workerCIDRs {
+		if len(nodes.ValidateSubset(workerCIDR)) == 0 {
+			continue
+		}
+		contained := false
+		for i, secondaryCIDR := range secondaryCIDRs {
+			if cidr := cidrvalidation.NewCIDR(secondaryCIDR, field.NewPath("networks", "vpc", "secondaryCIDRs").Index(i)); cidr.Parse() && len(cidr.ValidateSubset(workerCIDR)) == 0 {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			allErrs = append(allErrs, field.Invalid(workerCIDR.GetFieldPath(), workerCIDR.GetCIDR(),
+				fmt.Sprintf("must be a subset of \"networking.nodes\" (%q) or one of \"networks.vpc.secondaryCIDRs\"", nodes.GetCIDR())))
+		}
+	}
+	return allErrs
+}
+
+func validateSubsetOfAny(vpcCIDRs []cidrvalidation.CIDR, subsets ...cidrvalidation.CIDR) field.ErrorList {
+	if len(vpcCIDRs) == 1 {
+		return vpcCIDRs[0].ValidateSubset(subsets...)
+	}
+
+	allErrs := field.ErrorList{}
+	for _, subset := range subsets {
+		if subset == nil || !subset.Parse() {
+			continue
+		}
+		contained := false
+		for _, vpcCIDR := range vpcCIDRs {
+			if vpcCIDR.Parse() && len(vpcCIDR.ValidateSubset(subset)) == 0 {
+				contained = true
+				break
+			}
+		}
+		if !contained {
+			allErrs = append(allErrs, field.Invalid(subset.GetFieldPath(), subset.GetCIDR(), "must be a subset of \"networks.vpc.cidr\" or one of \"networks.vpc.secondaryCIDRs\""))
+		}
+	}
+	return allErrs
+}
+
 // ValidateInfrastructureConfigUpdate validates a InfrastructureConfig object.
 func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.InfrastructureConfig) field.ErrorList {
 	allErrs := field.ErrorList{}
@@ -196,7 +433,14 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.Infra
 	normalizedNewVPC := newConfig.Networks.VPC
 	normalizedOldVPC.UseCustomRouteTable = nil
 	normalizedNewVPC.UseCustomRouteTable = nil
+	// EgressCIDRs are only reported in the status and can be changed at any time.
+	normalizedOldVPC.EgressCIDRs = nil
+	normalizedNewVPC.EgressCIDRs = nil
+	// SecondaryCIDRs can be added to a VPC which runs out of addresses, they are validated separately.
+	normalizedOldVPC.SecondaryCIDRs = nil
+	normalizedNewVPC.SecondaryCIDRs = nil
 	allErrs = append(allErrs, apivalidation.ValidateImmutableField(normalizedNewVPC, normalizedOldVPC, vpcPath)...)
+	allErrs = append(allErrs, validateSecondaryCIDRsUpdate(oldConfig.Networks.VPC.SecondaryCIDRs, newConfig.Networks.VPC.SecondaryCIDRs, newConfig.Networks.Zones, vpcPath.Child("secondaryCIDRs"))...)
 
 	// Any change in effective value (nil/false ↔ true in either direction) is forbidden after creation.
 	if normalizeUseCustomRouteTable(oldConfig.Networks.VPC.UseCustomRouteTable) !=
@@ -217,6 +461,58 @@ func ValidateInfrastructureConfigUpdate(oldConfig, newConfig *apisalicloud.Infra
 			"dualStack cannot be disabled once enabled"))
 	}
 
+	// The NAS file system holds the data of the volumes, so it is neither replaced nor deleted with the shoot running.
+	nasPath := field.NewPath("nas")
+	if oldConfig.NAS != nil {
+		if newConfig.NAS == nil {
+			allErrs = append(allErrs, field.Forbidden(nasPath, "the NAS file system cannot be removed once created"))
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.NAS.StorageType, oldConfig.NAS.StorageType, nasPath.Child("storageType"))...)
+		}
+	}
+
+	// The SLS project and logstore created for the flow logs are only deleted together with the shoot, so they are
+	// neither replaced nor released with the shoot running.
+	flowLogsPath := field.NewPath("flowLogs")
+	if oldConfig.FlowLogs != nil && ptr.Deref(oldConfig.FlowLogs.Create, false) {
+		if newConfig.FlowLogs == nil || !ptr.Deref(newConfig.FlowLogs.Create, false) {
+			allErrs = append(allErrs, field.Forbidden(flowLogsPath.Child("create"), "the SLS project and logstore cannot be released once created"))
+		} else {
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FlowLogs.Project, oldConfig.FlowLogs.Project, flowLogsPath.Child("project"))...)
+			allErrs = append(allErrs, apivalidation.ValidateImmutableField(newConfig.FlowLogs.Logstore, oldConfig.FlowLogs.Logstore, flowLogsPath.Child("logstore"))...)
+		}
+	}
+
+	return allErrs
+}
+
+// validateSecondaryCIDRsUpdate forbids removing a secondary CIDR block of the VPC which still contains the worker CIDR of a zone.
+func validateSecondaryCIDRsUpdate(oldCIDRs, newCIDRs []string, zones []apisalicloud.Zone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	for _, oldCIDR := range oldCIDRs {
+		if slices.Contains(newCIDRs, oldCIDR) {
+			continue
+		}
+		_, removed, err := net.ParseCIDR(oldCIDR)
+		if err != nil {
+			continue
+		}
+		for _, zone := range zones {
+			workers := zone.Workers
+			if workers == "" {
+				workers = zone.Worker
+			}
+			_, workersNet, err := net.ParseCIDR(workers)
+			if err != nil {
+				continue
+			}
+			if removed.Contains(workersNet.IP) || workersNet.Contains(removed.IP) {
+				allErrs = append(allErrs, field.Forbidden(fldPath, fmt.Sprintf("secondary CIDR %s cannot be removed as it contains the workers CIDR %s of zone %s", oldCIDR, workers, zone.Name)))
+			}
+		}
+	}
+
 	return allErrs
 }
 
@@ -242,6 +538,9 @@ func ValidateNetworkZonesConfig(newZones, oldZones []apisalicloud.Zone, fldPath
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].Workers, newZones[i].Workers, fldPath.Index(i))...)
 			allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].Worker, newZones[i].Worker, fldPath.Index(i))...)
 		}
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(oldZones[i].VSwitchID, newZones[i].VSwitchID, fldPath.Index(i).Child("vswitchID"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(natGatewayType(oldZones[i]), natGatewayType(newZones[i]), fldPath.Index(i).Child("natGateway", "type"))...)
+		allErrs = append(allErrs, apivalidation.ValidateImmutableField(snatIPAddress(oldZones[i]), snatIPAddress(newZones[i]), fldPath.Index(i).Child("natGateway", "snatIPAddress"))...)
 		// Ipv6CidrBlock can be changed but not removed once set
 		if oldZones[i].Ipv6CidrBlock != nil && newZones[i].Ipv6CidrBlock == nil {
 			allErrs = append(allErrs, field.Invalid(
@@ -258,6 +557,52 @@ func ValidateNetworkZonesConfig(newZones, oldZones []apisalicloud.Zone, fldPath
 	return allErrs
 }
 
+func snatIPAddress(zone apisalicloud.Zone) *string {
+	if zone.NatGateway == nil {
+		return nil
+	}
+	return zone.NatGateway.SNATIPAddress
+}
+
+// validateSNATIPAddresses checks that the SNAT IP addresses of the zones with a NAT gateway of type VPC are in the
+// workers CIDR of the first of these zones, as the VPC NAT gateway is placed in its vswitch.
+func validateSNATIPAddresses(zones []apisalicloud.Zone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+
+	var gatewayCIDR *net.IPNet
+	for i, zone := range zones {
+		if natGatewayType(zone) != apisalicloud.NatGatewayTypeVPC {
+			continue
+		}
+		if gatewayCIDR == nil {
+			workers := zone.Workers
+			if workers == "" {
+				workers = zone.Worker
+			}
+			if _, cidr, err := net.ParseCIDR(workers); err == nil {
+				gatewayCIDR = cidr
+			} else {
+				// invalid CIDRs are reported by the validation of the workers
+				return allErrs
+			}
+		}
+		if address := snatIPAddress(zone); address != nil {
+			if ip := net.ParseIP(*address); ip != nil && ip.To4() != nil && !gatewayCIDR.Contains(ip) {
+				allErrs = append(allErrs, field.Invalid(fldPath.Index(i).Child("natGateway", "snatIPAddress"), *address,
+					fmt.Sprintf("must be in the workers CIDR %s of the first zone with a NAT gateway of type VPC", gatewayCIDR)))
+			}
+		}
+	}
+	return allErrs
+}
+
+func natGatewayType(zone apisalicloud.Zone) apisalicloud.NatGatewayType {
+	if zone.NatGateway == nil || zone.NatGateway.Type == nil {
+		return apisalicloud.NatGatewayTypeInternet
+	}
+	return *zone.NatGateway.Type
+}
+
 // check if migrate from worker to workers
 func isZoneMigratWorkerToWorkers(oldZone, newZone apisalicloud.Zone) bool {
 	if oldZone.Worker != "" && oldZone.Workers == "" && newZone.Worker == "" && newZone.Workers != "" {
@@ -270,12 +615,39 @@ func isZoneMigratWorkerToWorkers(oldZone, newZone apisalicloud.Zone) bool {
 func ValidateNatGatewayConfig(natGateway *apisalicloud.NatGatewayConfig, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 
-	if natGateway != nil {
-		if natGateway.EIPAllocationID == nil {
+	if natGateway == nil {
+		return allErrs
+	}
+
+	if natGateway.Type != nil {
+		switch *natGateway.Type {
+		case apisalicloud.NatGatewayTypeInternet:
+		case apisalicloud.NatGatewayTypeVPC:
+			if natGateway.EIPAllocationID != nil {
+				allErrs = append(allErrs, field.Forbidden(fldPath.Child("eipAllocationID"), "cannot be used with a NAT gateway of type VPC"))
+			}
+			if natGateway.SNATIPAddress != nil {
+				if ip := net.ParseIP(*natGateway.SNATIPAddress); ip == nil || ip.To4() == nil {
+					allErrs = append(allErrs, field.Invalid(fldPath.Child("snatIPAddress"), *natGateway.SNATIPAddress, "must be an IPv4 address"))
+				}
+			}
+			return allErrs
+		default:
+			allErrs = append(allErrs, field.NotSupported(fldPath.Child("type"), *natGateway.Type, []string{string(apisalicloud.NatGatewayTypeInternet), string(apisalicloud.NatGatewayTypeVPC)}))
+			return allErrs
+		}
+	}
+
+	if natGateway.SNATIPAddress != nil {
+		allErrs = append(allErrs, field.Forbidden(fldPath.Child("snatIPAddress"), "can only be used with a NAT gateway of type VPC"))
+	}
+
+	if natGateway.EIPAllocationID == nil {
+		if natGateway.Type == nil {
 			allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id is not specified"))
-		} else if *natGateway.EIPAllocationID == "" {
-			allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id cannot be empty string"))
 		}
+	} else if *natGateway.EIPAllocationID == "" {
+		allErrs = append(allErrs, field.Invalid(fldPath, natGateway, "eip id cannot be empty string"))
 	}
 
 	return allErrs
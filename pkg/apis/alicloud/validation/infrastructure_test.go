@@ -167,6 +167,141 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))
 			})
 
+			It("should allow workers CIDRs from secondary VPC CIDRs", func() {
+				networking.Nodes = nil
+				infrastructureConfig.Networks.VPC.CIDR = ptr.To("10.250.0.0/16")
+				infrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+				infrastructureConfig.Networks.Zones[1].Workers = "172.16.4.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should allow workers CIDRs from secondary VPC CIDRs outside of the nodes CIDR", func() {
+				infrastructureConfig.Networks.VPC.CIDR = ptr.To("10.250.0.0/16")
+				infrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+				infrastructureConfig.Networks.Zones[1].Workers = "172.16.4.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid workers CIDRs outside of the nodes CIDR and the secondary VPC CIDRs", func() {
+				infrastructureConfig.Networks.VPC.CIDR = ptr.To("10.0.0.0/8")
+				infrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+				infrastructureConfig.Networks.Zones[1].Workers = "10.251.4.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": Equal(`must be a subset of "networking.nodes" ("10.250.0.0/16") or one of "networks.vpc.secondaryCIDRs"`),
+				}))
+			})
+
+			It("should forbid workers CIDRs outside of all VPC CIDRs", func() {
+				networking.Nodes = nil
+				infrastructureConfig.Networks.VPC.CIDR = ptr.To("10.250.0.0/16")
+				infrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+				infrastructureConfig.Networks.Zones[1].Workers = "172.17.4.0/24"
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.zones[1].workers"),
+					"Detail": Equal(`must be a subset of "networks.vpc.cidr" or one of "networks.vpc.secondaryCIDRs"`),
+				}))
+			})
+
+			It("should forbid overlapping or invalid secondary VPC CIDRs", func() {
+				infrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"10.1.0.0/16", "100.64.0.0/10", "fd00::/64"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.vpc.secondaryCIDRs[0]"),
+					"Detail": Equal(`must not overlap with "networks.vpc.cidr" ("10.0.0.0/8")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.pods"),
+					"Detail": Equal(`must not overlap with "networks.vpc.secondaryCIDRs[1]" ("100.64.0.0/10")`),
+				}, Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networking.services"),
+					"Detail": Equal(`must not overlap with "networks.vpc.secondaryCIDRs[1]" ("100.64.0.0/10")`),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.secondaryCIDRs[2]"),
+				}))
+			})
+
+			It("should require a workers CIDR for every zone", func() {
+				infrastructureConfig.Networks.Zones[1].Workers = ""
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[1].workers"),
+				}))
+			})
+
+			It("should forbid an invalid workers prefix length", func() {
+				infrastructureConfig.Networks.WorkersPrefixLength = ptr.To[int32](30)
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":   Equal(field.ErrorTypeInvalid),
+					"Field":  Equal("networks.workersPrefixLength"),
+					"Detail": Equal("must be between 16 and 29"),
+				}))
+			})
+
+			It("should allow existing vswitches in an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{ID: ptr.To("vpc-123")}
+				infrastructureConfig.Networks.Zones[0].VSwitchID = ptr.To("vsw-1")
+				infrastructureConfig.Networks.Zones[1].VSwitchID = ptr.To("vsw-2")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid existing vswitches in a managed VPC", func() {
+				infrastructureConfig.Networks.Zones[0].VSwitchID = ptr.To("vsw-1")
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].vswitchID"),
+				}))
+			})
+
+			It("should forbid empty or duplicate vswitch IDs", func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{ID: ptr.To("vpc-123")}
+				infrastructureConfig.Networks.Zones[0].VSwitchID = ptr.To("vsw-1")
+				infrastructureConfig.Networks.Zones[1].VSwitchID = ptr.To("vsw-1")
+				infrastructureConfig.Networks.Zones = append(infrastructureConfig.Networks.Zones, apisalicloud.Zone{
+					Name:      "zone3",
+					Workers:   "10.250.5.0/24",
+					VSwitchID: ptr.To(""),
+				})
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeDuplicate),
+					"Field": Equal("networks.zones[1].vswitchID"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("networks.zones[2].vswitchID"),
+				}))
+			})
+
 			It("should allow specifying eip id", func() {
 				ipAllocID := "eip-ufxsdckfgitzcz"
 				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
@@ -177,6 +312,96 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				Expect(errorList).To(BeEmpty())
 			})
 
+			It("should allow a VPC NAT gateway without eip id", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type: ptr.To(apisalicloud.NatGatewayTypeVPC),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid an eip id for a VPC NAT gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type:            ptr.To(apisalicloud.NatGatewayTypeVPC),
+					EIPAllocationID: ptr.To("eip-ufxsdckfgitzcz"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.eipAllocationID"),
+				}))
+			})
+
+			It("should allow a SNAT IP address of a VPC NAT gateway in the workers CIDR of the first VPC zone", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type:          ptr.To(apisalicloud.NatGatewayTypeVPC),
+					SNATIPAddress: ptr.To("10.250.3.10"),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type:          ptr.To(apisalicloud.NatGatewayTypeVPC),
+					SNATIPAddress: ptr.To("10.250.3.11"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid a SNAT IP address outside of the workers CIDR of the first VPC zone", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type: ptr.To(apisalicloud.NatGatewayTypeVPC),
+				}
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type:          ptr.To(apisalicloud.NatGatewayTypeVPC),
+					SNATIPAddress: ptr.To("10.250.4.10"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[1].natGateway.snatIPAddress"),
+				}))
+			})
+
+			It("should forbid a SNAT IP address which is not an IPv4 address", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type:          ptr.To(apisalicloud.NatGatewayTypeVPC),
+					SNATIPAddress: ptr.To("2001:db8::1"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.zones[0].natGateway.snatIPAddress"),
+				}))
+			})
+
+			It("should forbid a SNAT IP address for an internet NAT gateway", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationID: ptr.To("eip-ufxsdckfgitzcz"),
+					SNATIPAddress:   ptr.To("10.250.3.10"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[0].natGateway.snatIPAddress"),
+				}))
+			})
+
+			It("should forbid an unknown NAT gateway type", func() {
+				infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+					Type: ptr.To(apisalicloud.NatGatewayType("Transit")),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.zones[0].natGateway.type"),
+				}))
+			})
+
 			It("should allow specifying valid config", func() {
 				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
 				Expect(errorList).To(BeEmpty())
@@ -194,6 +419,58 @@ var _ = Describe("InfrastructureConfig validation", func() {
 		Context("dualStack", func() {
 			var vpcID = "vpc-12345678"
 
+			Context("internetBandwidth", func() {
+				It("should allow IPv6 internet bandwidth with dual-stack", func() {
+					infrastructureConfig.DualStack = &apisalicloud.DualStack{
+						Enabled: true,
+						InternetBandwidth: &apisalicloud.IPv6InternetBandwidth{
+							Bandwidth:          2000,
+							InternetChargeType: ptr.To("PayByBandwidth"),
+							EgressOnly:         ptr.To(false),
+						},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+					Expect(errorList).To(BeEmpty())
+				})
+
+				It("should forbid IPv6 internet bandwidth without dual-stack or out of range", func() {
+					infrastructureConfig.DualStack = &apisalicloud.DualStack{
+						InternetBandwidth: &apisalicloud.IPv6InternetBandwidth{
+							Bandwidth: 2000,
+						},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeForbidden),
+						"Field": Equal("dualStack.internetBandwidth"),
+					}, Fields{
+						"Type":  Equal(field.ErrorTypeInvalid),
+						"Field": Equal("dualStack.internetBandwidth.bandwidth"),
+					}))
+				})
+
+				It("should forbid an unknown internet charge type", func() {
+					infrastructureConfig.DualStack = &apisalicloud.DualStack{
+						Enabled: true,
+						InternetBandwidth: &apisalicloud.IPv6InternetBandwidth{
+							Bandwidth:          10,
+							InternetChargeType: ptr.To("PrePaid"),
+						},
+					}
+
+					errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+					Expect(errorList).To(ConsistOfFields(Fields{
+						"Type":  Equal(field.ErrorTypeNotSupported),
+						"Field": Equal("dualStack.internetBandwidth.internetChargeType"),
+					}))
+				})
+			})
+
 			Context("Gardener-managed VPC (no VPC.ID)", func() {
 				It("should pass when all zones omit ipv6CidrBlock (defaults to zone index)", func() {
 					infrastructureConfig.DualStack = &apisalicloud.DualStack{Enabled: true}
@@ -508,13 +785,257 @@ var _ = Describe("InfrastructureConfig validation", func() {
 				}))))
 			})
 		})
+
+		Context("flowLogs", func() {
+			It("should allow flow logs into an SLS logstore", func() {
+				infrastructureConfig.FlowLogs = &apisalicloud.FlowLogs{
+					Project:     "shoot-logs",
+					Logstore:    "flowlogs",
+					TrafficType: ptr.To("Drop"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid flow logs without project and logstore or with an unknown traffic type", func() {
+				infrastructureConfig.FlowLogs = &apisalicloud.FlowLogs{
+					TrafficType: ptr.To("Reject"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("flowLogs.project"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("flowLogs.logstore"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("flowLogs.trafficType"),
+				}))
+			})
+
+			It("should forbid invalid names of an SLS project and logstore to be created", func() {
+				infrastructureConfig.FlowLogs = &apisalicloud.FlowLogs{
+					Project:  "Shoot_Logs",
+					Logstore: "flow.logs",
+					Create:   ptr.To(true),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.project"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("flowLogs.logstore"),
+				}))
+			})
+		})
+
+		Context("nas", func() {
+			It("should allow a NAS file system with a supported storage type", func() {
+				infrastructureConfig.NAS = &apisalicloud.NAS{StorageType: ptr.To("Performance")}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")).To(BeEmpty())
+			})
+
+			It("should forbid an unknown storage type", func() {
+				infrastructureConfig.NAS = &apisalicloud.NAS{StorageType: ptr.To("Premium")}
+
+				Expect(ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("nas.storageType"),
+				}))
+			})
+		})
+
+		Context("egressMode", func() {
+			var vpcID = "vpc-12345678"
+
+			BeforeEach(func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{
+					ID:         &vpcID,
+					EgressMode: ptr.To(apisalicloud.EgressModeNone),
+				}
+			})
+
+			It("should allow egress mode None with an existing VPC", func() {
+				infrastructureConfig.Networks.VPC.EgressCIDRs = []string{"192.168.0.0/24"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(BeEmpty())
+			})
+
+			It("should forbid egress mode None without an existing VPC", func() {
+				infrastructureConfig.Networks.VPC = apisalicloud.VPC{
+					CIDR:       &vpc,
+					EgressMode: ptr.To(apisalicloud.EgressModeNone),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.egressMode"),
+				}))
+			})
+
+			It("should forbid NAT gateway settings with egress mode None", func() {
+				infrastructureConfig.Networks.VPC.GardenerManagedNATGateway = ptr.To(true)
+				infrastructureConfig.Networks.VPC.UseCustomRouteTable = ptr.To(true)
+				infrastructureConfig.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{
+					EIPAllocationID: ptr.To("eip-ufxsdckfgitzcz"),
+				}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.gardenerManagedNATGateway"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.useCustomRouteTable"),
+				}, Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.zones[1].natGateway"),
+				}))
+			})
+
+			It("should forbid invalid egress CIDRs", func() {
+				infrastructureConfig.Networks.VPC.EgressCIDRs = []string{invalidCIDR}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeInvalid),
+					"Field": Equal("networks.vpc.egressCIDRs[0]"),
+				}))
+			})
+
+			It("should forbid egress CIDRs without egress mode None", func() {
+				infrastructureConfig.Networks.VPC.EgressMode = nil
+				infrastructureConfig.Networks.VPC.EgressCIDRs = []string{"192.168.0.0/24"}
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeForbidden),
+					"Field": Equal("networks.vpc.egressCIDRs"),
+				}))
+			})
+
+			It("should forbid an unknown egress mode", func() {
+				infrastructureConfig.Networks.VPC.EgressMode = ptr.To(apisalicloud.EgressMode("Firewall"))
+
+				errorList := ValidateInfrastructureConfig(infrastructureConfig, &networking, "cn-hangzhou")
+
+				Expect(errorList).To(ConsistOfFields(Fields{
+					"Type":  Equal(field.ErrorTypeNotSupported),
+					"Field": Equal("networks.vpc.egressMode"),
+				}))
+			})
+		})
 	})
 
 	Describe("#ValidateInfrastructureConfigUpdate", func() {
+		It("should allow changing the egress CIDRs but not the egress mode", func() {
+			infrastructureConfig.Networks.VPC = apisalicloud.VPC{
+				ID:         ptr.To("vpc-12345678"),
+				EgressMode: ptr.To(apisalicloud.EgressModeNone),
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.EgressCIDRs = []string{"192.168.0.0/24"}
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+
+			newInfrastructureConfig.Networks.VPC.EgressMode = nil
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.vpc"),
+			}))
+		})
+
+		It("should allow adding a secondary CIDR block", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+			newInfrastructureConfig.Networks.Zones = append(newInfrastructureConfig.Networks.Zones, apisalicloud.Zone{Name: "zone3", Workers: "172.16.0.0/24"})
+
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should allow removing an unused secondary CIDR block", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16", "172.17.0.0/16"}
+			oldInfrastructureConfig.Networks.Zones = append(oldInfrastructureConfig.Networks.Zones, apisalicloud.Zone{Name: "zone3", Workers: "172.16.0.0/24"})
+			newInfrastructureConfig := oldInfrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+		})
+
+		It("should forbid removing a secondary CIDR block which contains the workers of a zone", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+			oldInfrastructureConfig.Networks.Zones = append(oldInfrastructureConfig.Networks.Zones, apisalicloud.Zone{Name: "zone3", Workers: "172.16.0.0/24"})
+			newInfrastructureConfig := oldInfrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.VPC.SecondaryCIDRs = nil
+
+			Expect(ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeForbidden),
+				"Field":  Equal("networks.vpc.secondaryCIDRs"),
+				"Detail": ContainSubstring("zone3"),
+			}))
+		})
+
 		It("should return no errors for an unchanged config", func() {
 			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, infrastructureConfig)).To(BeEmpty())
 		})
 
+		It("should forbid releasing or replacing the created SLS project and logstore of the flow logs", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.FlowLogs = &apisalicloud.FlowLogs{Project: "shoot-logs", Logstore: "flowlogs", Create: ptr.To(true)}
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+
+			changedInfrastructureConfig := newInfrastructureConfig.DeepCopy()
+			changedInfrastructureConfig.FlowLogs.Project = "other-logs"
+			changedInfrastructureConfig.FlowLogs.Logstore = "other"
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, changedInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("flowLogs.project"),
+			}, Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("flowLogs.logstore"),
+			}))
+
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, infrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("flowLogs.create"),
+			}))
+		})
+
+		It("should allow adding but forbid removing the NAS file system or changing its storage type", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.NAS = &apisalicloud.NAS{}
+			Expect(ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)).To(BeEmpty())
+
+			changedInfrastructureConfig := newInfrastructureConfig.DeepCopy()
+			changedInfrastructureConfig.NAS.StorageType = ptr.To("Performance")
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, changedInfrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("nas.storageType"),
+			}))
+
+			Expect(ValidateInfrastructureConfigUpdate(newInfrastructureConfig, infrastructureConfig)).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeForbidden),
+				"Field": Equal("nas"),
+			}))
+		})
+
 		It("should return no errors for migrate zone worker to workers", func() {
 			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
 			tmpvalue := oldInfrastructureConfig.Networks.Zones[0].Worker
@@ -549,6 +1070,20 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			}))
 		})
 
+		It("should forbid changing the vswitch ID of a zone", func() {
+			oldInfrastructureConfig := infrastructureConfig.DeepCopy()
+			oldInfrastructureConfig.Networks.Zones[0].VSwitchID = ptr.To("vsw-1")
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].VSwitchID = ptr.To("vsw-2")
+
+			errorList := ValidateInfrastructureConfigUpdate(oldInfrastructureConfig, newInfrastructureConfig)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].vswitchID"),
+			}))
+		})
+
 		It("should forbid removing zone in zones section", func() {
 			newInfrastructureConfig := infrastructureConfig.DeepCopy()
 			newInfrastructureConfig.Networks.Zones = newInfrastructureConfig.Networks.Zones[1:]
@@ -583,6 +1118,33 @@ var _ = Describe("InfrastructureConfig validation", func() {
 			Expect(errorList).To(BeEmpty())
 		})
 
+		It("should forbid changing the NAT gateway type", func() {
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+				Type: ptr.To(apisalicloud.NatGatewayTypeVPC),
+			}
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].natGateway.type"),
+			}))
+		})
+
+		It("should forbid changing the SNAT IP address", func() {
+			infrastructureConfig.Networks.Zones[0].NatGateway = &apisalicloud.NatGatewayConfig{
+				Type: ptr.To(apisalicloud.NatGatewayTypeVPC),
+			}
+			newInfrastructureConfig := infrastructureConfig.DeepCopy()
+			newInfrastructureConfig.Networks.Zones[0].NatGateway.SNATIPAddress = ptr.To("10.250.3.10")
+			errorList := ValidateInfrastructureConfigUpdate(infrastructureConfig, newInfrastructureConfig)
+
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":  Equal(field.ErrorTypeInvalid),
+				"Field": Equal("networks.zones[0].natGateway.snatIPAddress"),
+			}))
+		})
+
 		Context("dualStack immutability", func() {
 			It("should allow enabling dualStack (false -> true)", func() {
 				oldConfig := infrastructureConfig.DeepCopy()
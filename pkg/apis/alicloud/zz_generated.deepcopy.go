@@ -44,6 +44,125 @@ func (in *BackupBucketConfig) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionConfig) DeepCopyInto(out *BastionConfig) {
+	*out = *in
+	if in.Images != nil {
+		in, out := &in.Images, &out.Images
+		*out = make([]BastionImage, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.InstanceTypes != nil {
+		in, out := &in.InstanceTypes, &out.InstanceTypes
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EIP != nil {
+		in, out := &in.EIP, &out.EIP
+		*out = new(BastionEIP)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.AccessMode != nil {
+		in, out := &in.AccessMode, &out.AccessMode
+		*out = new(BastionAccessMode)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionConfig.
+func (in *BastionConfig) DeepCopy() *BastionConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionEIP) DeepCopyInto(out *BastionEIP) {
+	*out = *in
+	if in.PoolTags != nil {
+		in, out := &in.PoolTags, &out.PoolTags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Tags != nil {
+		in, out := &in.Tags, &out.Tags
+		*out = make(map[string]string, len(*in))
+		for key, val := range *in {
+			(*out)[key] = val
+		}
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionEIP.
+func (in *BastionEIP) DeepCopy() *BastionEIP {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionEIP)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionImage) DeepCopyInto(out *BastionImage) {
+	*out = *in
+	if in.Architecture != nil {
+		in, out := &in.Architecture, &out.Architecture
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionImage.
+func (in *BastionImage) DeepCopy() *BastionImage {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionImage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *BastionStatus) DeepCopyInto(out *BastionStatus) {
+	*out = *in
+	out.TypeMeta = in.TypeMeta
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new BastionStatus.
+func (in *BastionStatus) DeepCopy() *BastionStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(BastionStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyObject is an autogenerated deepcopy function, copying the receiver, creating a new runtime.Object.
+func (in *BastionStatus) DeepCopyObject() runtime.Object {
+	if c := in.DeepCopy(); c != nil {
+		return c
+	}
+	return nil
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CSI) DeepCopyInto(out *CSI) {
 	*out = *in
@@ -52,6 +171,16 @@ func (in *CSI) DeepCopyInto(out *CSI) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.NAS != nil {
+		in, out := &in.NAS, &out.NAS
+		*out = new(CSINAS)
+		**out = **in
+	}
+	if in.OSS != nil {
+		in, out := &in.OSS, &out.OSS
+		*out = new(CSIOSS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -65,6 +194,48 @@ func (in *CSI) DeepCopy() *CSI {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSINAS) DeepCopyInto(out *CSINAS) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSINAS.
+func (in *CSINAS) DeepCopy() *CSINAS {
+	if in == nil {
+		return nil
+	}
+	out := new(CSINAS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *CSIOSS) DeepCopyInto(out *CSIOSS) {
+	*out = *in
+	if in.Bucket != nil {
+		in, out := &in.Bucket, &out.Bucket
+		*out = new(string)
+		**out = **in
+	}
+	if in.CredentialsRef != nil {
+		in, out := &in.CredentialsRef, &out.CredentialsRef
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new CSIOSS.
+func (in *CSIOSS) DeepCopy() *CSIOSS {
+	if in == nil {
+		return nil
+	}
+	out := new(CSIOSS)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *CloudControllerManagerConfig) DeepCopyInto(out *CloudControllerManagerConfig) {
 	*out = *in
@@ -99,6 +270,11 @@ func (in *CloudProfileConfig) DeepCopyInto(out *CloudProfileConfig) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.Bastion != nil {
+		in, out := &in.Bastion, &out.Bastion
+		*out = new(BastionConfig)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -134,6 +310,16 @@ func (in *ControlPlaneConfig) DeepCopyInto(out *ControlPlaneConfig) {
 		*out = new(CSI)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.LoadBalancer != nil {
+		in, out := &in.LoadBalancer, &out.LoadBalancer
+		*out = new(LoadBalancerConfig)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.Storage != nil {
+		in, out := &in.Storage, &out.Storage
+		*out = new(Storage)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -158,6 +344,11 @@ func (in *ControlPlaneConfig) DeepCopyObject() runtime.Object {
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *DualStack) DeepCopyInto(out *DualStack) {
 	*out = *in
+	if in.InternetBandwidth != nil {
+		in, out := &in.InternetBandwidth, &out.InternetBandwidth
+		*out = new(IPv6InternetBandwidth)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -171,6 +362,58 @@ func (in *DualStack) DeepCopy() *DualStack {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *FlowLogs) DeepCopyInto(out *FlowLogs) {
+	*out = *in
+	if in.TrafficType != nil {
+		in, out := &in.TrafficType, &out.TrafficType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Create != nil {
+		in, out := &in.Create, &out.Create
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new FlowLogs.
+func (in *FlowLogs) DeepCopy() *FlowLogs {
+	if in == nil {
+		return nil
+	}
+	out := new(FlowLogs)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *IPv6InternetBandwidth) DeepCopyInto(out *IPv6InternetBandwidth) {
+	*out = *in
+	if in.InternetChargeType != nil {
+		in, out := &in.InternetChargeType, &out.InternetChargeType
+		*out = new(string)
+		**out = **in
+	}
+	if in.EgressOnly != nil {
+		in, out := &in.EgressOnly, &out.EgressOnly
+		*out = new(bool)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new IPv6InternetBandwidth.
+func (in *IPv6InternetBandwidth) DeepCopy() *IPv6InternetBandwidth {
+	if in == nil {
+		return nil
+	}
+	out := new(IPv6InternetBandwidth)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *ImmutableConfig) DeepCopyInto(out *ImmutableConfig) {
 	*out = *in
@@ -194,9 +437,29 @@ func (in *InfrastructureConfig) DeepCopyInto(out *InfrastructureConfig) {
 	if in.DualStack != nil {
 		in, out := &in.DualStack, &out.DualStack
 		*out = new(DualStack)
-		**out = **in
+		(*in).DeepCopyInto(*out)
 	}
 	in.Networks.DeepCopyInto(&out.Networks)
+	if in.DeletionProtection != nil {
+		in, out := &in.DeletionProtection, &out.DeletionProtection
+		*out = new(bool)
+		**out = **in
+	}
+	if in.RetainEIPs != nil {
+		in, out := &in.RetainEIPs, &out.RetainEIPs
+		*out = new(bool)
+		**out = **in
+	}
+	if in.FlowLogs != nil {
+		in, out := &in.FlowLogs, &out.FlowLogs
+		*out = new(FlowLogs)
+		(*in).DeepCopyInto(*out)
+	}
+	if in.NAS != nil {
+		in, out := &in.NAS, &out.NAS
+		*out = new(NAS)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -230,6 +493,11 @@ func (in *InfrastructureStatus) DeepCopyInto(out *InfrastructureStatus) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.NAS != nil {
+		in, out := &in.NAS, &out.NAS
+		*out = new(NASStatus)
+		(*in).DeepCopyInto(*out)
+	}
 	return
 }
 
@@ -251,6 +519,47 @@ func (in *InfrastructureStatus) DeepCopyObject() runtime.Object {
 	return nil
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *LoadBalancerConfig) DeepCopyInto(out *LoadBalancerConfig) {
+	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(LoadBalancerType)
+		**out = **in
+	}
+	if in.AddressType != nil {
+		in, out := &in.AddressType, &out.AddressType
+		*out = new(LoadBalancerAddressType)
+		**out = **in
+	}
+	if in.Bandwidth != nil {
+		in, out := &in.Bandwidth, &out.Bandwidth
+		*out = new(int32)
+		**out = **in
+	}
+	if in.PaymentType != nil {
+		in, out := &in.PaymentType, &out.PaymentType
+		*out = new(LoadBalancerPaymentType)
+		**out = **in
+	}
+	if in.Spec != nil {
+		in, out := &in.Spec, &out.Spec
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new LoadBalancerConfig.
+func (in *LoadBalancerConfig) DeepCopy() *LoadBalancerConfig {
+	if in == nil {
+		return nil
+	}
+	out := new(LoadBalancerConfig)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *MachineImage) DeepCopyInto(out *MachineImage) {
 	*out = *in
@@ -374,14 +683,82 @@ func (in *MachineImages) DeepCopy() *MachineImages {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NAS) DeepCopyInto(out *NAS) {
+	*out = *in
+	if in.StorageType != nil {
+		in, out := &in.StorageType, &out.StorageType
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NAS.
+func (in *NAS) DeepCopy() *NAS {
+	if in == nil {
+		return nil
+	}
+	out := new(NAS)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NASMountTarget) DeepCopyInto(out *NASMountTarget) {
+	*out = *in
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NASMountTarget.
+func (in *NASMountTarget) DeepCopy() *NASMountTarget {
+	if in == nil {
+		return nil
+	}
+	out := new(NASMountTarget)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *NASStatus) DeepCopyInto(out *NASStatus) {
+	*out = *in
+	if in.MountTargets != nil {
+		in, out := &in.MountTargets, &out.MountTargets
+		*out = make([]NASMountTarget, len(*in))
+		copy(*out, *in)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new NASStatus.
+func (in *NASStatus) DeepCopy() *NASStatus {
+	if in == nil {
+		return nil
+	}
+	out := new(NASStatus)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *NatGatewayConfig) DeepCopyInto(out *NatGatewayConfig) {
 	*out = *in
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(NatGatewayType)
+		**out = **in
+	}
 	if in.EIPAllocationID != nil {
 		in, out := &in.EIPAllocationID, &out.EIPAllocationID
 		*out = new(string)
 		**out = **in
 	}
+	if in.SNATIPAddress != nil {
+		in, out := &in.SNATIPAddress, &out.SNATIPAddress
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -406,6 +783,11 @@ func (in *Networks) DeepCopyInto(out *Networks) {
 			(*in)[i].DeepCopyInto(&(*out)[i])
 		}
 	}
+	if in.WorkersPrefixLength != nil {
+		in, out := &in.WorkersPrefixLength, &out.WorkersPrefixLength
+		*out = new(int32)
+		**out = **in
+	}
 	return
 }
 
@@ -451,6 +833,90 @@ func (in *SecurityGroup) DeepCopy() *SecurityGroup {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *Storage) DeepCopyInto(out *Storage) {
+	*out = *in
+	if in.StorageClasses != nil {
+		in, out := &in.StorageClasses, &out.StorageClasses
+		*out = make([]StorageClass, len(*in))
+		for i := range *in {
+			(*in)[i].DeepCopyInto(&(*out)[i])
+		}
+	}
+	if in.VolumeSnapshotClass != nil {
+		in, out := &in.VolumeSnapshotClass, &out.VolumeSnapshotClass
+		*out = new(VolumeSnapshotClass)
+		(*in).DeepCopyInto(*out)
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new Storage.
+func (in *Storage) DeepCopy() *Storage {
+	if in == nil {
+		return nil
+	}
+	out := new(Storage)
+	in.DeepCopyInto(out)
+	return out
+}
+
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *StorageClass) DeepCopyInto(out *StorageClass) {
+	*out = *in
+	if in.Default != nil {
+		in, out := &in.Default, &out.Default
+		*out = new(bool)
+		**out = **in
+	}
+	if in.ReclaimPolicy != nil {
+		in, out := &in.ReclaimPolicy, &out.ReclaimPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.Type != nil {
+		in, out := &in.Type, &out.Type
+		*out = new(string)
+		**out = **in
+	}
+	if in.PerformanceLevel != nil {
+		in, out := &in.PerformanceLevel, &out.PerformanceLevel
+		*out = new(string)
+		**out = **in
+	}
+	if in.ProvisionedIOPS != nil {
+		in, out := &in.ProvisionedIOPS, &out.ProvisionedIOPS
+		*out = new(int64)
+		**out = **in
+	}
+	if in.FSType != nil {
+		in, out := &in.FSType, &out.FSType
+		*out = new(string)
+		**out = **in
+	}
+	if in.Encrypted != nil {
+		in, out := &in.Encrypted, &out.Encrypted
+		*out = new(bool)
+		**out = **in
+	}
+	if in.KMSKeyID != nil {
+		in, out := &in.KMSKeyID, &out.KMSKeyID
+		*out = new(string)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new StorageClass.
+func (in *StorageClass) DeepCopy() *StorageClass {
+	if in == nil {
+		return nil
+	}
+	out := new(StorageClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *VPC) DeepCopyInto(out *VPC) {
 	*out = *in
@@ -474,6 +940,21 @@ func (in *VPC) DeepCopyInto(out *VPC) {
 		*out = new(bool)
 		**out = **in
 	}
+	if in.SecondaryCIDRs != nil {
+		in, out := &in.SecondaryCIDRs, &out.SecondaryCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
+	if in.EgressMode != nil {
+		in, out := &in.EgressMode, &out.EgressMode
+		*out = new(EgressMode)
+		**out = **in
+	}
+	if in.EgressCIDRs != nil {
+		in, out := &in.EgressCIDRs, &out.EgressCIDRs
+		*out = make([]string, len(*in))
+		copy(*out, *in)
+	}
 	return
 }
 
@@ -529,6 +1010,47 @@ func (in *VSwitch) DeepCopy() *VSwitch {
 	return out
 }
 
+// DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
+func (in *VolumeSnapshotClass) DeepCopyInto(out *VolumeSnapshotClass) {
+	*out = *in
+	if in.Name != nil {
+		in, out := &in.Name, &out.Name
+		*out = new(string)
+		**out = **in
+	}
+	if in.DeletionPolicy != nil {
+		in, out := &in.DeletionPolicy, &out.DeletionPolicy
+		*out = new(string)
+		**out = **in
+	}
+	if in.InstantAccess != nil {
+		in, out := &in.InstantAccess, &out.InstantAccess
+		*out = new(bool)
+		**out = **in
+	}
+	if in.InstantAccessRetentionDays != nil {
+		in, out := &in.InstantAccessRetentionDays, &out.InstantAccessRetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	if in.RetentionDays != nil {
+		in, out := &in.RetentionDays, &out.RetentionDays
+		*out = new(int32)
+		**out = **in
+	}
+	return
+}
+
+// DeepCopy is an autogenerated deepcopy function, copying the receiver, creating a new VolumeSnapshotClass.
+func (in *VolumeSnapshotClass) DeepCopy() *VolumeSnapshotClass {
+	if in == nil {
+		return nil
+	}
+	out := new(VolumeSnapshotClass)
+	in.DeepCopyInto(out)
+	return out
+}
+
 // DeepCopyInto is an autogenerated deepcopy function, copying the receiver, writing into out. in must be non-nil.
 func (in *WorkerStatus) DeepCopyInto(out *WorkerStatus) {
 	*out = *in
@@ -574,6 +1096,11 @@ func (in *Zone) DeepCopyInto(out *Zone) {
 		*out = new(NatGatewayConfig)
 		(*in).DeepCopyInto(*out)
 	}
+	if in.VSwitchID != nil {
+		in, out := &in.VSwitchID, &out.VSwitchID
+		*out = new(string)
+		**out = **in
+	}
 	return
 }
 
@@ -40,6 +40,9 @@ type ControllerConfiguration struct {
 type Service struct {
 	// BackendLoadBalancerSpec specifies the type of backend Alicloud load balancer, default is slb.s1.small.
 	BackendLoadBalancerSpec string
+	// LoadBalancerType is the default type of the load balancers of shoot Services, either "CLB" or "NLB". Shoots can
+	// overwrite it in their ControlPlaneConfig. Defaults to "CLB".
+	LoadBalancerType string
 }
 
 // ETCD is an etcd configuration.
@@ -44,6 +44,10 @@ type ControllerConfiguration struct {
 type Service struct {
 	// BackendLoadBalancerSpec specifies the type of backend Alicloud load balancer, default is slb.s1.small.
 	BackendLoadBalancerSpec string `json:"backendLoadBalancerSpec"`
+	// LoadBalancerType is the default type of the load balancers of shoot Services, either "CLB" or "NLB". Shoots can
+	// overwrite it in their ControlPlaneConfig. Defaults to "CLB".
+	// +optional
+	LoadBalancerType string `json:"loadBalancerType,omitempty"`
 }
 
 // ETCD is an etcd configuration.
@@ -225,6 +225,7 @@ func Convert_config_ETCDStorage_To_v1alpha1_ETCDStorage(in *config.ETCDStorage,
 
 func autoConvert_v1alpha1_Service_To_config_Service(in *Service, out *config.Service, s conversion.Scope) error {
 	out.BackendLoadBalancerSpec = in.BackendLoadBalancerSpec
+	out.LoadBalancerType = in.LoadBalancerType
 	return nil
 }
 
@@ -235,6 +236,7 @@ func Convert_v1alpha1_Service_To_config_Service(in *Service, out *config.Service
 
 func autoConvert_config_Service_To_v1alpha1_Service(in *config.Service, out *Service, s conversion.Scope) error {
 	out.BackendLoadBalancerSpec = in.BackendLoadBalancerSpec
+	out.LoadBalancerType = in.LoadBalancerType
 	return nil
 }
 
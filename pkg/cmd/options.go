@@ -46,6 +46,8 @@ const (
 	ProviderClientBurstFlag = "provider-client-burst"
 	// ProviderClientWaitTimeoutFlag is the name of the command line flag to specify the client wait timeout for provider operations.
 	ProviderClientWaitTimeoutFlag = "provider-client-wait-timeout"
+	// EnableFlowGraphsHandlerFlag is the name of the command line flag to serve the infrastructure flow graphs on the metrics endpoint.
+	EnableFlowGraphsHandlerFlag = "enable-flow-graphs-handler"
 )
 
 // ControllerSwitchOptions are the controllercmd.SwitchOptions for the provider controllers.
@@ -69,10 +71,54 @@ func WebhookSwitchOptions() *webhookcmd.SwitchOptions {
 		webhookcmd.Switch(extensioncontrolplanewebhook.WebhookName, controlplanewebhook.AddToManager),
 		webhookcmd.Switch(extensioncontrolplanewebhook.SeedProviderWebhookName, seedproviderwebhook.AddToManager),
 		webhookcmd.Switch(extensionshootwebhook.WebhookName, shootwebhook.AddToManager),
+		webhookcmd.Switch(shootwebhook.ServiceWebhookName, shootwebhook.AddServiceWebhookToManager),
 		webhookcmd.Switch(extensionscloudproviderwebhook.WebhookName, cloudproviderwebhook.AddToManager),
 	)
 }
 
+// InfrastructureControllerOptions are command line options that can be set for the infrastructure controller.
+type InfrastructureControllerOptions struct {
+	controllercmd.ControllerOptions
+	EnableFlowGraphsHandler bool
+
+	config *InfrastructureControllerConfig
+}
+
+// AddFlags implements Flagger.AddFlags.
+func (c *InfrastructureControllerOptions) AddFlags(fs *pflag.FlagSet) {
+	c.ControllerOptions.AddFlags(fs)
+	fs.BoolVar(&c.EnableFlowGraphsHandler, EnableFlowGraphsHandlerFlag, c.EnableFlowGraphsHandler, "Serve the infrastructure flow graphs, including the names and resource IDs of all Infrastructures, on the unauthenticated metrics endpoint.")
+}
+
+// Complete implements Completer.Complete.
+func (c *InfrastructureControllerOptions) Complete() error {
+	if err := c.ControllerOptions.Complete(); err != nil {
+		return err
+	}
+	c.config = &InfrastructureControllerConfig{
+		ControllerConfig:        *c.ControllerOptions.Completed(),
+		EnableFlowGraphsHandler: c.EnableFlowGraphsHandler,
+	}
+	return nil
+}
+
+// Completed returns the completed InfrastructureControllerConfig. Only call this if `Complete` was successful.
+func (c *InfrastructureControllerOptions) Completed() *InfrastructureControllerConfig {
+	return c.config
+}
+
+// InfrastructureControllerConfig is a completed infrastructure controller configuration.
+type InfrastructureControllerConfig struct {
+	controllercmd.ControllerConfig
+	EnableFlowGraphsHandler bool
+}
+
+// Apply sets the values of this InfrastructureControllerConfig in the given infrastructurecontroller.AddOptions.
+func (c *InfrastructureControllerConfig) Apply(opts *infrastructurecontroller.AddOptions) {
+	c.ControllerConfig.Apply(&opts.Controller)
+	opts.EnableFlowGraphsHandler = c.EnableFlowGraphsHandler
+}
+
 // DNSRecordControllerOptions are command line options that can be set for dnsrecordcontroller.Options.
 type DNSRecordControllerOptions struct {
 	controllercmd.ControllerOptions
@@ -36,6 +36,21 @@ func (a *actuator) Delete(ctx context.Context, log logr.Logger, bastion *extensi
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
+	aliCloudVPCClient, err := a.newClientFactory.NewVPCClient(opt.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	instanceID, err := getBastionInstanceID(aliCloudECSClient, opt)
+	if err != nil {
+		return util.DetermineError(err, helper.KnownCodes)
+	}
+
+	err = removeEIP(ctx, aliCloudVPCClient, log, opt, instanceID)
+	if err != nil {
+		return util.DetermineError(fmt.Errorf("failed to remove EIP of bastion instance: %w", err), helper.KnownCodes)
+	}
+
 	err = removeBastionInstance(aliCloudECSClient, opt)
 	if err != nil {
 		return util.DetermineError(fmt.Errorf("failed to terminate bastion instance: %w", err), helper.KnownCodes)
@@ -83,3 +98,16 @@ func removeBastionInstance(c aliclient.ECS, opt *Options) error {
 
 	return c.DeleteInstances(response.Instances.Instance[0].InstanceId, true)
 }
+
+func getBastionInstanceID(c aliclient.ECS, opt *Options) (string, error) {
+	response, err := c.GetInstances(opt.BastionInstanceName)
+	if err != nil {
+		return "", err
+	}
+
+	if len(response.Instances.Instance) == 0 {
+		return "", nil
+	}
+
+	return response.Instances.Instance[0].InstanceId, nil
+}
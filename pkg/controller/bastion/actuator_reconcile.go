@@ -8,7 +8,6 @@ import (
 	"context"
 	"errors"
 	"fmt"
-	"strings"
 	"time"
 
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
@@ -19,11 +18,15 @@ import (
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"k8s.io/apimachinery/pkg/api/equality"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	alicloudv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
 )
 
 // bastionEndpoints holds the endpoints the bastion host provides
@@ -62,76 +65,38 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	imageID := infrastructureStatus.MachineImages[0].ID
-
-	imageInfo, err := aliCloudECSClient.GetImageInfo(imageID)
+	bastionConfig, err := bastionConfigFromCluster(cluster)
 	if err != nil {
-		return util.DetermineError(err, helper.KnownCodes)
-	}
-
-	if len(imageInfo.Images.Image) == 0 {
-		return errors.New("image not found")
+		return err
 	}
+	cloudAssistant := cloudAssistantAccess(bastionConfig)
 
-	// image architecture is returned as "X86" instead of "X86_64", "ARM" instead of "arm64", etc
-	arch := imageInfo.Images.Image[0].Architecture
-	imageArchitecture := strings.ToUpper((strings.Split(arch, "_")[0]))
-	switch strings.ToLower(arch) {
-	case "x86_64", "amd64":
-		imageArchitecture = "X86"
-	case "arm64", "aarch64":
-		imageArchitecture = "ARM"
-	}
+	vpcId := infrastructureStatus.VPC.ID
+	shootSecurityGroupId := infrastructureStatus.VPC.SecurityGroups[0].ID
 
-	instanceType, err := aliCloudECSClient.ListAllInstanceType()
+	machine, err := existingMachine(aliCloudECSClient, opt)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
-
-	instanceTypeMap := make(map[string]string)
-	for _, t := range instanceType.InstanceTypes.InstanceType {
-		instanceTypeMap[t.InstanceTypeId] = t.CpuArchitecture
-	}
-
-	vSwitchesZoneID := infrastructureStatus.VPC.VSwitches[0].Zone
-	vSwitchesID := infrastructureStatus.VPC.VSwitches[0].ID
-	vpcId := infrastructureStatus.VPC.ID
-	shootSecurityGroupId := infrastructureStatus.VPC.SecurityGroups[0].ID
-
-	var instanceTypeId string
-	for cores := 1; cores <= 2; cores++ {
-		instanceType, err := aliCloudECSClient.GetAvailableInstanceType(cores, vSwitchesZoneID)
+	if machine == nil {
+		bastionStatus, err := helper.BastionStatusFromRaw(bastion.Status.ProviderStatus)
 		if err != nil {
-			return util.DetermineError(err, helper.KnownCodes)
-		}
-
-		if instanceType == nil ||
-			len(instanceType.AvailableZones.AvailableZone) == 0 ||
-			len(instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource) == 0 ||
-			len(instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource) == 0 ||
-			instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Status != "Available" {
-			continue
+			return err
 		}
 
-		if _, ok := instanceTypeMap[instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Value]; !ok {
-			continue
+		selector, err := newMachineSelector(aliCloudECSClient, log, cluster, opt.Region, infrastructureStatus.MachineImages)
+		if err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
 		}
 
-		if instanceTypeMap[instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Value] != imageArchitecture {
-			continue
+		machine, err = determineMachine(selector, nodeVSwitches(infrastructureStatus, bastionStatus))
+		if err != nil {
+			return util.DetermineError(err, helper.KnownCodes)
 		}
-
-		instanceTypeId = instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Value
-		break
 	}
 
-	if instanceTypeId == "" {
-		if len(cluster.CloudProfile.Spec.MachineTypes) == 0 {
-			return errors.New("failed to determine instanceTypeId from cloud profile as fallback. Machine types missing from cloud profile")
-		}
-
-		instanceTypeId = cluster.CloudProfile.Spec.MachineTypes[0].Name
-		log.Info("falling back to first machine type of cloud profile as bastion instance type id", "instance type", cluster.CloudProfile.Spec.MachineTypes[0].Name)
+	if err := a.updateProviderStatus(ctx, bastion, machine.vSwitch, cloudAssistant, machine.instanceID, opt.Region); err != nil {
+		return err
 	}
 
 	securityGroupID, err := ensureSecurityGroup(aliCloudECSClient, opt.SecurityGroupName, vpcId, log)
@@ -139,7 +104,7 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	instanceID, err := ensureComputeInstance(aliCloudECSClient, log, opt, securityGroupID, imageID, vSwitchesID, vSwitchesZoneID, instanceTypeId)
+	instanceID, err := ensureComputeInstance(aliCloudECSClient, log, opt, securityGroupID, machine.imageID, machine.vSwitch.ID, machine.vSwitch.Zone, machine.instanceTypeID)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
@@ -156,17 +121,38 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 		}
 	}
 
-	err = ensureSecurityGroupRules(aliCloudECSClient, opt, shootSecurityGroupId, bastion, securityGroupID)
+	err = ensureSecurityGroupRules(aliCloudECSClient, opt, shootSecurityGroupId, bastion, securityGroupID, cloudAssistant)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	publicIP, err := aliCloudECSClient.AllocatePublicIp(instanceID)
+	if cloudAssistant {
+		// without a public endpoint, operators connect with Cloud Assistant sessions to the instance published as
+		// hostname of the ingress, which is the only part of the status gardenlet propagates to the garden
+		running, err := ensureCloudAssistant(aliCloudECSClient, log, opt, time.Now())
+		if err != nil {
+			return util.DetermineError(fmt.Errorf("failed to ensure Cloud Assistant agent of bastion instance: %w", err), helper.KnownCodes)
+		}
+		if !running {
+			return &ctrlerror.RequeueAfterError{
+				RequeueAfter: 30 * time.Second,
+				Cause:        errors.New("bastion instance has no running Cloud Assistant agent yet"),
+			}
+		}
+		if err := a.updateProviderStatus(ctx, bastion, machine.vSwitch, cloudAssistant, instanceID, opt.Region); err != nil {
+			return err
+		}
+		patch := client.MergeFrom(bastion.DeepCopy())
+		bastion.Status.Ingress = addressToIngress(&instanceID, nil)
+		return a.client.Status().Patch(ctx, bastion, patch)
+	}
+
+	publicIPAddress, err := a.ensurePublicIP(aliCloudECSClient, log, bastionConfig, opt, credentials, instanceID)
 	if err != nil {
 		return util.DetermineError(err, helper.KnownCodes)
 	}
 
-	endpoints, err := getInstanceEndpoints(aliCloudECSClient, opt, publicIP.IpAddress)
+	endpoints, err := getInstanceEndpoints(aliCloudECSClient, opt, publicIPAddress)
 	if err != nil {
 		return err
 	}
@@ -187,6 +173,53 @@ func (a *actuator) Reconcile(ctx context.Context, log logr.Logger, bastion *exte
 	return a.client.Status().Patch(ctx, bastion, patch)
 }
 
+// ensurePublicIP returns the public IP address of the bastion host. With an EIP configured for bastions in the
+// CloudProfileConfig, an EIP is associated with the instance, otherwise a public IP address is allocated by ECS.
+func (a *actuator) ensurePublicIP(c aliclient.ECS, log logr.Logger, bastionConfig *alicloudapi.BastionConfig, opt *Options, credentials *alicloud.Credentials, instanceID string) (string, error) {
+	if bastionConfig == nil || bastionConfig.EIP == nil {
+		publicIP, err := c.AllocatePublicIp(instanceID)
+		if err != nil {
+			return "", err
+		}
+		return publicIP.IpAddress, nil
+	}
+
+	vpcClient, err := a.newClientFactory.NewVPCClient(opt.Region, credentials.AccessKeyID, credentials.AccessKeySecret)
+	if err != nil {
+		return "", err
+	}
+	return ensureEIP(vpcClient, log, bastionConfig.EIP, opt, instanceID)
+}
+
+// updateProviderStatus records the zone and vswitch of the bastion host, so that later reconciliations select them
+// again. For Cloud Assistant sessions, the instance id and region are published once the instance exists.
+func (a *actuator) updateProviderStatus(ctx context.Context, bastion *extensionsv1alpha1.Bastion, vSwitch alicloudapi.VSwitch, cloudAssistant bool, instanceID, region string) error {
+	bastionStatus := &alicloudv1alpha1.BastionStatus{
+		TypeMeta: metav1.TypeMeta{
+			APIVersion: alicloudv1alpha1.SchemeGroupVersion.String(),
+			Kind:       "BastionStatus",
+		},
+		Zone:      vSwitch.Zone,
+		VSwitchID: vSwitch.ID,
+	}
+	if cloudAssistant && instanceID != "" {
+		bastionStatus.InstanceID = instanceID
+		bastionStatus.Region = region
+	}
+	current, err := helper.BastionStatusFromRaw(bastion.Status.ProviderStatus)
+	if err != nil {
+		return err
+	}
+	if current != nil && current.Zone == bastionStatus.Zone && current.VSwitchID == bastionStatus.VSwitchID &&
+		current.InstanceID == bastionStatus.InstanceID && current.Region == bastionStatus.Region {
+		return nil
+	}
+
+	patch := client.MergeFrom(bastion.DeepCopy())
+	bastion.Status.ProviderStatus = &runtime.RawExtension{Object: bastionStatus}
+	return a.client.Status().Patch(ctx, bastion, patch)
+}
+
 // IngressReady returns true if either an IP or a hostname or both are set.
 func IngressReady(ingress *corev1.LoadBalancerIngress) bool {
 	return ingress != nil && (ingress.Hostname != "" || ingress.IP != "")
@@ -282,7 +315,7 @@ func ensureSecurityGroup(c aliclient.ECS, securityGroupName, vpcID string, log l
 	return createResponse.SecurityGroupId, nil
 }
 
-func ensureSecurityGroupRules(c aliclient.ECS, opt *Options, shootSecurityGroupId string, bastion *extensionsv1alpha1.Bastion, securityGroupId string) error {
+func ensureSecurityGroupRules(c aliclient.ECS, opt *Options, shootSecurityGroupId string, bastion *extensionsv1alpha1.Bastion, securityGroupId string, cloudAssistant bool) error {
 	// ingress permission
 	ingressPermissions, err := ingressPermissions(bastion)
 	if err != nil {
@@ -291,8 +324,11 @@ func ensureSecurityGroupRules(c aliclient.ECS, opt *Options, shootSecurityGroupI
 
 	var wantedIngressRules []*ecs.AuthorizeSecurityGroupRequest
 
-	for _, ingressPermission := range ingressPermissions {
-		wantedIngressRules = append(wantedIngressRules, ingressAllowSSH(securityGroupId, ingressPermission))
+	// with Cloud Assistant sessions, no SSH ingress is opened to the bastion host
+	if !cloudAssistant {
+		for _, ingressPermission := range ingressPermissions {
+			wantedIngressRules = append(wantedIngressRules, ingressAllowSSH(securityGroupId, ingressPermission))
+		}
 	}
 
 	currentIngressRules, err := c.DescribeSecurityGroupAttribute(describeSecurityGroupAttributeRequest(securityGroupId, "ingress"))
@@ -329,6 +365,9 @@ func ensureSecurityGroupRules(c aliclient.ECS, opt *Options, shootSecurityGroupI
 	wantedEgressRules := []*ecs.AuthorizeSecurityGroupEgressRequest{
 		egressAllowSSHToWorker(privateIP, securityGroupId, shootSecurityGroupId),
 		egressDenyAll(securityGroupId)}
+	if cloudAssistant {
+		wantedEgressRules = append(wantedEgressRules, egressAllowCloudAssistant(securityGroupId))
+	}
 
 	currentEgressRules, err := c.DescribeSecurityGroupAttribute(describeSecurityGroupAttributeRequest(securityGroupId, "egress"))
 	if err != nil {
@@ -0,0 +1,75 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/go-logr/logr"
+
+	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+)
+
+const (
+	// cloudAssistantStartupGracePeriod is the time the Cloud Assistant agent has to report a heartbeat after the
+	// bastion instance has started before it is installed.
+	cloudAssistantStartupGracePeriod = 3 * time.Minute
+	// cloudAssistantServerCIDR is the internal network of the Cloud Assistant servers the agent connects to.
+	cloudAssistantServerCIDR = "100.100.0.0/16"
+	// ecsTimeLayout is the layout of the times reported by ECS.
+	ecsTimeLayout = "2006-01-02T15:04Z"
+)
+
+// cloudAssistantAccess returns whether operators connect to the bastion hosts with Cloud Assistant sessions.
+func cloudAssistantAccess(bastionConfig *alicloudapi.BastionConfig) bool {
+	return bastionConfig != nil && bastionConfig.AccessMode != nil && *bastionConfig.AccessMode == alicloudapi.BastionAccessModeCloudAssistant
+}
+
+// ensureCloudAssistant returns whether the Cloud Assistant agent of the bastion instance is running. If the agent has
+// never reported a heartbeat within the grace period after the start of the instance, it is installed and the
+// instance is rebooted. Instances which have not been started yet are measured from their creation.
+func ensureCloudAssistant(c aliclient.ECS, log logr.Logger, opt *Options, now time.Time) (bool, error) {
+	response, err := c.GetInstances(opt.BastionInstanceName)
+	if err != nil {
+		return false, err
+	}
+	if len(response.Instances.Instance) == 0 {
+		return false, errors.New("bastion instance not found")
+	}
+	instance := response.Instances.Instance[0]
+
+	status, err := c.GetCloudAssistantStatus(instance.InstanceId)
+	if err != nil {
+		return false, err
+	}
+	if status != nil && status.CloudAssistantStatus == "true" {
+		if !status.SupportSessionManager {
+			return false, errors.New("the Cloud Assistant agent of the bastion instance does not support sessions, please update the agent in the bastion image")
+		}
+		return true, nil
+	}
+	if status != nil && status.LastHeartbeatTime != "" {
+		return false, nil
+	}
+
+	// the start time is only reported once the instance has been started
+	startTime := instance.StartTime
+	if startTime == "" {
+		startTime = instance.CreationTime
+	}
+	started, err := time.Parse(ecsTimeLayout, startTime)
+	if err != nil {
+		return false, fmt.Errorf("failed to parse start time %q of bastion instance: %w", startTime, err)
+	}
+	if now.Sub(started) < cloudAssistantStartupGracePeriod {
+		return false, nil
+	}
+
+	log.Info("installing Cloud Assistant agent on bastion instance", "instance", instance.InstanceId)
+	return false, c.InstallCloudAssistant(instance.InstanceId)
+}
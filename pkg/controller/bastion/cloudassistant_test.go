@@ -0,0 +1,110 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"time"
+
+	ecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+)
+
+var _ = Describe("CloudAssistant", func() {
+	var (
+		ctrl      *gomock.Controller
+		ecsClient *mockalicloudclient.MockECS
+		opt       *Options
+		startTime time.Time
+		instance  ecs.Instance
+	)
+
+	const instanceID = "i-bastion"
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ecsClient = mockalicloudclient.NewMockECS(ctrl)
+		opt = &Options{BastionInstanceName: "shoot--foo--bar-bastion-bastion"}
+		startTime = time.Date(2026, 10, 1, 12, 0, 0, 0, time.UTC)
+		instance = ecs.Instance{InstanceId: instanceID, StartTime: startTime.Format(ecsTimeLayout)}
+	})
+
+	JustBeforeEach(func() {
+		ecsClient.EXPECT().GetInstances(opt.BastionInstanceName).Return(&ecs.DescribeInstancesResponse{
+			Instances: ecs.InstancesInDescribeInstances{
+				Instance: []ecs.Instance{instance},
+			},
+		}, nil)
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ensureCloudAssistant", func() {
+		It("should succeed if the agent is running and supports sessions", func() {
+			ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(&ecs.InstanceCloudAssistantStatus{CloudAssistantStatus: "true", SupportSessionManager: true}, nil)
+
+			Expect(ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime)).To(BeTrue())
+		})
+
+		It("should fail if the agent does not support sessions", func() {
+			ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(&ecs.InstanceCloudAssistantStatus{CloudAssistantStatus: "true"}, nil)
+
+			_, err := ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime)
+			Expect(err).To(MatchError(ContainSubstring("does not support sessions")))
+		})
+
+		It("should wait for the agent within the grace period", func() {
+			ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(&ecs.InstanceCloudAssistantStatus{CloudAssistantStatus: "false"}, nil)
+
+			Expect(ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime.Add(time.Minute))).To(BeFalse())
+		})
+
+		It("should install the agent if it never reported a heartbeat after the grace period", func() {
+			ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(nil, nil)
+			ecsClient.EXPECT().InstallCloudAssistant(instanceID)
+
+			Expect(ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime.Add(cloudAssistantStartupGracePeriod))).To(BeFalse())
+		})
+
+		Context("the instance has not been started yet", func() {
+			BeforeEach(func() {
+				instance.StartTime = ""
+				instance.CreationTime = startTime.Format(ecsTimeLayout)
+			})
+
+			It("should wait for the agent within the grace period after the creation", func() {
+				ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(nil, nil)
+
+				Expect(ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime.Add(time.Minute))).To(BeFalse())
+			})
+
+			It("should install the agent after the grace period after the creation", func() {
+				ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(nil, nil)
+				ecsClient.EXPECT().InstallCloudAssistant(instanceID)
+
+				Expect(ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime.Add(cloudAssistantStartupGracePeriod))).To(BeFalse())
+			})
+		})
+
+		Context("the start time is invalid", func() {
+			BeforeEach(func() {
+				instance.StartTime = "invalid"
+			})
+
+			It("should fail instead of waiting forever", func() {
+				ecsClient.EXPECT().GetCloudAssistantStatus(instanceID).Return(nil, nil)
+
+				_, err := ensureCloudAssistant(ecsClient, logr.Discard(), opt, startTime)
+				Expect(err).To(MatchError(ContainSubstring(`failed to parse start time "invalid"`)))
+			})
+		})
+	})
+})
@@ -4,6 +4,8 @@ import (
 	"context"
 	"errors"
 	"fmt"
+	"maps"
+	"slices"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/bastion"
 	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
@@ -69,8 +71,14 @@ func (c *configValidator) Validate(ctx context.Context, _ *extensionsv1alpha1.Ba
 		return allErrs
 	}
 
+	bastionConfig, err := bastionConfigFromCluster(cluster)
+	if err != nil {
+		allErrs = append(allErrs, field.InternalError(nil, err))
+		return allErrs
+	}
+
 	// Validate infrastructureStatus value
-	allErrs = append(allErrs, c.validateInfrastructureStatus(ctx, aliCloudECSClient, aliCloudVPCClient, infrastructureStatus)...)
+	allErrs = append(allErrs, c.validateInfrastructureStatus(ctx, aliCloudECSClient, aliCloudVPCClient, infrastructureStatus, bastionImagesForRegion(bastionConfig, cluster.Shoot.Spec.Region))...)
 	return allErrs
 }
 
@@ -98,10 +106,6 @@ func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *exte
 		return nil, errors.New("vswitches id must be not empty for infrastructure provider status")
 	}
 
-	if len(infrastructureStatus.MachineImages) == 0 || infrastructureStatus.MachineImages[0].ID == "" {
-		return nil, errors.New("machineImages id must be not empty for infrastructure provider status")
-	}
-
 	// The assumption is that the shoot only has one security group
 	if len(infrastructureStatus.VPC.SecurityGroups) == 0 || infrastructureStatus.VPC.SecurityGroups[0].ID == "" {
 		return nil, errors.New("shoot securityGroups id must be not empty for infrastructure provider status")
@@ -110,7 +114,7 @@ func getInfrastructureStatus(ctx context.Context, c client.Client, cluster *exte
 	return infrastructureStatus, nil
 }
 
-func (c *configValidator) validateInfrastructureStatus(ctx context.Context, aliCloudECSClient aliclient.ECS, aliCloudVPCClient aliclient.VPC, infrastructureStatus *alicloudapi.InfrastructureStatus) field.ErrorList {
+func (c *configValidator) validateInfrastructureStatus(ctx context.Context, aliCloudECSClient aliclient.ECS, aliCloudVPCClient aliclient.VPC, infrastructureStatus *alicloudapi.InfrastructureStatus, bastionImages map[string]string) field.ErrorList {
 	allErrs := field.ErrorList{}
 
 	vpc, err := aliCloudVPCClient.GetVPCWithID(ctx, infrastructureStatus.VPC.ID)
@@ -125,10 +129,25 @@ func (c *configValidator) validateInfrastructureStatus(ctx context.Context, aliC
 		return allErrs
 	}
 
-	machineImages, err := aliCloudECSClient.CheckIfImageExists(infrastructureStatus.MachineImages[0].ID)
-	if err != nil || !machineImages {
-		allErrs = append(allErrs, field.InternalError(field.NewPath("machineImages"), fmt.Errorf("could not get machineImages %s from alicloud provider: %w", infrastructureStatus.MachineImages[0].ID, err)))
-		return allErrs
+	if len(bastionImages) > 0 {
+		// the bastion images configured in the cloud profile are used instead of the machine image of the workers
+		for _, arch := range slices.Sorted(maps.Keys(bastionImages)) {
+			exists, err := aliCloudECSClient.CheckIfImageExists(bastionImages[arch])
+			if err != nil || !exists {
+				allErrs = append(allErrs, field.InternalError(field.NewPath("bastion", "images"), fmt.Errorf("could not get bastion image %s from alicloud provider: %w", bastionImages[arch], err)))
+				return allErrs
+			}
+		}
+	} else {
+		if len(infrastructureStatus.MachineImages) == 0 || infrastructureStatus.MachineImages[0].ID == "" {
+			allErrs = append(allErrs, field.Required(field.NewPath("machineImages"), "machineImages id must be not empty for infrastructure provider status if no bastion image is configured"))
+			return allErrs
+		}
+		machineImages, err := aliCloudECSClient.CheckIfImageExists(infrastructureStatus.MachineImages[0].ID)
+		if err != nil || !machineImages {
+			allErrs = append(allErrs, field.InternalError(field.NewPath("machineImages"), fmt.Errorf("could not get machineImages %s from alicloud provider: %w", infrastructureStatus.MachineImages[0].ID, err)))
+			return allErrs
+		}
 	}
 
 	shootSecurityGroupId, err := aliCloudECSClient.GetSecurityGroupWithID(infrastructureStatus.VPC.SecurityGroups[0].ID)
@@ -195,6 +195,24 @@ var _ = Describe("ConfigValidator", func() {
 				}))
 		})
 
+		It("should fail if no bastion image is configured and the infrastructureStatus has no machineImages", func() {
+			worker.Spec.InfrastructureProviderStatus.Raw = encode(&apialicloud.InfrastructureStatus{
+				VPC: apialicloud.VPCStatus{
+					ID:             id,
+					VSwitches:      []apialicloud.VSwitch{{ID: id, Zone: "zone"}},
+					SecurityGroups: []apialicloud.SecurityGroup{{ID: id}},
+				},
+			})
+			vpcClient.EXPECT().GetVPCWithID(ctx, id).Return([]vpc.Vpc{{VpcId: id}}, nil)
+			vpcClient.EXPECT().GetVSwitchesInfoByID(id).Return(&aliclient.VSwitchInfo{ZoneID: "zoneid"}, nil)
+			errorList := cv.Validate(ctx, bastion, cluster)
+			Expect(errorList).To(ConsistOfFields(
+				gstruct.Fields{
+					"Type":  Equal(field.ErrorTypeRequired),
+					"Field": Equal("machineImages"),
+				}))
+		})
+
 		It("should fail with InternalError if getting securityGroup id failed", func() {
 			vpcClient.EXPECT().GetVPCWithID(ctx, id).Return([]vpc.Vpc{{VpcId: id}}, nil)
 			vpcClient.EXPECT().GetVSwitchesInfoByID(id).Return(&aliclient.VSwitchInfo{ZoneID: "zoneid"}, nil)
@@ -0,0 +1,202 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"slices"
+	"strconv"
+	"time"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/go-logr/logr"
+	"k8s.io/apimachinery/pkg/util/wait"
+	"k8s.io/utils/ptr"
+
+	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+)
+
+const (
+	eipInstanceType      = "EcsInstance"
+	eipStatusAvailable   = "Available"
+	defaultEIPBandwidth  = 5
+	eipChargeTypeTraffic = "PayByTraffic"
+)
+
+// ensureEIP associates an EIP with the bastion instance and returns its address. The EIP is taken from the pool
+// selected by the pool tags or allocated with the name of the bastion instance.
+func ensureEIP(c aliclient.VPC, log logr.Logger, config *alicloudapi.BastionEIP, opt *Options, instanceID string) (string, error) {
+	associated, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+		req.AssociatedInstanceId = instanceID
+		req.AssociatedInstanceType = eipInstanceType
+	})
+	if err != nil {
+		return "", err
+	}
+	if len(associated) > 0 {
+		return associated[0].IpAddress, nil
+	}
+
+	var eip *vpc.EipAddress
+	if len(config.PoolTags) > 0 {
+		eip, err = pickPooledEIP(c, config.PoolTags)
+		if err != nil {
+			return "", err
+		}
+		if eip == nil {
+			return "", fmt.Errorf("no available EIP found in the bastion EIP pool with tags %v", config.PoolTags)
+		}
+		log.Info("associating pooled EIP with bastion instance", "eip", eip.AllocationId)
+	} else {
+		eip, err = ensureAllocatedEIP(c, log, config, opt)
+		if err != nil {
+			return "", err
+		}
+		if eip.Status != eipStatusAvailable {
+			return "", fmt.Errorf("EIP %s of bastion instance is not available yet: %s", eip.AllocationId, eip.Status)
+		}
+	}
+
+	req := vpc.CreateAssociateEipAddressRequest()
+	req.AllocationId = eip.AllocationId
+	req.InstanceId = instanceID
+	req.InstanceType = eipInstanceType
+	if _, err := c.AssociateEipAddress(req); err != nil {
+		return "", fmt.Errorf("failed to associate EIP %s with bastion instance: %w", eip.AllocationId, err)
+	}
+	return eip.IpAddress, nil
+}
+
+// pickPooledEIP returns an available EIP with all pool tags or nil if there is none.
+func pickPooledEIP(c aliclient.VPC, poolTags map[string]string) (*vpc.EipAddress, error) {
+	var tags []vpc.DescribeEipAddressesTag
+	for _, key := range slices.Sorted(maps.Keys(poolTags)) {
+		tags = append(tags, vpc.DescribeEipAddressesTag{Key: key, Value: poolTags[key]})
+	}
+	eips, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+		req.Tag = &tags
+		req.Status = eipStatusAvailable
+	})
+	if err != nil || len(eips) == 0 {
+		return nil, err
+	}
+	return &eips[0], nil
+}
+
+// ensureAllocatedEIP returns the EIP allocated for the bastion instance, allocating and tagging it if needed.
+func ensureAllocatedEIP(c aliclient.VPC, log logr.Logger, config *alicloudapi.BastionEIP, opt *Options) (*vpc.EipAddress, error) {
+	eips, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+		req.EipName = opt.BastionInstanceName
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(eips) > 0 {
+		return &eips[0], nil
+	}
+
+	log.Info("allocating EIP for bastion instance")
+	req := vpc.CreateAllocateEipAddressRequest()
+	req.Name = opt.BastionInstanceName
+	req.Bandwidth = strconv.Itoa(int(ptr.Deref(config.Bandwidth, defaultEIPBandwidth)))
+	req.InternetChargeType = eipChargeTypeTraffic
+	resp, err := c.AllocateEipAddress(req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(config.Tags) > 0 {
+		var tags []vpc.TagResourcesTag
+		for _, key := range slices.Sorted(maps.Keys(config.Tags)) {
+			tags = append(tags, vpc.TagResourcesTag{Key: key, Value: config.Tags[key]})
+		}
+		tagReq := vpc.CreateTagResourcesRequest()
+		tagReq.ResourceType = "EIP"
+		tagReq.ResourceId = &[]string{resp.AllocationId}
+		tagReq.Tag = &tags
+		if _, err := c.TagResources(tagReq); err != nil {
+			return nil, fmt.Errorf("failed to tag EIP %s of bastion instance: %w", resp.AllocationId, err)
+		}
+	}
+
+	eips, err = describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+		req.AllocationId = resp.AllocationId
+	})
+	if err != nil {
+		return nil, err
+	}
+	if len(eips) == 0 {
+		return nil, fmt.Errorf("allocated EIP %s of bastion instance not found", resp.AllocationId)
+	}
+	return &eips[0], nil
+}
+
+// removeEIP unassociates the EIP from the bastion instance. An EIP allocated for the bastion instance is released,
+// an EIP of the pool is only returned to it.
+func removeEIP(ctx context.Context, c aliclient.VPC, log logr.Logger, opt *Options, instanceID string) error {
+	if instanceID != "" {
+		associated, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+			req.AssociatedInstanceId = instanceID
+			req.AssociatedInstanceType = eipInstanceType
+		})
+		if err != nil {
+			return err
+		}
+		for _, eip := range associated {
+			log.Info("unassociating EIP from bastion instance", "eip", eip.AllocationId)
+			req := vpc.CreateUnassociateEipAddressRequest()
+			req.AllocationId = eip.AllocationId
+			req.InstanceId = instanceID
+			req.InstanceType = eipInstanceType
+			if _, err := c.UnassociateEipAddress(req); err != nil {
+				return fmt.Errorf("failed to unassociate EIP %s from bastion instance: %w", eip.AllocationId, err)
+			}
+			if err := waitForEIPAvailable(ctx, c, eip.AllocationId); err != nil {
+				return err
+			}
+		}
+	}
+
+	allocated, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+		req.EipName = opt.BastionInstanceName
+	})
+	if err != nil {
+		return err
+	}
+	for _, eip := range allocated {
+		log.Info("releasing EIP of bastion instance", "eip", eip.AllocationId)
+		req := vpc.CreateReleaseEipAddressRequest()
+		req.AllocationId = eip.AllocationId
+		if _, err := c.ReleaseEipAddress(req); err != nil {
+			return fmt.Errorf("failed to release EIP %s of bastion instance: %w", eip.AllocationId, err)
+		}
+	}
+	return nil
+}
+
+func waitForEIPAvailable(ctx context.Context, c aliclient.VPC, allocationID string) error {
+	return wait.PollUntilContextTimeout(ctx, 2*time.Second, time.Minute, true, func(_ context.Context) (bool, error) {
+		eips, err := describeEIPs(c, func(req *vpc.DescribeEipAddressesRequest) {
+			req.AllocationId = allocationID
+		})
+		if err != nil {
+			return false, err
+		}
+		return len(eips) == 0 || eips[0].Status == eipStatusAvailable, nil
+	})
+}
+
+func describeEIPs(c aliclient.VPC, filter func(req *vpc.DescribeEipAddressesRequest)) ([]vpc.EipAddress, error) {
+	req := vpc.CreateDescribeEipAddressesRequest()
+	filter(req)
+	resp, err := c.DescribeEipAddresses(req)
+	if err != nil {
+		return nil, err
+	}
+	return resp.EipAddresses.EipAddress, nil
+}
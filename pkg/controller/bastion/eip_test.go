@@ -0,0 +1,138 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"context"
+
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/utils/ptr"
+
+	apialicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+)
+
+var _ = Describe("EIP", func() {
+	var (
+		ctrl      *gomock.Controller
+		vpcClient *mockalicloudclient.MockVPC
+		opt       *Options
+	)
+
+	const instanceID = "i-bastion"
+
+	eipsResponse := func(eips ...vpc.EipAddress) *vpc.DescribeEipAddressesResponse {
+		return &vpc.DescribeEipAddressesResponse{EipAddresses: vpc.EipAddresses{EipAddress: eips}}
+	}
+
+	expectDescribeByInstance := func(eips ...vpc.EipAddress) {
+		vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).DoAndReturn(func(req *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+			Expect(req.AssociatedInstanceId).To(Equal(instanceID))
+			Expect(req.AssociatedInstanceType).To(Equal(eipInstanceType))
+			return eipsResponse(eips...), nil
+		})
+	}
+
+	expectDescribeByName := func(eips ...vpc.EipAddress) {
+		vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).DoAndReturn(func(req *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+			Expect(req.EipName).To(Equal(opt.BastionInstanceName))
+			return eipsResponse(eips...), nil
+		})
+	}
+
+	expectAssociate := func(allocationID string) {
+		vpcClient.EXPECT().AssociateEipAddress(gomock.Any()).DoAndReturn(func(req *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
+			Expect(req.AllocationId).To(Equal(allocationID))
+			Expect(req.InstanceId).To(Equal(instanceID))
+			return &vpc.AssociateEipAddressResponse{}, nil
+		})
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		vpcClient = mockalicloudclient.NewMockVPC(ctrl)
+		opt = &Options{BastionInstanceName: "shoot--foo--bar-bastion-bastion"}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#ensureEIP", func() {
+		It("should return the address of the EIP already associated with the instance", func() {
+			expectDescribeByInstance(vpc.EipAddress{AllocationId: "eip-1", IpAddress: "1.2.3.4"})
+
+			address, err := ensureEIP(vpcClient, logr.Discard(), &apialicloud.BastionEIP{}, opt, instanceID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal("1.2.3.4"))
+		})
+
+		It("should associate an available EIP of the pool", func() {
+			expectDescribeByInstance()
+			vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).DoAndReturn(func(req *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
+				Expect(*req.Tag).To(Equal([]vpc.DescribeEipAddressesTag{{Key: "purpose", Value: "bastion"}}))
+				Expect(req.Status).To(Equal(eipStatusAvailable))
+				return eipsResponse(vpc.EipAddress{AllocationId: "eip-pool", IpAddress: "5.6.7.8", Status: eipStatusAvailable}), nil
+			})
+			expectAssociate("eip-pool")
+
+			address, err := ensureEIP(vpcClient, logr.Discard(), &apialicloud.BastionEIP{PoolTags: map[string]string{"purpose": "bastion"}}, opt, instanceID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal("5.6.7.8"))
+		})
+
+		It("should fail if the pool has no available EIP", func() {
+			expectDescribeByInstance()
+			vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).Return(eipsResponse(), nil)
+
+			_, err := ensureEIP(vpcClient, logr.Discard(), &apialicloud.BastionEIP{PoolTags: map[string]string{"purpose": "bastion"}}, opt, instanceID)
+			Expect(err).To(MatchError(ContainSubstring("no available EIP found in the bastion EIP pool")))
+		})
+
+		It("should allocate, tag and associate an EIP", func() {
+			expectDescribeByInstance()
+			expectDescribeByName()
+			vpcClient.EXPECT().AllocateEipAddress(gomock.Any()).DoAndReturn(func(req *vpc.AllocateEipAddressRequest) (*vpc.AllocateEipAddressResponse, error) {
+				Expect(req.Name).To(Equal(opt.BastionInstanceName))
+				Expect(req.Bandwidth).To(Equal("10"))
+				return &vpc.AllocateEipAddressResponse{AllocationId: "eip-new"}, nil
+			})
+			vpcClient.EXPECT().TagResources(gomock.Any()).DoAndReturn(func(req *vpc.TagResourcesRequest) (*vpc.TagResourcesResponse, error) {
+				Expect(*req.ResourceId).To(Equal([]string{"eip-new"}))
+				Expect(*req.Tag).To(Equal([]vpc.TagResourcesTag{{Key: "owner", Value: "ops"}}))
+				return &vpc.TagResourcesResponse{}, nil
+			})
+			vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).Return(eipsResponse(vpc.EipAddress{AllocationId: "eip-new", IpAddress: "9.9.9.9", Status: eipStatusAvailable}), nil)
+			expectAssociate("eip-new")
+
+			address, err := ensureEIP(vpcClient, logr.Discard(), &apialicloud.BastionEIP{Tags: map[string]string{"owner": "ops"}, Bandwidth: ptr.To[int32](10)}, opt, instanceID)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(address).To(Equal("9.9.9.9"))
+		})
+	})
+
+	Describe("#removeEIP", func() {
+		It("should return a pooled EIP to the pool and release an allocated EIP", func() {
+			expectDescribeByInstance(vpc.EipAddress{AllocationId: "eip-pool"})
+			vpcClient.EXPECT().UnassociateEipAddress(gomock.Any()).DoAndReturn(func(req *vpc.UnassociateEipAddressRequest) (*vpc.UnassociateEipAddressResponse, error) {
+				Expect(req.AllocationId).To(Equal("eip-pool"))
+				Expect(req.InstanceId).To(Equal(instanceID))
+				return &vpc.UnassociateEipAddressResponse{}, nil
+			})
+			vpcClient.EXPECT().DescribeEipAddresses(gomock.Any()).Return(eipsResponse(vpc.EipAddress{AllocationId: "eip-pool", Status: eipStatusAvailable}), nil)
+			expectDescribeByName(vpc.EipAddress{AllocationId: "eip-allocated", Status: eipStatusAvailable})
+			vpcClient.EXPECT().ReleaseEipAddress(gomock.Any()).DoAndReturn(func(req *vpc.ReleaseEipAddressRequest) (*vpc.ReleaseEipAddressResponse, error) {
+				Expect(req.AllocationId).To(Equal("eip-allocated"))
+				return &vpc.ReleaseEipAddressResponse{}, nil
+			})
+
+			Expect(removeEIP(context.Background(), vpcClient, logr.Discard(), opt, instanceID)).To(Succeed())
+		})
+	})
+})
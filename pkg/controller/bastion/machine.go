@@ -0,0 +1,272 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	"errors"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/gardener/gardener/extensions/pkg/controller"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
+	"github.com/go-logr/logr"
+	"k8s.io/utils/ptr"
+
+	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+)
+
+// bastionMachine is the image, instance type and vswitch selected for the bastion host. The instance id is only set
+// if the bastion host already exists.
+type bastionMachine struct {
+	instanceID     string
+	imageID        string
+	instanceTypeID string
+	vSwitch        alicloudapi.VSwitch
+}
+
+// ecsArchitecture returns the CPU architecture as reported for ECS instance types, i.e. "X86" instead of "amd64"
+// or "x86_64" and "ARM" instead of "arm64" or "aarch64".
+func ecsArchitecture(arch string) string {
+	switch strings.ToLower(arch) {
+	case "x86_64", v1beta1constants.ArchitectureAMD64:
+		return "X86"
+	case v1beta1constants.ArchitectureARM64, "aarch64":
+		return "ARM"
+	}
+	return strings.ToUpper(strings.Split(arch, "_")[0])
+}
+
+// bastionConfigFromCluster returns the bastion section of the CloudProfileConfig or nil if there is none.
+func bastionConfigFromCluster(cluster *controller.Cluster) (*alicloudapi.BastionConfig, error) {
+	cloudProfileConfig, err := helper.CloudProfileConfigFromCluster(cluster)
+	if err != nil || cloudProfileConfig == nil {
+		return nil, err
+	}
+	return cloudProfileConfig.Bastion, nil
+}
+
+// bastionImagesForRegion returns the ids of the bastion images configured for the region by ECS architecture.
+func bastionImagesForRegion(bastionConfig *alicloudapi.BastionConfig, region string) map[string]string {
+	images := map[string]string{}
+	if bastionConfig == nil {
+		return images
+	}
+	for _, image := range bastionConfig.Images {
+		if image.Region == region {
+			images[ecsArchitecture(ptr.Deref(image.Architecture, v1beta1constants.ArchitectureAMD64))] = image.ID
+		}
+	}
+	return images
+}
+
+// nodeVSwitches returns the vswitches of the nodes. The vswitch recorded in the status of the bastion comes first,
+// so that the bastion host stays in its zone.
+func nodeVSwitches(infrastructureStatus *alicloudapi.InfrastructureStatus, bastionStatus *alicloudapi.BastionStatus) []alicloudapi.VSwitch {
+	var vSwitches []alicloudapi.VSwitch
+	for _, vSwitch := range infrastructureStatus.VPC.VSwitches {
+		if vSwitch.Purpose != alicloudapi.PurposeNodes {
+			continue
+		}
+		if bastionStatus != nil && vSwitch.ID == bastionStatus.VSwitchID {
+			vSwitches = append([]alicloudapi.VSwitch{vSwitch}, vSwitches...)
+			continue
+		}
+		vSwitches = append(vSwitches, vSwitch)
+	}
+	return vSwitches
+}
+
+// machineSelector selects the image and instance type of the bastion host. The bastion images and instance types of
+// the CloudProfileConfig are preferred. Without bastion images, the machine image of the workers is used. Without
+// bastion instance types, a small instance type available in the zone is probed.
+type machineSelector struct {
+	client                    aliclient.ECS
+	log                       logr.Logger
+	cluster                   *controller.Cluster
+	bastionConfig             *alicloudapi.BastionConfig
+	images                    map[string]string
+	instanceTypeArchitectures map[string]string
+}
+
+func newMachineSelector(c aliclient.ECS, log logr.Logger, cluster *controller.Cluster, region string, workerImages []alicloudapi.MachineImage) (*machineSelector, error) {
+	bastionConfig, err := bastionConfigFromCluster(cluster)
+	if err != nil {
+		return nil, err
+	}
+
+	images := bastionImagesForRegion(bastionConfig, region)
+	if len(images) == 0 {
+		if bastionConfig != nil && len(bastionConfig.Images) > 0 {
+			return nil, fmt.Errorf("no bastion image is configured in the cloud profile for region %s", region)
+		}
+		if len(workerImages) == 0 || workerImages[0].ID == "" {
+			return nil, errors.New("no bastion image is configured in the cloud profile and the infrastructure status contains no machine image of the workers")
+		}
+		workerImageID := workerImages[0].ID
+		arch, err := imageArchitecture(c, workerImageID)
+		if err != nil {
+			return nil, err
+		}
+		images[arch] = workerImageID
+	}
+
+	instanceTypes, err := c.ListAllInstanceType()
+	if err != nil {
+		return nil, err
+	}
+	instanceTypeArchitectures := make(map[string]string)
+	for _, t := range instanceTypes.InstanceTypes.InstanceType {
+		instanceTypeArchitectures[t.InstanceTypeId] = t.CpuArchitecture
+	}
+
+	return &machineSelector{
+		client:                    c,
+		log:                       log,
+		cluster:                   cluster,
+		bastionConfig:             bastionConfig,
+		images:                    images,
+		instanceTypeArchitectures: instanceTypeArchitectures,
+	}, nil
+}
+
+func (s *machineSelector) hasInstanceTypes() bool {
+	return s.bastionConfig != nil && len(s.bastionConfig.InstanceTypes) > 0
+}
+
+// selectInZone returns the image and instance type for the zone or empty strings if no instance type is available
+// in the zone.
+func (s *machineSelector) selectInZone(zoneID string) (string, string, error) {
+	if s.hasInstanceTypes() {
+		for _, instanceTypeID := range s.bastionConfig.InstanceTypes {
+			arch, ok := s.instanceTypeArchitectures[instanceTypeID]
+			if !ok {
+				s.log.Info("skipping unknown bastion instance type", "instance type", instanceTypeID)
+				continue
+			}
+			imageID, ok := s.images[arch]
+			if !ok {
+				s.log.Info("skipping bastion instance type without image of its architecture", "instance type", instanceTypeID, "architecture", arch)
+				continue
+			}
+			available, err := s.client.IsInstanceTypeAvailable(instanceTypeID, zoneID)
+			if err != nil {
+				return "", "", err
+			}
+			if available {
+				return imageID, instanceTypeID, nil
+			}
+			s.log.Info("skipping bastion instance type not available in zone", "instance type", instanceTypeID, "zone", zoneID)
+		}
+		return "", "", nil
+	}
+
+	arch, imageID := s.defaultImage()
+	for cores := 1; cores <= 2; cores++ {
+		instanceType, err := s.client.GetAvailableInstanceType(cores, zoneID)
+		if err != nil {
+			return "", "", err
+		}
+
+		if instanceType == nil ||
+			len(instanceType.AvailableZones.AvailableZone) == 0 ||
+			len(instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource) == 0 ||
+			len(instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource) == 0 ||
+			instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Status != "Available" {
+			continue
+		}
+
+		instanceTypeID := instanceType.AvailableZones.AvailableZone[0].AvailableResources.AvailableResource[0].SupportedResources.SupportedResource[0].Value
+		if s.instanceTypeArchitectures[instanceTypeID] != arch {
+			continue
+		}
+		return imageID, instanceTypeID, nil
+	}
+	return "", "", nil
+}
+
+// defaultImage returns the architecture and id of the image used with probed instance types, preferring X86.
+func (s *machineSelector) defaultImage() (string, string) {
+	arch := "X86"
+	if _, ok := s.images[arch]; !ok {
+		archs := make([]string, 0, len(s.images))
+		for a := range s.images {
+			archs = append(archs, a)
+		}
+		slices.Sort(archs)
+		arch = archs[0]
+	}
+	return arch, s.images[arch]
+}
+
+// determineMachine selects the first of the vswitches whose zone has capacity for the bastion host. Without bastion
+// instance types in the CloudProfileConfig, the first machine type of the CloudProfile in the first vswitch is used
+// as fallback.
+func determineMachine(s *machineSelector, vSwitches []alicloudapi.VSwitch) (*bastionMachine, error) {
+	if len(vSwitches) == 0 {
+		return nil, errors.New("no vswitch for nodes found in infrastructure status")
+	}
+
+	var zones []string
+	for _, vSwitch := range vSwitches {
+		imageID, instanceTypeID, err := s.selectInZone(vSwitch.Zone)
+		if err != nil {
+			return nil, err
+		}
+		if instanceTypeID != "" {
+			return &bastionMachine{imageID: imageID, instanceTypeID: instanceTypeID, vSwitch: vSwitch}, nil
+		}
+		s.log.Info("no bastion instance type available in zone", "zone", vSwitch.Zone)
+		zones = append(zones, vSwitch.Zone)
+	}
+
+	if s.hasInstanceTypes() {
+		return nil, fmt.Errorf("none of the bastion instance types %s of the cloud profile is available in the zones %s with a bastion image of its architecture", strings.Join(s.bastionConfig.InstanceTypes, ", "), strings.Join(zones, ", "))
+	}
+
+	machineTypes := s.cluster.CloudProfile.Spec.MachineTypes
+	if len(machineTypes) == 0 {
+		return nil, errors.New("failed to determine instanceTypeId from cloud profile as fallback. Machine types missing from cloud profile")
+	}
+	s.log.Info("falling back to first machine type of cloud profile as bastion instance type id", "instance type", machineTypes[0].Name)
+	_, imageID := s.defaultImage()
+	return &bastionMachine{imageID: imageID, instanceTypeID: machineTypes[0].Name, vSwitch: vSwitches[0]}, nil
+}
+
+// existingMachine returns the vswitch of the bastion host if it has already been created, otherwise nil.
+func existingMachine(c aliclient.ECS, opt *Options) (*bastionMachine, error) {
+	response, err := c.GetInstances(opt.BastionInstanceName)
+	if err != nil {
+		return nil, err
+	}
+	if len(response.Instances.Instance) == 0 || response.Instances.Instance[0].InstanceName != opt.BastionInstanceName {
+		return nil, nil
+	}
+	instance := response.Instances.Instance[0]
+	return &bastionMachine{
+		instanceID:     instance.InstanceId,
+		imageID:        instance.ImageId,
+		instanceTypeID: instance.InstanceType,
+		vSwitch: alicloudapi.VSwitch{
+			Purpose: alicloudapi.PurposeNodes,
+			ID:      instance.VpcAttributes.VSwitchId,
+			Zone:    instance.ZoneId,
+		},
+	}, nil
+}
+
+// imageArchitecture returns the ECS architecture of the image.
+func imageArchitecture(c aliclient.ECS, imageID string) (string, error) {
+	imageInfo, err := c.GetImageInfo(imageID)
+	if err != nil {
+		return "", err
+	}
+	if len(imageInfo.Images.Image) == 0 {
+		return "", errors.New("image not found")
+	}
+	return ecsArchitecture(imageInfo.Images.Image[0].Architecture), nil
+}
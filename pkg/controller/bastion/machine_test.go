@@ -0,0 +1,177 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package bastion
+
+import (
+	ecs "github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	corev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	"github.com/gardener/gardener/pkg/extensions"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	"k8s.io/apimachinery/pkg/runtime"
+
+	apialicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	mockalicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/mock/provider-alicloud/alicloud/client"
+)
+
+var _ = Describe("Machine", func() {
+	var (
+		ctrl      *gomock.Controller
+		ecsClient *mockalicloudclient.MockECS
+		cluster   *extensions.Cluster
+	)
+
+	const (
+		zone          = "cn-hangzhou-h"
+		otherZone     = "cn-hangzhou-i"
+		workerImageID = "worker-image"
+	)
+
+	withCloudProfileConfig := func(config string) {
+		cluster.CloudProfile.Spec.ProviderConfig = &runtime.RawExtension{Raw: []byte(config)}
+	}
+
+	instanceTypes := &ecs.DescribeInstanceTypesResponse{
+		InstanceTypes: ecs.InstanceTypesInDescribeInstanceTypes{
+			InstanceType: []ecs.InstanceType{
+				{InstanceTypeId: "ecs.t6-c1m1.large", CpuArchitecture: "X86"},
+				{InstanceTypeId: "ecs.g8y.large", CpuArchitecture: "ARM"},
+			},
+		},
+	}
+
+	BeforeEach(func() {
+		ctrl = gomock.NewController(GinkgoT())
+		ecsClient = mockalicloudclient.NewMockECS(ctrl)
+		cluster = &extensions.Cluster{
+			CloudProfile: &corev1beta1.CloudProfile{
+				Spec: corev1beta1.CloudProfileSpec{
+					MachineTypes: []corev1beta1.MachineType{{Name: "ecs.g6.large"}},
+				},
+			},
+		}
+	})
+
+	AfterEach(func() {
+		ctrl.Finish()
+	})
+
+	Describe("#determineMachine", func() {
+		var (
+			vSwitches    []apialicloud.VSwitch
+			workerImages []apialicloud.MachineImage
+		)
+
+		BeforeEach(func() {
+			workerImages = []apialicloud.MachineImage{{Name: "gardenlinux", Version: "1.0.0", ID: workerImageID}}
+			vSwitches = []apialicloud.VSwitch{
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-h", Zone: zone},
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-i", Zone: otherZone},
+			}
+		})
+
+		determine := func() (*bastionMachine, error) {
+			selector, err := newMachineSelector(ecsClient, logr.Discard(), cluster, "cn-hangzhou", workerImages)
+			if err != nil {
+				return nil, err
+			}
+			return determineMachine(selector, vSwitches)
+		}
+
+		It("should select the first available bastion instance type with an image of its architecture", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"images":[{"region":"cn-hangzhou","architecture":"arm64","id":"bastion-arm64"}],"instanceTypes":["ecs.unknown","ecs.t6-c1m1.large","ecs.g8y.large"]}}`)
+			ecsClient.EXPECT().ListAllInstanceType().Return(instanceTypes, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.g8y.large", zone).Return(true, nil)
+
+			machine, err := determine()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine).To(Equal(&bastionMachine{imageID: "bastion-arm64", instanceTypeID: "ecs.g8y.large", vSwitch: vSwitches[0]}))
+		})
+
+		It("should use the worker image with the bastion instance types if no bastion image is configured", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"instanceTypes":["ecs.g8y.large","ecs.t6-c1m1.large"]}}`)
+			ecsClient.EXPECT().GetImageInfo(workerImageID).Return(&ecs.DescribeImagesResponse{
+				Images: ecs.Images{Image: []ecs.Image{{Architecture: "x86_64"}}},
+			}, nil)
+			ecsClient.EXPECT().ListAllInstanceType().Return(instanceTypes, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", zone).Return(true, nil)
+
+			machine, err := determine()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine).To(Equal(&bastionMachine{imageID: workerImageID, instanceTypeID: "ecs.t6-c1m1.large", vSwitch: vSwitches[0]}))
+		})
+
+		It("should not require a machine image of the workers if a bastion image is configured", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"images":[{"region":"cn-hangzhou","id":"bastion-amd64"}],"instanceTypes":["ecs.t6-c1m1.large"]}}`)
+			workerImages = nil
+			ecsClient.EXPECT().ListAllInstanceType().Return(instanceTypes, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", zone).Return(true, nil)
+
+			machine, err := determine()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine).To(Equal(&bastionMachine{imageID: "bastion-amd64", instanceTypeID: "ecs.t6-c1m1.large", vSwitch: vSwitches[0]}))
+		})
+
+		It("should fail if neither a bastion image nor a machine image of the workers is available", func() {
+			workerImages = nil
+
+			_, err := determine()
+			Expect(err).To(MatchError(ContainSubstring("infrastructure status contains no machine image of the workers")))
+		})
+
+		It("should fall back to the next zone with capacity", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"images":[{"region":"cn-hangzhou","id":"bastion-amd64"}],"instanceTypes":["ecs.t6-c1m1.large"]}}`)
+			ecsClient.EXPECT().ListAllInstanceType().Return(instanceTypes, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", zone).Return(false, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", otherZone).Return(true, nil)
+
+			machine, err := determine()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(machine).To(Equal(&bastionMachine{imageID: "bastion-amd64", instanceTypeID: "ecs.t6-c1m1.large", vSwitch: vSwitches[1]}))
+		})
+
+		It("should fail if none of the bastion instance types is available in any zone", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"images":[{"region":"cn-hangzhou","id":"bastion-amd64"}],"instanceTypes":["ecs.t6-c1m1.large"]}}`)
+			ecsClient.EXPECT().ListAllInstanceType().Return(instanceTypes, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", zone).Return(false, nil)
+			ecsClient.EXPECT().IsInstanceTypeAvailable("ecs.t6-c1m1.large", otherZone).Return(false, nil)
+
+			_, err := determine()
+			Expect(err).To(MatchError(ContainSubstring("none of the bastion instance types ecs.t6-c1m1.large of the cloud profile is available in the zones " + zone + ", " + otherZone)))
+		})
+
+		It("should fail if no bastion image is configured for the region", func() {
+			withCloudProfileConfig(`{"apiVersion":"alicloud.provider.extensions.gardener.cloud/v1alpha1","kind":"CloudProfileConfig","bastion":{"images":[{"region":"cn-beijing","id":"bastion-amd64"}]}}`)
+
+			_, err := determine()
+			Expect(err).To(MatchError("no bastion image is configured in the cloud profile for region cn-hangzhou"))
+		})
+	})
+
+	Describe("#nodeVSwitches", func() {
+		It("should return the vswitches of the nodes with the recorded vswitch first", func() {
+			infrastructureStatus := &apialicloud.InfrastructureStatus{
+				VPC: apialicloud.VPCStatus{
+					VSwitches: []apialicloud.VSwitch{
+						{Purpose: apialicloud.PurposeNodes, ID: "vsw-h", Zone: zone},
+						{Purpose: apialicloud.PurposeInternal, ID: "vsw-internal", Zone: zone},
+						{Purpose: apialicloud.PurposeNodes, ID: "vsw-i", Zone: otherZone},
+					},
+				},
+			}
+
+			Expect(nodeVSwitches(infrastructureStatus, nil)).To(Equal([]apialicloud.VSwitch{
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-h", Zone: zone},
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-i", Zone: otherZone},
+			}))
+			Expect(nodeVSwitches(infrastructureStatus, &apialicloud.BastionStatus{Zone: otherZone, VSwitchID: "vsw-i"})).To(Equal([]apialicloud.VSwitch{
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-i", Zone: otherZone},
+				{Purpose: apialicloud.PurposeNodes, ID: "vsw-h", Zone: zone},
+			}))
+		})
+	})
+})
@@ -35,6 +35,16 @@ func egressAllowSSHToWorker(sourceCidrIp, securityGroupId, destSecurityGroupId s
 	return request
 }
 
+func egressAllowCloudAssistant(securityGroupId string) *ecs.AuthorizeSecurityGroupEgressRequest {
+	request := ecs.CreateAuthorizeSecurityGroupEgressRequest()
+	request.SecurityGroupId = securityGroupId
+	request.Description = "Allow Bastion egress to Cloud Assistant"
+	request.IpProtocol = "TCP"
+	request.PortRange = "443/443"
+	request.DestCidrIp = cloudAssistantServerCIDR
+	return request
+}
+
 func egressDenyAll(securityGroupId string) *ecs.AuthorizeSecurityGroupEgressRequest {
 	request := ecs.CreateAuthorizeSecurityGroupEgressRequest()
 	request.SecurityGroupId = securityGroupId
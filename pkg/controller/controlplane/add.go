@@ -38,6 +38,8 @@ type AddOptions struct {
 	WebhookServerNamespace string
 	// CSI specifies the configuration for CSI components
 	CSI config.CSI
+	// Service specifies the load balancer configuration of the shoot Services.
+	Service config.Service
 	// ExtensionClasses defines the extension classes this extension is responsible for.
 	ExtensionClasses []extensionsv1alpha1.ExtensionClass
 }
@@ -55,7 +57,7 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		controlPlaneShootChart,
 		controlPlaneShootCRDsChart,
 		storageClassChart,
-		NewValuesProvider(mgr, opts.CSI),
+		NewValuesProvider(mgr, opts.CSI, opts.Service),
 		extensionscontroller.ChartRendererFactoryFunc(util.NewChartRendererForShoot),
 		imagevector.ImageVector(),
 		"",
@@ -10,6 +10,7 @@ import (
 	"encoding/json"
 	"fmt"
 	"path/filepath"
+	"strconv"
 	"strings"
 
 	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
@@ -27,11 +28,13 @@ import (
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	rbacv1 "k8s.io/api/rbac/v1"
+	storagev1 "k8s.io/api/storage/v1"
 	apiextensionsv1 "k8s.io/apiextensions-apiserver/pkg/apis/apiextensions/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"k8s.io/apimachinery/pkg/runtime"
 	"k8s.io/apimachinery/pkg/runtime/serializer"
 	vpaautoscalingv1 "k8s.io/autoscaler/vertical-pod-autoscaler/pkg/apis/autoscaling.k8s.io/v1"
+	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/manager"
 
@@ -80,6 +83,10 @@ func shootAccessSecretsFunc(namespace string) []*gutil.AccessSecret {
 		gutil.NewShootAccessSecret("csi-snapshotter", namespace),
 		gutil.NewShootAccessSecret("csi-resizer", namespace),
 		gutil.NewShootAccessSecret("csi-snapshot-controller", namespace),
+		gutil.NewShootAccessSecret("csi-nas-controller-ali-plugin", namespace),
+		gutil.NewShootAccessSecret("csi-nas-provisioner", namespace),
+		gutil.NewShootAccessSecret("csi-oss-controller-ali-plugin", namespace),
+		gutil.NewShootAccessSecret("csi-oss-provisioner", namespace),
 	}
 }
 
@@ -110,12 +117,18 @@ var controlPlaneChart = &chart.Chart{
 				alicloud.CSIPluginImageName,
 				alicloud.CSILivenessProbeImageName,
 				alicloud.CSISnapshotControllerImageName,
+				alicloud.CSINASPluginImageName,
+				alicloud.CSIOSSPluginImageName,
 			},
 			Objects: []*chart.Object{
 				{Type: &appsv1.Deployment{}, Name: "csi-plugin-controller"},
 				{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: "csi-plugin-controller-vpa"},
 				{Type: &appsv1.Deployment{}, Name: "csi-snapshot-controller"},
 				{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: "csi-snapshot-controller-vpa"},
+				{Type: &appsv1.Deployment{}, Name: alicloud.CSINASPluginController},
+				{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: alicloud.CSINASPluginController + "-vpa"},
+				{Type: &appsv1.Deployment{}, Name: alicloud.CSIOSSPluginController},
+				{Type: &vpaautoscalingv1.VerticalPodAutoscaler{}, Name: alicloud.CSIOSSPluginController + "-vpa"},
 			},
 		},
 	},
@@ -140,6 +153,8 @@ var controlPlaneShootChart = &chart.Chart{
 				alicloud.CSIPluginImageName,
 				alicloud.CSIPluginInitImageName,
 				alicloud.CSILivenessProbeImageName,
+				alicloud.CSINASPluginImageName,
+				alicloud.CSIOSSPluginImageName,
 			},
 			Objects: []*chart.Object{
 				// csi-disk-plugin-alicloud
@@ -181,6 +196,39 @@ var controlPlaneShootChart = &chart.Chart{
 				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-resizer"},
 				{Type: &rbacv1.Role{}, Name: "csi-resizer"},
 				{Type: &rbacv1.RoleBinding{}, Name: "csi-resizer"},
+				// csi-nas-plugin-alicloud
+				{Type: &appsv1.DaemonSet{}, Name: alicloud.CSINASPluginNodeName},
+				{Type: &storagev1.CSIDriver{}, Name: alicloud.CSINASDriverName},
+				{Type: &corev1.ServiceAccount{}, Name: alicloud.CSINASPluginNodeName},
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-nas-plugin-alicloud"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-nas-plugin-alicloud"},
+				// csi-nas-controller-ali-plugin
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-nas-controller-ali-plugin"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-nas-controller-ali-plugin"},
+				{Type: &rbacv1.Role{}, Name: "csi-nas-controller-ali-plugin"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-nas-controller-ali-plugin"},
+				// csi-nas-provisioner
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-nas-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-nas-provisioner"},
+				{Type: &rbacv1.Role{}, Name: "csi-nas-provisioner"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-nas-provisioner"},
+				// csi-oss-plugin-alicloud
+				{Type: &appsv1.DaemonSet{}, Name: alicloud.CSIOSSPluginNodeName},
+				{Type: &storagev1.CSIDriver{}, Name: alicloud.CSIOSSDriverName},
+				{Type: &corev1.Secret{}, Name: alicloud.CSIOSSCredentialsSecretName},
+				{Type: &corev1.ServiceAccount{}, Name: alicloud.CSIOSSPluginNodeName},
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-oss-plugin-alicloud"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-oss-plugin-alicloud"},
+				// csi-oss-controller-ali-plugin
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-oss-controller-ali-plugin"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-oss-controller-ali-plugin"},
+				{Type: &rbacv1.Role{}, Name: "csi-oss-controller-ali-plugin"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-oss-controller-ali-plugin"},
+				// csi-oss-provisioner
+				{Type: &rbacv1.ClusterRole{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":kube-system:csi-oss-provisioner"},
+				{Type: &rbacv1.ClusterRoleBinding{}, Name: extensionsv1alpha1.SchemeGroupVersion.Group + ":csi-oss-provisioner"},
+				{Type: &rbacv1.Role{}, Name: "csi-oss-provisioner"},
+				{Type: &rbacv1.RoleBinding{}, Name: "csi-oss-provisioner"},
 			},
 		},
 	},
@@ -209,12 +257,13 @@ var storageClassChart = &chart.Chart{
 }
 
 // NewValuesProvider creates a new ValuesProvider for the generic actuator.
-func NewValuesProvider(mgr manager.Manager, csi config.CSI) genericactuator.ValuesProvider {
+func NewValuesProvider(mgr manager.Manager, csi config.CSI, service config.Service) genericactuator.ValuesProvider {
 	return &valuesProvider{
 		client:  mgr.GetClient(),
 		scheme:  mgr.GetScheme(),
 		decoder: serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 		csi:     csi,
+		service: service,
 	}
 }
 
@@ -225,6 +274,7 @@ type valuesProvider struct {
 	scheme  *runtime.Scheme
 	decoder runtime.Decoder
 	csi     config.CSI
+	service config.Service
 }
 
 // GetControlPlaneChartValues returns the values for the control plane chart applied by the generic actuator.
@@ -253,7 +303,7 @@ func (vp *valuesProvider) GetControlPlaneChartValues(
 func (vp *valuesProvider) GetControlPlaneShootChartValues(
 	ctx context.Context,
 	cp *extensionsv1alpha1.ControlPlane,
-	_ *extensionscontroller.Cluster,
+	cluster *extensionscontroller.Cluster,
 	_ secretsmanager.Reader,
 	_ map[string]string,
 ) (map[string]interface{}, error) {
@@ -268,8 +318,42 @@ func (vp *valuesProvider) GetControlPlaneShootChartValues(
 		return nil, err
 	}
 
+	// The OSS CSI driver uses the credentials of the cloudprovider secret unless a scoped secret is referenced
+	ossCredentials := credentials
+	if helper.IsCSIOSSEnabled(cpConfig) && cpConfig.CSI.OSS.CredentialsRef != nil {
+		ossCredentials, err = vp.readReferencedCredentials(ctx, cp.Namespace, cluster, *cpConfig.CSI.OSS.CredentialsRef)
+		if err != nil {
+			return nil, fmt.Errorf("could not read OSS credentials of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+		}
+	}
+
 	// Get control plane shoot chart values
-	return vp.getControlPlaneShootChartValues(cpConfig, credentials)
+	return vp.getControlPlaneShootChartValues(cpConfig, credentials, ossCredentials)
+}
+
+// readReferencedCredentials reads the credentials from the secret of the resource reference with the given name in the shoot.
+func (vp *valuesProvider) readReferencedCredentials(
+	ctx context.Context,
+	namespace string,
+	cluster *extensionscontroller.Cluster,
+	name string,
+) (*alicloud.Credentials, error) {
+	if cluster == nil || cluster.Shoot == nil {
+		return nil, fmt.Errorf("missing shoot to resolve resource reference %q", name)
+	}
+
+	for _, resource := range cluster.Shoot.Spec.Resources {
+		if resource.Name != name {
+			continue
+		}
+		secret := &corev1.Secret{}
+		if err := extensionscontroller.GetObjectByReference(ctx, vp.client, &resource.ResourceRef, namespace, secret); err != nil {
+			return nil, fmt.Errorf("could not get secret of resource reference %q: %w", name, err)
+		}
+		return alicloud.ReadSecretCredentials(secret, false)
+	}
+
+	return nil, fmt.Errorf("resource reference %q not found in shoot", name)
 }
 
 // cloudConfig wraps the settings for the Alicloud provider.
@@ -290,6 +374,165 @@ type cloudConfig struct {
 	}
 }
 
+// getVolumeSnapshotClassValues returns the values of the default VolumeSnapshotClass of the disk driver.
+func getVolumeSnapshotClassValues(vsc *apisalicloud.VolumeSnapshotClass) map[string]interface{} {
+	parameters := map[string]interface{}{}
+	if vsc.InstantAccess != nil {
+		parameters["instantAccess"] = strconv.FormatBool(*vsc.InstantAccess)
+	}
+	if vsc.InstantAccessRetentionDays != nil {
+		parameters["instantAccessRetentionDays"] = strconv.FormatInt(int64(*vsc.InstantAccessRetentionDays), 10)
+	}
+	if vsc.RetentionDays != nil {
+		parameters["retentionDays"] = strconv.FormatInt(int64(*vsc.RetentionDays), 10)
+	}
+
+	return map[string]interface{}{
+		"name":           ptr.Deref(vsc.Name, "default"),
+		"deletionPolicy": ptr.Deref(vsc.DeletionPolicy, "Delete"),
+		"parameters":     parameters,
+	}
+}
+
+// GetStorageClassesChartValues returns the values for the storage classes chart applied by the generic actuator.
+func (vp *valuesProvider) GetStorageClassesChartValues(
+	_ context.Context,
+	cp *extensionsv1alpha1.ControlPlane,
+	_ *extensionscontroller.Cluster,
+) (map[string]interface{}, error) {
+	cpConfig, err := vp.decodeControlPlaneConfig(cp)
+	if err != nil {
+		return nil, err
+	}
+	values := map[string]interface{}{}
+
+	if helper.IsCSINASEnabled(cpConfig) {
+		nasStorageClasses, err := vp.getNASStorageClasses(cp)
+		if err != nil {
+			return nil, err
+		}
+		values["nasStorageClasses"] = nasStorageClasses
+	}
+
+	if helper.IsCSIOSSEnabled(cpConfig) && cpConfig.CSI.OSS.Bucket != nil {
+		values["ossStorageClasses"] = []interface{}{
+			map[string]interface{}{
+				"name": "oss",
+				"parameters": map[string]interface{}{
+					"bucket":   *cpConfig.CSI.OSS.Bucket,
+					"url":      fmt.Sprintf("oss-%s-internal.aliyuncs.com", cp.Spec.Region),
+					"path":     "/",
+					"volumeAs": "subpath",
+					"csi.storage.k8s.io/provisioner-secret-name":       alicloud.CSIOSSCredentialsSecretName,
+					"csi.storage.k8s.io/provisioner-secret-namespace":  metav1.NamespaceSystem,
+					"csi.storage.k8s.io/node-publish-secret-name":      alicloud.CSIOSSCredentialsSecretName,
+					"csi.storage.k8s.io/node-publish-secret-namespace": metav1.NamespaceSystem,
+				},
+			},
+		}
+	}
+
+	if cpConfig.Storage != nil && cpConfig.Storage.VolumeSnapshotClass != nil {
+		values["volumeSnapshotClass"] = getVolumeSnapshotClassValues(cpConfig.Storage.VolumeSnapshotClass)
+	}
+
+	if cpConfig.Storage == nil || len(cpConfig.Storage.StorageClasses) == 0 {
+		if len(values) == 0 {
+			return nil, nil
+		}
+		return values, nil
+	}
+
+	var storageClasses []interface{}
+	for _, sc := range cpConfig.Storage.StorageClasses {
+		parameters := map[string]interface{}{
+			"csi.storage.k8s.io/fstype": ptr.Deref(sc.FSType, "ext4"),
+			"type":                      ptr.Deref(sc.Type, "cloud_essd"),
+			"readOnly":                  "false",
+			"encrypted":                 strconv.FormatBool(ptr.Deref(sc.Encrypted, true)),
+		}
+		if sc.PerformanceLevel != nil {
+			parameters["performanceLevel"] = *sc.PerformanceLevel
+		}
+		if sc.ProvisionedIOPS != nil {
+			parameters["provisionedIops"] = strconv.FormatInt(*sc.ProvisionedIOPS, 10)
+		}
+		if sc.KMSKeyID != nil {
+			parameters["kmsKeyId"] = *sc.KMSKeyID
+		}
+
+		storageClass := map[string]interface{}{
+			"name":       sc.Name,
+			"default":    ptr.Deref(sc.Default, false),
+			"parameters": parameters,
+		}
+		if sc.ReclaimPolicy != nil {
+			storageClass["reclaimPolicy"] = *sc.ReclaimPolicy
+		}
+		storageClasses = append(storageClasses, storageClass)
+	}
+
+	values["storageClasses"] = storageClasses
+	return values, nil
+}
+
+// getNASStorageClasses returns the NAS storage classes of a shoot. If the infrastructure provides a NAS file system,
+// volumes are provisioned as sub directories of it, either through the mount target of the first zone or through the
+// mount target of a dedicated zone. Otherwise, a NAS file system is created per volume in the zone of the nodes.
+func (vp *valuesProvider) getNASStorageClasses(cp *extensionsv1alpha1.ControlPlane) ([]interface{}, error) {
+	infraStatus := &apisalicloud.InfrastructureStatus{}
+	if cp.Spec.InfrastructureProviderStatus != nil {
+		if _, _, err := vp.decoder.Decode(cp.Spec.InfrastructureProviderStatus.Raw, nil, infraStatus); err != nil {
+			return nil, fmt.Errorf("could not decode infrastructureProviderStatus of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+		}
+	}
+
+	if infraStatus.NAS != nil && len(infraStatus.NAS.MountTargets) > 0 {
+		subpathParameters := func(mountTarget apisalicloud.NASMountTarget) map[string]interface{} {
+			return map[string]interface{}{
+				"volumeAs":        "subpath",
+				"server":          mountTarget.Domain + ":/",
+				"archiveOnDelete": "false",
+			}
+		}
+
+		storageClasses := []interface{}{
+			map[string]interface{}{
+				"name":       "nas",
+				"parameters": subpathParameters(infraStatus.NAS.MountTargets[0]),
+			},
+		}
+		for _, mountTarget := range infraStatus.NAS.MountTargets {
+			storageClasses = append(storageClasses, map[string]interface{}{
+				"name":       "nas-" + mountTarget.Zone,
+				"parameters": subpathParameters(mountTarget),
+			})
+		}
+		return storageClasses, nil
+	}
+
+	vswitch, err := helper.FindVSwitchForPurpose(infraStatus.VPC.VSwitches, apisalicloud.PurposeNodes)
+	if err != nil {
+		return nil, fmt.Errorf("could not determine vswitch from infrastructureProviderStatus of controlplane '%s': %w", client.ObjectKeyFromObject(cp), err)
+	}
+
+	return []interface{}{
+		map[string]interface{}{
+			"name": "nas",
+			"parameters": map[string]interface{}{
+				"volumeAs":       "filesystem",
+				"fileSystemType": "standard",
+				"storageType":    "Capacity",
+				"protocolType":   "NFS",
+				"zoneId":         vswitch.Zone,
+				"vpcId":          infraStatus.VPC.ID,
+				"vSwitchId":      vswitch.ID,
+				"deleteVolume":   "true",
+			},
+		},
+	}, nil
+}
+
 func (vp *valuesProvider) decodeControlPlaneConfig(cp *extensionsv1alpha1.ControlPlane) (*apisalicloud.ControlPlaneConfig, error) {
 	cpConfig := &apisalicloud.ControlPlaneConfig{}
 
@@ -397,6 +640,17 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 				},
 			},
 			"csiSnapshotController": map[string]interface{}{},
+			"nas": map[string]interface{}{
+				"enabled": helper.IsCSINASEnabled(cpConfig),
+			},
+			"oss": map[string]interface{}{
+				"enabled": helper.IsCSIOSSEnabled(cpConfig),
+			},
+			"csiNASPluginController": map[string]interface{}{
+				"podAnnotations": map[string]interface{}{
+					"checksum/secret-cloudprovider": checksums[v1beta1constants.SecretNameCloudProvider],
+				},
+			},
 		},
 	}
 
@@ -404,6 +658,10 @@ func (vp *valuesProvider) getControlPlaneChartValues(
 		values["alicloud-cloud-controller-manager"].(map[string]interface{})["featureGates"] = cpConfig.CloudControllerManager.FeatureGates
 	}
 
+	if helper.LoadBalancerType(cpConfig, vp.service.LoadBalancerType) == apisalicloud.LoadBalancerTypeNLB {
+		values["alicloud-cloud-controller-manager"].(map[string]interface{})["enableNLB"] = true
+	}
+
 	return values, nil
 }
 
@@ -415,7 +673,7 @@ func (vp *valuesProvider) enableCSIADController(cpConfig *apisalicloud.ControlPl
 func (vp *valuesProvider) getControlPlaneShootChartValues(
 	cpConfig *apisalicloud.ControlPlaneConfig,
 	credentials *alicloud.Credentials,
-
+	ossCredentials *alicloud.Credentials,
 ) (map[string]interface{}, error) {
 	values := map[string]interface{}{
 		"csi-alicloud": map[string]interface{}{
@@ -423,9 +681,22 @@ func (vp *valuesProvider) getControlPlaneShootChartValues(
 				"credentialsFile": base64.StdEncoding.EncodeToString([]byte(credentials.CredentialsFile)),
 			},
 			"enableADController": vp.enableCSIADController(cpConfig),
+			"nas": map[string]interface{}{
+				"enabled": helper.IsCSINASEnabled(cpConfig),
+			},
+			"oss": map[string]interface{}{
+				"enabled": helper.IsCSIOSSEnabled(cpConfig),
+			},
 		},
 	}
 
+	if helper.IsCSIOSSEnabled(cpConfig) {
+		values["csi-alicloud"].(map[string]interface{})["oss"].(map[string]interface{})["credential"] = map[string]interface{}{
+			"accessKeyID":     base64.StdEncoding.EncodeToString([]byte(ossCredentials.AccessKeyID)),
+			"accessKeySecret": base64.StdEncoding.EncodeToString([]byte(ossCredentials.AccessKeySecret)),
+		}
+	}
+
 	return values, nil
 }
 
@@ -21,6 +21,7 @@ import (
 	. "github.com/onsi/gomega"
 	"go.uber.org/mock/gomock"
 	appsv1 "k8s.io/api/apps/v1"
+	autoscalingv1 "k8s.io/api/autoscaling/v1"
 	corev1 "k8s.io/api/core/v1"
 	policyv1 "k8s.io/api/policy/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
@@ -148,6 +149,17 @@ var _ = Describe("ValuesProvider", func() {
 				},
 
 				"csiSnapshotController": map[string]interface{}{},
+				"nas": map[string]interface{}{
+					"enabled": false,
+				},
+				"oss": map[string]interface{}{
+					"enabled": false,
+				},
+				"csiNASPluginController": map[string]interface{}{
+					"podAnnotations": map[string]interface{}{
+						"checksum/secret-cloudprovider": "8bafb35ff1ac60275d62e1cbd495aceb511fb354f74a20f7d06ecb48b3a68432",
+					},
+				},
 			},
 		}
 
@@ -157,6 +169,12 @@ var _ = Describe("ValuesProvider", func() {
 					"credentialsFile": "YmF6",
 				},
 				"enableADController": true,
+				"nas": map[string]interface{}{
+					"enabled": false,
+				},
+				"oss": map[string]interface{}{
+					"enabled": false,
+				},
 			},
 		}
 
@@ -199,7 +217,7 @@ var _ = Describe("ValuesProvider", func() {
 			Client: c,
 			Scheme: scheme,
 		}
-		vp = NewValuesProvider(mgr, csi)
+		vp = NewValuesProvider(mgr, csi, config.Service{})
 	})
 
 	AfterEach(func() {
@@ -247,6 +265,27 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(values["alicloud-cloud-controller-manager"]).To(HaveKeyWithValue("ccmNetworkFalg", "vpc"))
 		})
 
+		It("should enable NLB support of the cloud-controller-manager if NLB is the default load balancer type", func() {
+			vp = NewValuesProvider(mgr, csi, config.Service{LoadBalancerType: "NLB"})
+
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["alicloud-cloud-controller-manager"]).To(HaveKeyWithValue("enableNLB", true))
+		})
+
+		It("should enable the NAS CSI driver if it is enabled in the control plane config", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{NAS: &apisalicloud.CSINAS{Enabled: true}},
+				}),
+			}
+
+			values, err := vp.GetControlPlaneChartValues(context.TODO(), cp, cluster, fakeSecretsManager, checksums, false)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["csi-alicloud"]).To(HaveKeyWithValue("nas", map[string]interface{}{"enabled": true}))
+		})
+
 		DescribeTable("topologyAwareRoutingEnabled value",
 			func(seedSettings *gardencorev1beta1.SeedSettings, shootControlPlane *gardencorev1beta1.ControlPlane) {
 				cluster.Seed = &gardencorev1beta1.Seed{
@@ -288,6 +327,231 @@ var _ = Describe("ValuesProvider", func() {
 		)
 	})
 
+	Describe("#GetStorageClassesChartValues", func() {
+		It("should return no values to deploy the default storage class", func() {
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeNil())
+		})
+
+		It("should return the storage classes of the control plane config", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					Storage: &apisalicloud.Storage{StorageClasses: []apisalicloud.StorageClass{
+						{Name: "essd-pl2", Default: ptr.To(true), PerformanceLevel: ptr.To("PL2"), FSType: ptr.To("xfs")},
+						{Name: "auto", Type: ptr.To("cloud_auto"), ProvisionedIOPS: ptr.To[int64](5000), ReclaimPolicy: ptr.To("Retain"), KMSKeyID: ptr.To("key-1")},
+					}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"storageClasses": []interface{}{
+					map[string]interface{}{
+						"name":    "essd-pl2",
+						"default": true,
+						"parameters": map[string]interface{}{
+							"csi.storage.k8s.io/fstype": "xfs",
+							"type":                      "cloud_essd",
+							"readOnly":                  "false",
+							"encrypted":                 "true",
+							"performanceLevel":          "PL2",
+						},
+					},
+					map[string]interface{}{
+						"name":          "auto",
+						"default":       false,
+						"reclaimPolicy": "Retain",
+						"parameters": map[string]interface{}{
+							"csi.storage.k8s.io/fstype": "ext4",
+							"type":                      "cloud_auto",
+							"readOnly":                  "false",
+							"encrypted":                 "true",
+							"provisionedIops":           "5000",
+							"kmsKeyId":                  "key-1",
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues with a VolumeSnapshotClass", func() {
+		It("should return the default volume snapshot class without storage classes", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					Storage: &apisalicloud.Storage{VolumeSnapshotClass: &apisalicloud.VolumeSnapshotClass{}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"volumeSnapshotClass": map[string]interface{}{
+					"name":           "default",
+					"deletionPolicy": "Delete",
+					"parameters":     map[string]interface{}{},
+				},
+			}))
+		})
+
+		It("should return the retention options of the volume snapshot class", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					Storage: &apisalicloud.Storage{VolumeSnapshotClass: &apisalicloud.VolumeSnapshotClass{
+						Name:                       ptr.To("snapshots"),
+						DeletionPolicy:             ptr.To("Retain"),
+						InstantAccess:              ptr.To(true),
+						InstantAccessRetentionDays: ptr.To[int32](1),
+						RetentionDays:              ptr.To[int32](30),
+					}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"volumeSnapshotClass": map[string]interface{}{
+					"name":           "snapshots",
+					"deletionPolicy": "Retain",
+					"parameters": map[string]interface{}{
+						"instantAccess":              "true",
+						"instantAccessRetentionDays": "1",
+						"retentionDays":              "30",
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues with NAS", func() {
+		var nasCP *extensionsv1alpha1.ControlPlane
+
+		BeforeEach(func() {
+			nasCP = cp.DeepCopy()
+			nasCP.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{NAS: &apisalicloud.CSINAS{Enabled: true}},
+				}),
+			}
+		})
+
+		It("should return a storage class creating a NAS file system per volume", func() {
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), nasCP, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"nasStorageClasses": []interface{}{
+					map[string]interface{}{
+						"name": "nas",
+						"parameters": map[string]interface{}{
+							"volumeAs":       "filesystem",
+							"fileSystemType": "standard",
+							"storageType":    "Capacity",
+							"protocolType":   "NFS",
+							"zoneId":         "eu-central-1a",
+							"vpcId":          "vpc-1234",
+							"vSwitchId":      "vswitch-acbd1234",
+							"deleteVolume":   "true",
+						},
+					},
+				},
+			}))
+		})
+
+		It("should return storage classes using the mount targets of the NAS file system of the infrastructure", func() {
+			nasCP.Spec.InfrastructureProviderStatus = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.InfrastructureStatus{
+					NAS: &apisalicloud.NASStatus{
+						FileSystemID: "fs-1234",
+						MountTargets: []apisalicloud.NASMountTarget{
+							{Zone: "eu-central-1a", Domain: "fs-1234-a.eu-central-1.nas.aliyuncs.com"},
+							{Zone: "eu-central-1b", Domain: "fs-1234-b.eu-central-1.nas.aliyuncs.com"},
+						},
+					},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), nasCP, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"nasStorageClasses": []interface{}{
+					map[string]interface{}{
+						"name": "nas",
+						"parameters": map[string]interface{}{
+							"volumeAs":        "subpath",
+							"server":          "fs-1234-a.eu-central-1.nas.aliyuncs.com:/",
+							"archiveOnDelete": "false",
+						},
+					},
+					map[string]interface{}{
+						"name": "nas-eu-central-1a",
+						"parameters": map[string]interface{}{
+							"volumeAs":        "subpath",
+							"server":          "fs-1234-a.eu-central-1.nas.aliyuncs.com:/",
+							"archiveOnDelete": "false",
+						},
+					},
+					map[string]interface{}{
+						"name": "nas-eu-central-1b",
+						"parameters": map[string]interface{}{
+							"volumeAs":        "subpath",
+							"server":          "fs-1234-b.eu-central-1.nas.aliyuncs.com:/",
+							"archiveOnDelete": "false",
+						},
+					},
+				},
+			}))
+		})
+	})
+
+	Describe("#GetStorageClassesChartValues with OSS", func() {
+		It("should return a storage class for the OSS bucket of the control plane config", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{Enabled: true, Bucket: ptr.To("ml-datasets")}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(Equal(map[string]interface{}{
+				"ossStorageClasses": []interface{}{
+					map[string]interface{}{
+						"name": "oss",
+						"parameters": map[string]interface{}{
+							"bucket":   "ml-datasets",
+							"url":      "oss-eu-central-1-internal.aliyuncs.com",
+							"path":     "/",
+							"volumeAs": "subpath",
+							"csi.storage.k8s.io/provisioner-secret-name":       "csi-oss-credentials",
+							"csi.storage.k8s.io/provisioner-secret-namespace":  "kube-system",
+							"csi.storage.k8s.io/node-publish-secret-name":      "csi-oss-credentials",
+							"csi.storage.k8s.io/node-publish-secret-namespace": "kube-system",
+						},
+					},
+				},
+			}))
+		})
+
+		It("should not return a storage class without OSS bucket", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{Enabled: true}},
+				}),
+			}
+
+			values, err := vp.GetStorageClassesChartValues(context.TODO(), cp, cluster)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values).To(BeNil())
+		})
+	})
+
 	Describe("#GetControlPlaneShootChartValues", func() {
 		BeforeEach(func() {
 			c.EXPECT().Get(context.TODO(), cpSecretKey, &corev1.Secret{}).DoAndReturn(clientGet(cpSecret))
@@ -303,6 +567,55 @@ var _ = Describe("ValuesProvider", func() {
 			Expect(err).NotTo(HaveOccurred())
 			Expect(values).To(Equal(controlPlaneShootChartValues))
 		})
+
+		It("should use the credentials of the cloudprovider secret for the OSS CSI driver", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{Enabled: true}},
+				}),
+			}
+
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["csi-alicloud"]).To(HaveKeyWithValue("oss", map[string]interface{}{
+				"enabled": true,
+				"credential": map[string]interface{}{
+					"accessKeyID":     "Zm9v",
+					"accessKeySecret": "YmFy",
+				},
+			}))
+		})
+
+		It("should use the credentials of the referenced secret for the OSS CSI driver", func() {
+			cp := cp.DeepCopy()
+			cp.Spec.ProviderConfig = &runtime.RawExtension{
+				Raw: encode(&apisalicloud.ControlPlaneConfig{
+					CSI: &apisalicloud.CSI{OSS: &apisalicloud.CSIOSS{Enabled: true, CredentialsRef: ptr.To("oss-credentials")}},
+				}),
+			}
+			cluster.Shoot.Spec.Resources = []gardencorev1beta1.NamedResourceReference{{
+				Name:        "oss-credentials",
+				ResourceRef: autoscalingv1.CrossVersionObjectReference{APIVersion: "v1", Kind: "Secret", Name: "my-oss-secret"},
+			}}
+			ossSecret := &corev1.Secret{
+				Data: map[string][]byte{
+					alicloud.AccessKeyID:     []byte("oss-id"),
+					alicloud.AccessKeySecret: []byte("oss-secret"),
+				},
+			}
+			c.EXPECT().Get(context.TODO(), client.ObjectKey{Namespace: namespace, Name: "ref-my-oss-secret"}, gomock.AssignableToTypeOf(&corev1.Secret{})).DoAndReturn(clientGet(ossSecret))
+
+			values, err := vp.GetControlPlaneShootChartValues(context.TODO(), cp, cluster, fakeSecretsManager, checksums)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(values["csi-alicloud"]).To(HaveKeyWithValue("oss", map[string]interface{}{
+				"enabled": true,
+				"credential": map[string]interface{}{
+					"accessKeyID":     "b3NzLWlk",
+					"accessKeySecret": "b3NzLXNlY3JldA==",
+				},
+			}))
+		})
 	})
 })
 
@@ -9,6 +9,7 @@ import (
 	"time"
 
 	healthcheckconfig "github.com/gardener/gardener/extensions/pkg/apis/config/v1alpha1"
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/general"
 	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck/worker"
@@ -25,8 +26,16 @@ import (
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 )
 
+const (
+	// ConditionTypeInfrastructureInSync is the condition type reporting whether the infrastructure resources
+	// still match the state recorded by the infrastructure flow.
+	ConditionTypeInfrastructureInSync = "InfrastructureInSync"
+)
+
 var (
 	defaultSyncPeriod = time.Second * 30
+	// infrastructureDriftCheckInterval is the minimal interval between two drift checks of the same infrastructure.
+	infrastructureDriftCheckInterval = time.Minute * 10
 	// DefaultAddOptions are the default DefaultAddArgs for AddToManager.
 	DefaultAddOptions = healthcheck.DefaultAddArgs{
 		HealthCheckConfig: healthcheckconfig.HealthCheckConfig{
@@ -63,12 +72,52 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
 				HealthCheck:   general.NewSeedDeploymentHealthChecker(alicloud.CloudControllerManagerName),
 			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+				HealthCheck:   general.NewSeedDeploymentHealthChecker(alicloud.CSINASPluginController),
+				PreCheckFunc:  isCSINASEnabled,
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDaemonSetHealthChecker(alicloud.CSINASPluginNodeName),
+				PreCheckFunc:  isCSINASEnabled,
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootControlPlaneHealthy),
+				HealthCheck:   general.NewSeedDeploymentHealthChecker(alicloud.CSIOSSPluginController),
+				PreCheckFunc:  isCSIOSSEnabled,
+			},
+			{
+				ConditionType: string(gardencorev1beta1.ShootSystemComponentsHealthy),
+				HealthCheck:   general.NewShootDaemonSetHealthChecker(alicloud.CSIOSSPluginNodeName),
+				PreCheckFunc:  isCSIOSSEnabled,
+			},
 		},
 		sets.Set[gardencorev1beta1.ConditionType]{},
 	); err != nil {
 		return err
 	}
 
+	if err := healthcheck.DefaultRegistration(
+		alicloud.Type,
+		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.InfrastructureResource),
+		func() client.ObjectList { return &extensionsv1alpha1.InfrastructureList{} },
+		func() extensionsv1alpha1.Object { return &extensionsv1alpha1.Infrastructure{} },
+		mgr,
+		opts,
+		nil,
+		[]healthcheck.ConditionTypeToHealthCheck{{
+			ConditionType: ConditionTypeInfrastructureInSync,
+			HealthCheck:   NewInfrastructureDriftChecker(infrastructureDriftCheckInterval),
+			ErrorCodeCheckFunc: func(err error) []gardencorev1beta1.ErrorCode {
+				return util.DetermineErrorCodes(err, helper.KnownCodes)
+			},
+		}},
+		sets.Set[gardencorev1beta1.ConditionType]{},
+	); err != nil {
+		return err
+	}
+
 	return healthcheck.DefaultRegistration(
 		alicloud.Type,
 		extensionsv1alpha1.SchemeGroupVersion.WithKind(extensionsv1alpha1.WorkerResource),
@@ -88,6 +137,32 @@ func RegisterHealthChecks(_ context.Context, mgr manager.Manager, opts healthche
 	)
 }
 
+// isCSINASEnabled checks whether the NAS CSI driver is enabled in the ControlPlaneConfig of the shoot.
+func isCSINASEnabled(_ context.Context, _ client.Client, _ client.Object, cluster any) bool {
+	c, ok := cluster.(*extensionscontroller.Cluster)
+	if !ok {
+		return false
+	}
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(c)
+	if err != nil {
+		return false
+	}
+	return helper.IsCSINASEnabled(cpConfig)
+}
+
+// isCSIOSSEnabled checks whether the OSS CSI driver is enabled in the ControlPlaneConfig of the shoot.
+func isCSIOSSEnabled(_ context.Context, _ client.Client, _ client.Object, cluster any) bool {
+	c, ok := cluster.(*extensionscontroller.Cluster)
+	if !ok {
+		return false
+	}
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(c)
+	if err != nil {
+		return false
+	}
+	return helper.IsCSIOSSEnabled(cpConfig)
+}
+
 // AddToManager adds a controller with the default Options.
 func AddToManager(ctx context.Context, mgr manager.Manager) error {
 	return RegisterHealthChecks(ctx, mgr, DefaultAddOptions)
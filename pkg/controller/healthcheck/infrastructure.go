@@ -0,0 +1,183 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/extensions/pkg/controller/healthcheck"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/go-logr/logr"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// InfrastructureDriftChecker compares the resources recorded in the flow state of an Infrastructure
+// with their live state in Alicloud. As this requires a number of cloud API calls, the result of a
+// check is reused until the check interval has passed or the Infrastructure has changed.
+type InfrastructureDriftChecker struct {
+	logger        logr.Logger
+	client        client.Client
+	checkInterval time.Duration
+	actorFactory  aliclient.Factory
+
+	lock    sync.Mutex
+	results map[types.NamespacedName]*driftCheckResult
+}
+
+type driftCheckResult struct {
+	checkedAt time.Time
+	// generation and lastUpdateTime identify the reconciliation of the Infrastructure the result was computed for.
+	generation     int64
+	lastUpdateTime metav1.Time
+	result         *healthcheck.SingleCheckResult
+}
+
+func (r *driftCheckResult) isValidFor(infra *extensionsv1alpha1.Infrastructure, now time.Time, checkInterval time.Duration) bool {
+	return now.Sub(r.checkedAt) < checkInterval &&
+		r.generation == infra.Generation &&
+		infra.Status.LastOperation != nil && r.lastUpdateTime.Equal(&infra.Status.LastOperation.LastUpdateTime)
+}
+
+var (
+	_ healthcheck.HealthCheck  = (*InfrastructureDriftChecker)(nil)
+	_ healthcheck.SourceClient = (*InfrastructureDriftChecker)(nil)
+)
+
+// NewInfrastructureDriftChecker is a health check function which detects drift of the infrastructure
+// resources managed by the infrastructure flow. The live state is queried at most once per checkInterval.
+func NewInfrastructureDriftChecker(checkInterval time.Duration) *InfrastructureDriftChecker {
+	return newInfrastructureDriftChecker(checkInterval, aliclient.FactoryFunc(aliclient.NewActor))
+}
+
+func newInfrastructureDriftChecker(checkInterval time.Duration, actorFactory aliclient.Factory) *InfrastructureDriftChecker {
+	return &InfrastructureDriftChecker{
+		checkInterval: checkInterval,
+		actorFactory:  actorFactory,
+		results:       map[types.NamespacedName]*driftCheckResult{},
+	}
+}
+
+// InjectSourceClient injects the seed/source client
+func (c *InfrastructureDriftChecker) InjectSourceClient(client client.Client) {
+	c.client = client
+}
+
+// SetLoggerSuffix injects the logger
+func (c *InfrastructureDriftChecker) SetLoggerSuffix(provider, extension string) {
+	c.logger = log.Log.WithName(fmt.Sprintf("%s-%s-healthcheck-infrastructure-drift", provider, extension))
+}
+
+// Check executes the health check
+func (c *InfrastructureDriftChecker) Check(ctx context.Context, request types.NamespacedName) (*healthcheck.SingleCheckResult, error) {
+	c.pruneExpiredResults(time.Now())
+
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := c.client.Get(ctx, request, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			c.forget(request)
+		}
+		err = fmt.Errorf("unable to retrieve infrastructure %q: %w", request.String(), err)
+		c.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	if !infra.DeletionTimestamp.IsZero() || infra.Status.State == nil ||
+		infra.Status.LastOperation == nil || infra.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		// drift can only be detected reliably after a successful reconciliation
+		c.forget(request)
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	c.lock.Lock()
+	cached := c.results[request]
+	c.lock.Unlock()
+	if cached != nil && cached.isValidFor(infra, time.Now(), c.checkInterval) {
+		return cached.result, nil
+	}
+
+	state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+	if err != nil {
+		return nil, fmt.Errorf("unable to decode flow state of infrastructure %q: %w", request.String(), err)
+	}
+	if state == nil {
+		// state is still managed by Terraformer
+		return &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}, nil
+	}
+
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return nil, err
+	}
+	credentials, err := alicloud.ReadCredentialsFromSecretRef(ctx, c.client, &infra.Spec.SecretRef)
+	if err != nil {
+		return nil, err
+	}
+	actor, err := c.actorFactory.NewActor(credentials.AccessKeyID, credentials.AccessKeySecret, infra.Spec.Region)
+	if err != nil {
+		return nil, err
+	}
+	flowContext := infraflow.NewFlowContextWithActor(c.logger, actor, infra, config, state.ToFlatMap(), nil, nil)
+	drifts, err := flowContext.DetectDrift(ctx)
+	if err != nil {
+		err = fmt.Errorf("unable to detect drift of infrastructure %q: %w", request.String(), err)
+		c.logger.Error(err, "Health check failed")
+		return nil, err
+	}
+
+	result := &healthcheck.SingleCheckResult{Status: gardencorev1beta1.ConditionTrue}
+	if len(drifts) > 0 {
+		details := make([]string, 0, len(drifts))
+		for _, drift := range drifts {
+			details = append(details, drift.String())
+		}
+		result = &healthcheck.SingleCheckResult{
+			Status: gardencorev1beta1.ConditionFalse,
+			Detail: fmt.Sprintf("Infrastructure resources drifted from the recorded state: %s", strings.Join(details, "; ")),
+		}
+	}
+
+	c.lock.Lock()
+	c.results[request] = &driftCheckResult{
+		checkedAt:      time.Now(),
+		generation:     infra.Generation,
+		lastUpdateTime: infra.Status.LastOperation.LastUpdateTime,
+		result:         result,
+	}
+	c.lock.Unlock()
+	return result, nil
+}
+
+// forget removes the cached result of the given Infrastructure.
+func (c *InfrastructureDriftChecker) forget(request types.NamespacedName) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	delete(c.results, request)
+}
+
+// pruneExpiredResults removes all cached results which are older than the check interval. As such results are
+// never reused, this also drops the results of Infrastructures which were deleted in the meantime.
+func (c *InfrastructureDriftChecker) pruneExpiredResults(now time.Time) {
+	c.lock.Lock()
+	defer c.lock.Unlock()
+	for key, result := range c.results {
+		if now.Sub(result.checkedAt) >= c.checkInterval {
+			delete(c.results, key)
+		}
+	}
+}
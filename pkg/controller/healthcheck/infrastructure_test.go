@@ -0,0 +1,187 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package healthcheck
+
+import (
+	"context"
+	"encoding/json"
+	"time"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("InfrastructureDriftChecker", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "infra"
+		region    = "cn-beijing"
+	)
+
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		actor        *mockaliclient.MockActor
+		actorFactory *mockaliclient.MockFactory
+		fakeClient   client.Client
+		checker      *InfrastructureDriftChecker
+
+		request types.NamespacedName
+		infra   *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+		actorFactory = mockaliclient.NewMockFactory(ctrl)
+
+		request = types.NamespacedName{Namespace: namespace, Name: name}
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace, Generation: 1},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type: alicloud.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: encode(&v1alpha1.InfrastructureConfig{
+						TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+						Networks: v1alpha1.Networks{VPC: v1alpha1.VPC{CIDR: ptr.To("10.0.0.0/16")}},
+					})},
+				},
+				Region:    region,
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{
+						State:          gardencorev1beta1.LastOperationStateSucceeded,
+						LastUpdateTime: metav1.NewTime(time.Now().Add(-time.Hour).Truncate(time.Second)),
+					},
+					State: &runtime.RawExtension{Raw: encode(infraflow.NewPersistentStateFromFlatMap(shared.FlatMap{
+						infraflow.IdentifierVPC: "vpc-1",
+					}))},
+				},
+			},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+			Data: map[string][]byte{
+				alicloud.AccessKeyID:     []byte("id"),
+				alicloud.AccessKeySecret: []byte("secret"),
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(infra, secret).WithStatusSubresource(infra).Build()
+		Expect(fakeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		checker = newInfrastructureDriftChecker(10*time.Minute, actorFactory)
+		checker.InjectSourceClient(fakeClient)
+		checker.SetLoggerSuffix("provider-alicloud", "infrastructure")
+	})
+
+	expectVPC := func(vpc *aliclient.VPC) {
+		actorFactory.EXPECT().NewActor("id", "secret", region).Return(actor, nil)
+		actor.EXPECT().GetVpc(ctx, "vpc-1").Return(vpc, nil)
+	}
+
+	It("should report a healthy infrastructure and reuse the result", func() {
+		expectVPC(&aliclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"})
+
+		for range 2 {
+			result, err := checker.Check(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+			Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		}
+	})
+
+	It("should report drift and check again after the infrastructure was reconciled", func() {
+		expectVPC(nil)
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+		Expect(result.Detail).To(ContainSubstring("VPC vpc-1: not found"))
+
+		infra.Status.LastOperation.LastUpdateTime = metav1.NewTime(time.Now().Truncate(time.Second))
+		Expect(fakeClient.Status().Update(ctx, infra)).To(Succeed())
+		expectVPC(&aliclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"})
+
+		result, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+	})
+
+	It("should check again after the generation of the infrastructure changed", func() {
+		expectVPC(nil)
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionFalse))
+
+		checker.results[request].generation = 0
+		expectVPC(nil)
+
+		_, err = checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	It("should not check an infrastructure whose last operation did not succeed", func() {
+		infra.Status.LastOperation.State = gardencorev1beta1.LastOperationStateError
+		Expect(fakeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		result, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(result.Status).To(Equal(gardencorev1beta1.ConditionTrue))
+		Expect(checker.results).To(BeEmpty())
+	})
+
+	It("should forget the result of a deleted infrastructure", func() {
+		expectVPC(&aliclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"})
+		_, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checker.results).To(HaveKey(request))
+
+		Expect(fakeClient.Delete(ctx, infra)).To(Succeed())
+
+		_, err = checker.Check(ctx, request)
+		Expect(err).To(HaveOccurred())
+		Expect(checker.results).To(BeEmpty())
+	})
+
+	It("should prune expired results of other infrastructures", func() {
+		other := types.NamespacedName{Namespace: "shoot--foo--gone", Name: name}
+		checker.results[other] = &driftCheckResult{checkedAt: time.Now().Add(-time.Hour)}
+		expectVPC(&aliclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"})
+
+		_, err := checker.Check(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+		Expect(checker.results).To(HaveLen(1))
+		Expect(checker.results).To(HaveKey(request))
+	})
+})
+
+func encode(obj any) []byte {
+	data, _ := json.Marshal(obj)
+	return data
+}
@@ -29,6 +29,7 @@ import (
 	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 	alicloudv1alpha1 "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
 	aliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
 
 // StatusTypeMeta is the TypeMeta of InfrastructureStatus.
@@ -65,6 +66,8 @@ func NewActuatorWithDeps(
 		restConfig: mgr.GetConfig(),
 		decoder:    serializer.NewCodecFactory(mgr.GetScheme(), serializer.EnableStrict).UniversalDecoder(),
 
+		eventRecorder: shared.NewEventRecorder(mgr.GetEventRecorder(alicloud.Name+"-"+infrastructure.ControllerName), shared.DefaultEventDeduplicationInterval),
+
 		newClientFactory:           newClientFactory,
 		machineImageOwnerSecretRef: machineImageOwnerSecretRef,
 		toBeSharedImageIDs:         toBeSharedImageIDs,
@@ -97,6 +100,8 @@ type actuator struct {
 	decoder    runtime.Decoder
 	restConfig *rest.Config
 
+	eventRecorder *shared.EventRecorder
+
 	alicloudECSClient alicloudclient.ECS
 	newClientFactory  alicloudclient.ClientFactory
 
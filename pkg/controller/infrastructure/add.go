@@ -39,6 +39,9 @@ type AddOptions struct {
 	DisableProjectedTokenMount bool
 	// ExtensionClasses defines the extension classes this extension is responsible for.
 	ExtensionClasses []extensionsv1alpha1.ExtensionClass
+	// EnableFlowGraphsHandler specifies whether the flow graphs are served on the metrics endpoint.
+	// As the metrics endpoint is not authenticated, it is disabled by default.
+	EnableFlowGraphsHandler bool
 }
 
 // AddToManagerWithOptions adds a controller with the given AddOptions to the given manager.
@@ -49,6 +52,12 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, options A
 		return err
 	}
 
+	if options.EnableFlowGraphsHandler {
+		if err := mgr.AddMetricsServerExtraHandler(GraphsHandlerPath, flowGraphs); err != nil {
+			return err
+		}
+	}
+
 	return infrastructure.Add(mgr, infrastructure.AddArgs{
 		Actuator:          actuator,
 		ConfigValidator:   NewConfigValidator(mgr, log.Log, aliclient.FactoryFunc(aliclient.NewActor)),
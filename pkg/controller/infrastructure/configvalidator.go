@@ -8,6 +8,7 @@ import (
 	"context"
 	"fmt"
 	"net"
+	"slices"
 	"strings"
 
 	"github.com/gardener/gardener/extensions/pkg/controller/infrastructure"
@@ -71,7 +72,13 @@ func (c *configValidator) Validate(ctx context.Context, infra *extensionsv1alpha
 		if config.Networks.VPC.GardenerManagedNATGateway == nil || !*config.Networks.VPC.GardenerManagedNATGateway {
 			createManagedNATGateway = false
 		}
-		allErrs = append(allErrs, c.validateVPC(ctx, actor, *config.Networks.VPC.ID, !createManagedNATGateway, field.NewPath("networks", "vpc", "id"))...)
+		egressModeNone := config.Networks.VPC.EgressMode != nil && *config.Networks.VPC.EgressMode == apisalicloud.EgressModeNone
+		vpcErrs := c.validateVPC(ctx, actor, *config.Networks.VPC.ID, !createManagedNATGateway && !egressModeNone, field.NewPath("networks", "vpc", "id"))
+		allErrs = append(allErrs, vpcErrs...)
+		if egressModeNone && len(vpcErrs) == 0 {
+			logger.Info("Validating default route for egress mode None")
+			allErrs = append(allErrs, c.validateDefaultRoute(ctx, actor, *config.Networks.VPC.ID, config.Networks.Zones, field.NewPath("networks", "vpc", "egressMode"))...)
+		}
 
 		if config.DualStack != nil && config.DualStack.Enabled {
 			logger.Info("Validating VPC IPv6 support for dualStack")
@@ -163,6 +170,63 @@ func (c *configValidator) validateVPC(ctx context.Context, actor aliclient.Actor
 	return allErrs
 }
 
+// validateDefaultRoute checks that the route tables used by the zones contain a default route, as the egress traffic
+// relies on it if no NAT gateway is used. Zones with an existing vswitch use the route table of the vswitch, the
+// vswitches created for the other zones use the system route table of the VPC.
+func (c *configValidator) validateDefaultRoute(ctx context.Context, actor aliclient.Actor, vpcID string, zones []apisalicloud.Zone, fldPath *field.Path) field.ErrorList {
+	allErrs := field.ErrorList{}
+	routeTableIds := sets.New[string]()
+	useSystemRouteTable := false
+	for _, zone := range zones {
+		if zone.VSwitchID == nil {
+			useSystemRouteTable = true
+			continue
+		}
+		vsw, err := actor.GetVSwitch(ctx, *zone.VSwitchID)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("validateDefaultRoute GetVSwitch %s failed: %+v", *zone.VSwitchID, err)))
+			return allErrs
+		}
+		if vsw != nil && vsw.RouteTableId != "" {
+			routeTableIds.Insert(vsw.RouteTableId)
+		}
+	}
+
+	if useSystemRouteTable {
+		vpc, err := actor.GetVpc(ctx, vpcID)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("validateDefaultRoute GetVpc %s failed: %+v", vpcID, err)))
+			return allErrs
+		}
+		if vpc == nil {
+			allErrs = append(allErrs, field.Invalid(fldPath, apisalicloud.EgressModeNone, fmt.Sprintf("VPC %s not found", vpcID)))
+			return allErrs
+		}
+		for _, id := range vpc.RouteTableIds {
+			rt, err := actor.GetRouteTable(ctx, id)
+			if err != nil {
+				allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("validateDefaultRoute GetRouteTable %s failed: %+v", id, err)))
+				return allErrs
+			}
+			if rt != nil && rt.RouteTableType == "System" {
+				routeTableIds.Insert(rt.RouteTableId)
+			}
+		}
+	}
+
+	for _, id := range sets.List(routeTableIds) {
+		entries, err := actor.ListRouteEntriesByRouteTable(ctx, id)
+		if err != nil {
+			allErrs = append(allErrs, field.InternalError(fldPath, fmt.Errorf("validateDefaultRoute ListRouteEntriesByRouteTable %s failed: %+v", id, err)))
+			return allErrs
+		}
+		if !slices.ContainsFunc(entries, func(entry *aliclient.RouteEntry) bool { return entry.DestinationCidrBlock == "0.0.0.0/0" }) {
+			allErrs = append(allErrs, field.Invalid(fldPath, apisalicloud.EgressModeNone, fmt.Sprintf("no default route (0.0.0.0/0) found in route table %s", id)))
+		}
+	}
+	return allErrs
+}
+
 func (c *configValidator) validateEIP(ctx context.Context, actor aliclient.Actor, eipId string, fldPath *field.Path) field.ErrorList {
 	allErrs := field.ErrorList{}
 	eip, err := actor.GetEIP(ctx, eipId)
@@ -256,7 +320,7 @@ func (c *configValidator) validateMultiShootVPC(vswitches []*aliclient.VSwitch,
 }
 
 // validateVSwitchCIDRConflict checks whether any of the configured zone worker CIDRs overlap with
-// vswitches already existing in the VPC that are not owned by this shoot.
+// vswitches already existing in the VPC that are not owned by this shoot. Zones using an existing vswitch are skipped.
 // Called only on create, but create may be retried after partial failure, so vswitches whose name
 // starts with "<namespace>-" (the naming convention used by this extension) are excluded to avoid
 // false positives on retry.
@@ -277,7 +341,7 @@ func (c *configValidator) validateVSwitchCIDRConflict(vswitches []*aliclient.VSw
 		if workerCIDR == "" {
 			workerCIDR = zone.Worker
 		}
-		if workerCIDR == "" {
+		if workerCIDR == "" || zone.VSwitchID != nil {
 			continue
 		}
 		fldPath := zonesPath.Index(i).Child("workers")
@@ -495,6 +495,27 @@ var _ = Describe("ConfigValidator", func() {
 		Expect(errorList).To(BeEmpty())
 	})
 
+	It("should pass when a zone uses an existing vswitch on create", func() {
+		infra.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
+		infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					ID: ptr.To(vpcID),
+				},
+				Zones: []apisalicloud.Zone{{Name: "zone_1", Workers: "192.168.1.0/24", VSwitchID: ptr.To("vsw-existing")}},
+			},
+		})
+		actor.EXPECT().GetVpc(ctx, vpcID).Return(&aliclient.VPC{}, nil)
+		actor.EXPECT().ListNatGatewaysByVPC(ctx, vpcID).Return([]*aliclient.NatGateway{{NatGatewayId: "ngw-1"}}, nil)
+		actor.EXPECT().GetNatGatewayTags(ctx, gomock.Any()).Return(map[string]aliclient.Tags{"ngw-1": {}}, nil)
+		actor.EXPECT().FindVSwitchesByVPC(ctx, vpcID).Return([]*aliclient.VSwitch{
+			{VSwitchId: "vsw-existing", Name: "landing-zone-vsw", CidrBlock: "192.168.1.0/24"},
+		}, nil)
+
+		errorList := cv.Validate(ctx, infra)
+		Expect(errorList).To(BeEmpty())
+	})
+
 	It("should pass when no foreign vswitches have overlapping CIDRs on create", func() {
 		infra.Status.LastOperation = &gardencorev1beta1.LastOperation{Type: gardencorev1beta1.LastOperationTypeCreate}
 		infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
@@ -516,6 +537,74 @@ var _ = Describe("ConfigValidator", func() {
 		Expect(errorList).To(BeEmpty())
 	})
 
+	Context("egress mode None", func() {
+		BeforeEach(func() {
+			infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
+				Networks: apisalicloud.Networks{
+					VPC: apisalicloud.VPC{
+						ID:         ptr.To(vpcID),
+						EgressMode: ptr.To(apisalicloud.EgressModeNone),
+					},
+					Zones: []apisalicloud.Zone{
+						{Name: "zone_1", Workers: "192.168.1.0/24"},
+						{Name: "zone_2", Workers: "192.168.2.0/24", VSwitchID: ptr.To("vsw-existing")},
+					},
+				},
+			})
+			actor.EXPECT().GetVpc(ctx, vpcID).Return(&aliclient.VPC{RouteTableIds: []string{"vtb-custom", "vtb-system"}}, nil).Times(2)
+			actor.EXPECT().GetVSwitch(ctx, "vsw-existing").Return(&aliclient.VSwitch{VSwitchId: "vsw-existing", RouteTableId: "vtb-custom"}, nil)
+			actor.EXPECT().GetRouteTable(ctx, "vtb-custom").Return(&aliclient.RouteTable{RouteTableId: "vtb-custom", RouteTableType: "Custom"}, nil)
+			actor.EXPECT().GetRouteTable(ctx, "vtb-system").Return(&aliclient.RouteTable{RouteTableId: "vtb-system", RouteTableType: "System"}, nil)
+			actor.EXPECT().ListRouteEntriesByRouteTable(ctx, "vtb-system").Return([]*aliclient.RouteEntry{
+				{DestinationCidrBlock: "0.0.0.0/0", NextHopType: "TransitRouter"},
+			}, nil)
+		})
+
+		It("should not require a user natgateway if the route tables have a default route", func() {
+			actor.EXPECT().ListRouteEntriesByRouteTable(ctx, "vtb-custom").Return([]*aliclient.RouteEntry{
+				{DestinationCidrBlock: "0.0.0.0/0", NextHopType: "Instance"},
+			}, nil)
+
+			errorList := cv.Validate(ctx, infra)
+			Expect(errorList).To(BeEmpty())
+		})
+
+		It("should forbid when a route table has no default route", func() {
+			actor.EXPECT().ListRouteEntriesByRouteTable(ctx, "vtb-custom").Return([]*aliclient.RouteEntry{
+				{DestinationCidrBlock: "10.0.0.0/8", NextHopType: "TransitRouter"},
+			}, nil)
+
+			errorList := cv.Validate(ctx, infra)
+			Expect(errorList).To(ConsistOfFields(Fields{
+				"Type":   Equal(field.ErrorTypeInvalid),
+				"Field":  Equal("networks.vpc.egressMode"),
+				"Detail": Equal("no default route (0.0.0.0/0) found in route table vtb-custom"),
+			}))
+		})
+	})
+
+	It("should forbid egress mode None when the VPC is gone while checking the default route", func() {
+		infra.Spec.ProviderConfig.Raw = encode(&apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{
+					ID:         ptr.To(vpcID),
+					EgressMode: ptr.To(apisalicloud.EgressModeNone),
+				},
+				Zones: []apisalicloud.Zone{{Name: "zone_1", Workers: "192.168.1.0/24"}},
+			},
+		})
+		gomock.InOrder(
+			actor.EXPECT().GetVpc(ctx, vpcID).Return(&aliclient.VPC{RouteTableIds: []string{"vtb-system"}}, nil),
+			actor.EXPECT().GetVpc(ctx, vpcID).Return(nil, nil),
+		)
+
+		errorList := cv.Validate(ctx, infra)
+		Expect(errorList).To(ConsistOfFields(Fields{
+			"Type":   Equal(field.ErrorTypeInvalid),
+			"Field":  Equal("networks.vpc.egressMode"),
+			"Detail": Equal("VPC " + vpcID + " not found"),
+		}))
+	})
 })
 
 func encode(obj runtime.Object) []byte {
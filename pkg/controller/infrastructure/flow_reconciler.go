@@ -7,10 +7,13 @@ package infrastructure
 import (
 	"context"
 	"fmt"
+	"net"
+	"slices"
 	"strings"
 
 	extensioncontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/util"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
 	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	"k8s.io/apimachinery/pkg/runtime"
@@ -134,11 +137,33 @@ func (f *FlowReconciler) reconcileWithFlow(ctx context.Context, infrastructure *
 	if err != nil {
 		return err
 	}
+	if strings.EqualFold(infrastructure.Annotations[aliapi.AnnotationKeyFlowAdoptResources], "true") {
+		if err := f.adoptResources(ctx, infrastructure, flowContext); err != nil {
+			return err
+		}
+	}
+	var networking *gardencorev1beta1.Networking
+	if cluster.Shoot != nil {
+		networking = cluster.Shoot.Spec.Networking
+	}
 	if err = flowContext.Reconcile(ctx); err != nil {
-		_ = f.updateStatusProvider(ctx, infrastructure, machineImages, flowContext.ExportState())
+		_ = f.updateStatusProvider(ctx, infrastructure, machineImages, networking, flowContext.ExportState())
 		return err
 	}
-	return f.updateStatusProvider(ctx, infrastructure, machineImages, flowContext.ExportState())
+	return f.updateStatusProvider(ctx, infrastructure, machineImages, networking, flowContext.ExportState())
+}
+
+// adoptResources rebuilds the flow state from the resources tagged for the shoot and removes the adoption annotation
+// once the resources have been adopted.
+func (f *FlowReconciler) adoptResources(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure, flowContext *infraflow.FlowContext) error {
+	f.log.Info("adopting resources into flow state")
+	if err := flowContext.AdoptResources(ctx); err != nil {
+		return fmt.Errorf("adopting resources failed: %w", err)
+	}
+
+	patch := client.MergeFrom(infrastructure.DeepCopy())
+	delete(infrastructure.Annotations, aliapi.AnnotationKeyFlowAdoptResources)
+	return f.client.Patch(ctx, infrastructure, patch)
 }
 
 func (f *FlowReconciler) migrateFlowStateFromTerraformerState(ctx context.Context, infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
@@ -148,7 +173,7 @@ func (f *FlowReconciler) migrateFlowStateFromTerraformerState(ctx context.Contex
 		return nil, err
 	}
 
-	state, err := migrateTerraformStateToFlowState(infrastructure.Status.State, infrastructureConfig.Networks.Zones)
+	state, err := MigrateTerraformStateToFlowState(infrastructure.Status.State, infrastructureConfig.Networks.Zones)
 	if err != nil {
 		return nil, fmt.Errorf("migration from terraform state failed: %w", err)
 	}
@@ -172,7 +197,7 @@ func (f *FlowReconciler) updateStatusState(ctx context.Context, infra *extension
 	return f.client.Status().Patch(ctx, infra, patch)
 }
 
-func (f *FlowReconciler) updateStatusProvider(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, machineImages []aliapi.MachineImage, flatState shared.FlatMap) error {
+func (f *FlowReconciler) updateStatusProvider(ctx context.Context, infra *extensionsv1alpha1.Infrastructure, machineImages []aliapi.MachineImage, networking *gardencorev1beta1.Networking, flatState shared.FlatMap) error {
 	infrastructureConfig, err := f.decodeInfrastructureConfig(infra)
 	if err != nil {
 		return err
@@ -194,13 +219,54 @@ func (f *FlowReconciler) updateStatusProvider(ctx context.Context, infra *extens
 
 	patch := client.MergeFrom(infra.DeepCopy())
 	infra.Status.ProviderStatus = &runtime.RawExtension{Object: infrastructureStatus}
-	egressCidrs := getEgressIpCidrs(state)
-	if egressCidrs != nil {
+	infra.Status.Networking = statusNetworking(infrastructureConfig, networking)
+	if vpc := infrastructureConfig.Networks.VPC; vpc.EgressMode != nil && *vpc.EgressMode == aliapi.EgressModeNone {
+		// without NAT gateways the egress addresses are unknown to the extension, only the configured ones are reported
+		infra.Status.EgressCIDRs = vpc.EgressCIDRs
+	} else if egressCidrs := getEgressIpCidrs(state); egressCidrs != nil {
 		infra.Status.EgressCIDRs = egressCidrs
 	}
 	return f.client.Status().Patch(ctx, infra, patch)
 }
 
+// statusNetworking returns the networking status with the worker CIDRs in secondary CIDRs of the VPC outside of
+// `networking.nodes` as additional node CIDRs, so that the nodes in these CIDRs are reachable from the control plane.
+// It returns nil if all worker CIDRs are in `networking.nodes`.
+func statusNetworking(config *aliapi.InfrastructureConfig, networking *gardencorev1beta1.Networking) *extensionsv1alpha1.InfrastructureStatusNetworking {
+	if networking == nil || networking.Nodes == nil {
+		return nil
+	}
+	_, nodesCIDR, err := net.ParseCIDR(*networking.Nodes)
+	if err != nil {
+		return nil
+	}
+
+	nodes := []string{*networking.Nodes}
+	for _, zone := range config.Networks.Zones {
+		workers := zone.Workers
+		if workers == "" {
+			workers = zone.Worker
+		}
+		ip, _, err := net.ParseCIDR(workers)
+		if err != nil || nodesCIDR.Contains(ip) || slices.Contains(nodes, workers) {
+			continue
+		}
+		nodes = append(nodes, workers)
+	}
+	if len(nodes) == 1 {
+		return nil
+	}
+
+	status := &extensionsv1alpha1.InfrastructureStatusNetworking{Nodes: nodes}
+	if networking.Pods != nil {
+		status.Pods = []string{*networking.Pods}
+	}
+	if networking.Services != nil {
+		status.Services = []string{*networking.Services}
+	}
+	return status
+}
+
 func getEgressIpCidrs(state *infraflow.PersistentState) []string {
 	if len(state.Data) == 0 {
 		return nil
@@ -217,8 +283,12 @@ func getEgressIpCidrs(state *infraflow.PersistentState) []string {
 			if len(parts) != 3 {
 				continue
 			}
-			if parts[2] == infraflow.ZoneNATGWElasticIPAddress {
-				cidrs = append(cidrs, v+"/32")
+			switch parts[2] {
+			case infraflow.ZoneNATGWElasticIPAddress, infraflow.ZoneVPCNATGWIPAddress:
+				// zones using the VPC NAT gateway share its private SNAT IP address
+				if cidr := v + "/32"; !slices.Contains(cidrs, cidr) {
+					cidrs = append(cidrs, cidr)
+				}
 			}
 		}
 	}
@@ -285,6 +355,24 @@ func computeProviderStatusFromFlowState(config *aliapi.InfrastructureConfig, sta
 		}
 	}
 
+	if fileSystemID := state.Data[infraflow.IdentifierNASFileSystem]; shared.IsValidValue(fileSystemID) {
+		status.NAS = &aliv1alpha1.NASStatus{FileSystemID: fileSystemID}
+		prefix := infraflow.ChildIdZones + shared.Separator
+		for k, v := range state.Data {
+			parts := strings.Split(k, shared.Separator)
+			if !shared.IsValidValue(v) || !strings.HasPrefix(k, prefix) || len(parts) != 3 || parts[2] != infraflow.IdentifierZoneNASMountTarget {
+				continue
+			}
+			status.NAS.MountTargets = append(status.NAS.MountTargets, aliv1alpha1.NASMountTarget{
+				Zone:   parts[1],
+				Domain: v,
+			})
+		}
+		slices.SortFunc(status.NAS.MountTargets, func(a, b aliv1alpha1.NASMountTarget) int {
+			return strings.Compare(a.Zone, b.Zone)
+		})
+	}
+
 	return status, nil
 }
 
@@ -327,7 +415,13 @@ func (f *FlowReconciler) createFlowContext(ctx context.Context, infrastructure *
 		oldFlatState = oldState.ToFlatMap()
 	}
 
-	return infraflow.NewFlowContext(f.log, shootCloudProviderCredentials, infrastructure, infrastructureConfig, oldFlatState, persistor, cluster)
+	flowContext, err := infraflow.NewFlowContext(f.log, shootCloudProviderCredentials, infrastructure, infrastructureConfig, oldFlatState, persistor, cluster)
+	if err != nil {
+		return nil, err
+	}
+	flowContext.SetEventRecorder(f.actuator.eventRecorder, infrastructure)
+	flowContext.SetGraphRecorder(flowGraphs.recorderFor(infraObjectKey))
+	return flowContext, nil
 }
 
 func (f *FlowReconciler) getFlowStateFromInfraStatus(infrastructure *extensionsv1alpha1.Infrastructure) (*infraflow.PersistentState, error) {
@@ -348,5 +442,6 @@ func (f *FlowReconciler) deleteWithFlow(ctx context.Context, infrastructure *ext
 		_ = flowContext.PersistState(ctx, true)
 		return err
 	}
+	flowGraphs.forget(client.ObjectKeyFromObject(infrastructure))
 	return flowContext.PersistState(ctx, true)
 }
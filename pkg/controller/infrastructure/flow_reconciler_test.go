@@ -0,0 +1,61 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure_test
+
+import (
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"k8s.io/utils/ptr"
+
+	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure"
+)
+
+var _ = Describe("StatusNetworking", func() {
+	var (
+		config     *aliapi.InfrastructureConfig
+		networking *gardencorev1beta1.Networking
+	)
+
+	BeforeEach(func() {
+		config = &aliapi.InfrastructureConfig{
+			Networks: aliapi.Networks{
+				VPC: aliapi.VPC{CIDR: ptr.To("10.250.0.0/16"), SecondaryCIDRs: []string{"172.16.0.0/16"}},
+				Zones: []aliapi.Zone{
+					{Name: "cn-shanghai-a", Workers: "10.250.0.0/24"},
+					{Name: "cn-shanghai-b", Workers: "10.250.1.0/24"},
+				},
+			},
+		}
+		networking = &gardencorev1beta1.Networking{
+			Nodes:    ptr.To("10.250.0.0/16"),
+			Pods:     ptr.To("100.96.0.0/11"),
+			Services: ptr.To("100.64.0.0/13"),
+		}
+	})
+
+	It("should return nil if all workers are in the nodes CIDR", func() {
+		Expect(StatusNetworking(config, networking)).To(BeNil())
+	})
+
+	It("should return nil if the nodes CIDR is not set", func() {
+		networking.Nodes = nil
+		config.Networks.Zones[1].Workers = "172.16.0.0/24"
+
+		Expect(StatusNetworking(config, networking)).To(BeNil())
+	})
+
+	It("should add the workers outside of the nodes CIDR as node CIDRs", func() {
+		config.Networks.Zones = append(config.Networks.Zones, aliapi.Zone{Name: "cn-shanghai-c", Workers: "172.16.0.0/24"})
+
+		Expect(StatusNetworking(config, networking)).To(Equal(&extensionsv1alpha1.InfrastructureStatusNetworking{
+			Nodes:    []string{"10.250.0.0/16", "172.16.0.0/24"},
+			Pods:     []string{"100.96.0.0/11"},
+			Services: []string{"100.64.0.0/13"},
+		}))
+	})
+})
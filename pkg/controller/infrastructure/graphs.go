@@ -0,0 +1,92 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infrastructure
+
+import (
+	"net/http"
+	"sync"
+
+	"k8s.io/apimachinery/pkg/types"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+const (
+	// GraphsHandlerPath is the path of the debug endpoint served by the metrics server which renders the flow graphs
+	// of an Infrastructure, e.g. `/debug/infraflow/graphs?namespace=shoot--foo--bar&name=bar&format=dot`.
+	GraphsHandlerPath = "/debug/infraflow/graphs"
+
+	graphsFormatJSON = "json"
+	graphsFormatDOT  = "dot"
+)
+
+// flowGraphs keeps the recorded flow graphs of the Infrastructures reconciled by this controller.
+var flowGraphs = newGraphRegistry()
+
+type graphRegistry struct {
+	lock      sync.Mutex
+	recorders map[types.NamespacedName]*shared.GraphRecorder
+}
+
+func newGraphRegistry() *graphRegistry {
+	return &graphRegistry{recorders: map[types.NamespacedName]*shared.GraphRecorder{}}
+}
+
+// recorderFor returns the graph recorder of the given Infrastructure, creating it if needed.
+func (r *graphRegistry) recorderFor(key types.NamespacedName) *shared.GraphRecorder {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	recorder := r.recorders[key]
+	if recorder == nil {
+		recorder = shared.NewGraphRecorder()
+		r.recorders[key] = recorder
+	}
+	return recorder
+}
+
+func (r *graphRegistry) get(key types.NamespacedName) *shared.GraphRecorder {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	return r.recorders[key]
+}
+
+func (r *graphRegistry) forget(key types.NamespacedName) {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+	delete(r.recorders, key)
+}
+
+// ServeHTTP renders the recorded flow graphs of the Infrastructure given by the `namespace` and `name` query parameters
+// as JSON (default) or DOT (`format=dot`).
+func (r *graphRegistry) ServeHTTP(w http.ResponseWriter, req *http.Request) {
+	query := req.URL.Query()
+	key := types.NamespacedName{Namespace: query.Get("namespace"), Name: query.Get("name")}
+	if key.Namespace == "" || key.Name == "" {
+		http.Error(w, "query parameters 'namespace' and 'name' are required", http.StatusBadRequest)
+		return
+	}
+	recorder := r.get(key)
+	if recorder == nil {
+		http.Error(w, "no flow graphs recorded for infrastructure "+key.String(), http.StatusNotFound)
+		return
+	}
+
+	switch format := query.Get("format"); format {
+	case graphsFormatDOT:
+		w.Header().Set("Content-Type", "text/vnd.graphviz; charset=utf-8")
+		_, _ = w.Write([]byte(recorder.ToDOT()))
+	case "", graphsFormatJSON:
+		data, err := recorder.ToJSON()
+		if err != nil {
+			http.Error(w, err.Error(), http.StatusInternalServerError)
+			return
+		}
+		w.Header().Set("Content-Type", "application/json")
+		_, _ = w.Write(data)
+	default:
+		http.Error(w, "unsupported format "+format, http.StatusBadRequest)
+	}
+}
@@ -0,0 +1,277 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+// adoption collects the IDs of the resources found by the tags of the shoot.
+type adoption struct {
+	state     FlatMap
+	ambiguous []string
+}
+
+// add records the ID of the only matching resource under the given key. If more than one resource matches, the
+// match is reported as ambiguous instead.
+func (a *adoption) add(key, kind string, ids []string) {
+	switch len(ids) {
+	case 0:
+	case 1:
+		a.state[key] = ids[0]
+	default:
+		a.ambiguous = append(a.ambiguous, fmt.Sprintf("%s (%s)", kind, strings.Join(ids, ", ")))
+	}
+}
+
+func findIDsByTags[T any](ctx context.Context, tags aliclient.Tags, finder func(ctx context.Context, tags aliclient.Tags) ([]*T, error), idOf func(item *T) string) ([]string, error) {
+	found, err := finder(ctx, tags)
+	if err != nil {
+		return nil, err
+	}
+	var ids []string
+	for _, item := range found {
+		ids = append(ids, idOf(item))
+	}
+	return ids, nil
+}
+
+func zoneKey(zoneName, key string) string {
+	return ChildIdZones + Separator + zoneName + Separator + key
+}
+
+// AdoptResources rebuilds the state from the resources found by the tags of the shoot, e.g. after a restore from
+// backup or if the state has been lost. Resources found are recorded in the state, replacing the recorded IDs.
+// If any resource is matched ambiguously, the state is left unchanged and an error listing the matches is returned,
+// so that the following reconciliation neither picks an arbitrary one nor creates yet another duplicate.
+func (c *FlowContext) AdoptResources(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	log.Info("adopting existing resources by tags")
+
+	a := &adoption{state: FlatMap{}}
+	if c.config.Networks.VPC.ID == nil {
+		ids, err := findIDsByTags(ctx, c.commonTags, c.actor.FindVpcsByTags, func(item *aliclient.VPC) string { return item.VpcId })
+		if err != nil {
+			return err
+		}
+		a.add(IdentifierVPC, "VPC", ids)
+	}
+
+	ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("sg"), c.actor.FindSecurityGroupsByTags, func(item *aliclient.SecurityGroup) string { return item.SecurityGroupId })
+	if err != nil {
+		return err
+	}
+	a.add(IdentifierNodesSecurityGroup, "security group", ids)
+
+	if c.config.Networks.VPC.ID == nil || (c.config.Networks.VPC.GardenerManagedNATGateway != nil && *c.config.Networks.VPC.GardenerManagedNATGateway) {
+		ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("natgw"), c.actor.FindNatGatewayByTags, func(item *aliclient.NatGateway) string { return item.NatGatewayId })
+		if err != nil {
+			return err
+		}
+		a.add(IdentifierNatGateway, "NAT gateway", ids)
+	}
+
+	if c.retainEIPsEnabled() {
+		ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix(eipPoolSuffix), c.actor.FindEIPsByTags, func(item *aliclient.EIP) string { return item.EipId })
+		if err != nil {
+			return err
+		}
+		for _, id := range ids {
+			a.state[ChildIdEIPPool+Separator+id] = id
+		}
+	}
+
+	if c.hasVPCNatGatewayZones() {
+		ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("vpc-natgw"), c.actor.FindNatGatewayByTags, func(item *aliclient.NatGateway) string { return item.NatGatewayId })
+		if err != nil {
+			return err
+		}
+		a.add(IdentifierVPCNatGateway, "VPC NAT gateway", ids)
+	}
+
+	if c.dualStackEnabled() {
+		ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("ipv6gw"), c.actor.FindIpv6GatewaysByTags, func(item *aliclient.IPv6Gateway) string { return item.Ipv6GatewayId })
+		if err != nil {
+			return err
+		}
+		a.add(IdentifierIPV6Gateway, "IPv6 gateway", ids)
+	}
+
+	if c.useCustomRouteTable() {
+		ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("rt"), c.actor.FindRouteTablesByTags, func(item *aliclient.RouteTable) string { return item.RouteTableId })
+		if err != nil {
+			return err
+		}
+		a.add(IdentifierRouteTable, "route table", ids)
+	}
+
+	if err := c.adoptZones(ctx, a); err != nil {
+		return err
+	}
+
+	if c.config.NAS != nil {
+		if err := c.adoptNAS(ctx, a); err != nil {
+			return err
+		}
+	}
+
+	if c.dualStackEnabled() {
+		if err := c.adoptIPv6InternetBandwidth(ctx, a); err != nil {
+			return err
+		}
+	}
+
+	if len(a.ambiguous) > 0 {
+		return fmt.Errorf("cannot adopt resources, found more than one resource tagged for the shoot: %s", strings.Join(a.ambiguous, "; "))
+	}
+	c.state.ImportFromFlatMap(a.state)
+	log.Info("adopted existing resources", "count", len(a.state))
+	return c.PersistState(ctx, true)
+}
+
+// adoptZones matches the vswitches tagged for the shoot to the zones by zone and CIDR. The suffix of a zone is
+// restored from the name tag of its vswitch, which is needed to find the NAT gateway EIP of the zone.
+func (c *FlowContext) adoptZones(ctx context.Context, a *adoption) error {
+	vswitches, err := c.actor.FindVSwitchesByTags(ctx, c.clusterTags())
+	if err != nil {
+		return err
+	}
+
+	nodesPrefix := c.namespace + "-nodes-"
+	for _, zone := range c.config.Networks.Zones {
+		if zone.VSwitchID != nil {
+			a.state[zoneKey(zone.Name, IdentifierZoneVSwitch)] = *zone.VSwitchID
+			a.state[zoneKey(zone.Name, MarkerZoneVSwitchNotOwned)] = "true"
+			continue
+		}
+		cidrBlock := zone.Workers
+		if cidrBlock == "" {
+			cidrBlock = zone.Worker
+		}
+		var (
+			ids    []string
+			suffix string
+		)
+		for _, vsw := range vswitches {
+			if vsw.ZoneId == zone.Name && vsw.CidrBlock == cidrBlock {
+				ids = append(ids, vsw.VSwitchId)
+				if s, ok := strings.CutPrefix(vsw.Tags[TagKeyName], nodesPrefix); ok {
+					suffix = s
+				}
+			}
+		}
+		a.add(zoneKey(zone.Name, IdentifierZoneVSwitch), "vswitch of zone "+zone.Name, ids)
+		if len(ids) != 1 || suffix == "" {
+			continue
+		}
+		a.state[zoneKey(zone.Name, IdentifierZoneSuffix)] = suffix
+
+		if c.config.FlowLogs != nil {
+			ids, err := findIDsByTags(ctx, c.commonTagsWithSuffix("flowlog-"+suffix), c.actor.FindFlowLogsByTags, func(item *aliclient.FlowLog) string { return item.FlowLogId })
+			if err != nil {
+				return err
+			}
+			a.add(zoneKey(zone.Name, IdentifierZoneFlowLog), "flow log of zone "+zone.Name, ids)
+		}
+
+		if c.useVPCNatGateway(zone.Name) || (zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil) {
+			continue
+		}
+		eips, err := c.actor.FindEIPsByTags(ctx, c.commonTagsWithSuffix("eip-natgw-"+suffix))
+		if err != nil {
+			return err
+		}
+		var eipIds []string
+		for _, eip := range eips {
+			eipIds = append(eipIds, eip.EipId)
+		}
+		a.add(zoneKey(zone.Name, IdentifierZoneNATGWElasticIP), "NAT gateway EIP of zone "+zone.Name, eipIds)
+		if len(eips) == 1 {
+			a.state[zoneKey(zone.Name, ZoneNATGWElasticIPAddress)] = eips[0].IpAddress
+		}
+	}
+	return nil
+}
+
+// adoptNAS adopts the NAS file system of the shoot and matches its mount targets to the zones by the adopted vswitches.
+func (c *FlowContext) adoptNAS(ctx context.Context, a *adoption) error {
+	ids, err := findIDsByTags(ctx, c.nasTags(), c.actor.FindNASFileSystemsByTags, func(item *aliclient.NASFileSystem) string { return item.FileSystemId })
+	if err != nil {
+		return err
+	}
+	a.add(IdentifierNASFileSystem, "NAS file system", ids)
+	if len(ids) != 1 {
+		return nil
+	}
+
+	mountTargets, err := c.actor.ListNASMountTargets(ctx, ids[0])
+	if err != nil {
+		return err
+	}
+	for _, zone := range c.config.Networks.Zones {
+		vswitchId, ok := a.state[zoneKey(zone.Name, IdentifierZoneVSwitch)]
+		if !ok {
+			continue
+		}
+		for _, mountTarget := range mountTargets {
+			if mountTarget.VSwitchId == vswitchId {
+				a.state[zoneKey(zone.Name, IdentifierZoneNASMountTarget)] = mountTarget.MountTargetDomain
+				break
+			}
+		}
+	}
+	return nil
+}
+
+// adoptIPv6InternetBandwidth records the IPv6 gateway on which the IPv6 internet bandwidth of the nodes has been
+// opened, if egress-only rules of the shoot or node addresses with bandwidth are found in the adopted vswitches.
+// Otherwise, the bandwidth would not be released if disabled and the rules would be left behind on deletion.
+func (c *FlowContext) adoptIPv6InternetBandwidth(ctx context.Context, a *adoption) error {
+	ipv6GatewayId := a.state[IdentifierIPV6Gateway]
+	if ipv6GatewayId == "" && c.config.Networks.VPC.ID != nil {
+		// the IPv6 gateway of a user-provided VPC is not tagged for the shoot
+		gw, err := c.actor.FindIpv6GatewayByVPC(ctx, *c.config.Networks.VPC.ID)
+		if err != nil {
+			return err
+		}
+		if gw != nil {
+			ipv6GatewayId = gw.Ipv6GatewayId
+		}
+	}
+	if ipv6GatewayId == "" {
+		return nil
+	}
+
+	rules, err := c.listIPv6EgressOnlyRules(ctx, ipv6GatewayId)
+	if err != nil {
+		return err
+	}
+	opened := len(rules) > 0
+	for _, zone := range c.config.Networks.Zones {
+		vswitchId, ok := a.state[zoneKey(zone.Name, IdentifierZoneVSwitch)]
+		if opened || !ok {
+			continue
+		}
+		addresses, err := c.actor.ListIpv6AddressesByVSwitch(ctx, vswitchId)
+		if err != nil {
+			return err
+		}
+		for _, address := range addresses {
+			if nodeIPv6AddressInstanceTypes.Has(address.AssociatedInstanceType) && address.InternetBandwidthId != "" {
+				opened = true
+				break
+			}
+		}
+	}
+	if opened {
+		a.state[IdentifierIPv6InternetBandwidth] = ipv6GatewayId
+	}
+	return nil
+}
@@ -0,0 +1,207 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"github.com/onsi/gomega/types"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("AdoptResources", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+
+		vpcs []*aliclient.VPC
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19"}},
+			},
+		}
+		vpcs = []*aliclient.VPC{{VpcId: "vpc-1"}}
+	})
+
+	expectFinders := func() {
+		actor.EXPECT().FindVpcsByTags(ctx, gomock.Any()).Return(vpcs, nil)
+		actor.EXPECT().FindSecurityGroupsByTags(ctx, gomock.Any()).Return([]*aliclient.SecurityGroup{{SecurityGroupId: "sg-1"}}, nil)
+		actor.EXPECT().FindNatGatewayByTags(ctx, gomock.Any()).Return([]*aliclient.NatGateway{{NatGatewayId: "ngw-1"}}, nil)
+		actor.EXPECT().FindVSwitchesByTags(ctx, gomock.Any()).Return([]*aliclient.VSwitch{
+			{VSwitchId: "vsw-1", ZoneId: "cn-beijing-a", CidrBlock: "10.0.0.0/19", Tags: aliclient.Tags{TagKeyName: namespace + "-nodes-z0"}},
+			{VSwitchId: "vsw-other", ZoneId: "cn-beijing-b", CidrBlock: "10.0.32.0/19"},
+		}, nil)
+		actor.EXPECT().FindEIPsByTags(ctx, aliclient.Tags{
+			"kubernetes.io/cluster/" + namespace: TagValueCluster,
+			TagKeyName:                           namespace + "-eip-natgw-z0",
+		}).Return([]*aliclient.EIP{{EipId: "eip-1", IpAddress: "1.2.3.4"}}, nil)
+	}
+
+	adopt := func() (shared.FlatMap, error) {
+		flowContext := NewFlowContextWithActor(log.Log, actor, infra, config, nil, nil, nil)
+		err := flowContext.AdoptResources(ctx)
+		return flowContext.ExportState(), err
+	}
+
+	DescribeTable("should adopt the resources matched by their tags",
+		func(vpcIds []string, matchState types.GomegaMatcher, matchErr types.GomegaMatcher) {
+			vpcs = nil
+			for _, id := range vpcIds {
+				vpcs = append(vpcs, &aliclient.VPC{VpcId: id})
+			}
+			expectFinders()
+
+			state, err := adopt()
+			Expect(err).To(matchErr)
+			Expect(state).To(matchState)
+		},
+		Entry("without a matching VPC", nil,
+			And(Not(HaveKey(IdentifierVPC)), HaveKeyWithValue(IdentifierNodesSecurityGroup, "sg-1")), Not(HaveOccurred())),
+		Entry("with one matching VPC", []string{"vpc-1"},
+			HaveKeyWithValue(IdentifierVPC, "vpc-1"), Not(HaveOccurred())),
+		Entry("with more than one matching VPC", []string{"vpc-1", "vpc-2"},
+			BeEmpty(), MatchError(ContainSubstring("VPC (vpc-1, vpc-2)"))),
+	)
+
+	It("should rebuild the flow state of the zones", func() {
+		expectFinders()
+
+		Expect(adopt()).To(Equal(shared.FlatMap{
+			IdentifierVPC:                                        "vpc-1",
+			IdentifierNodesSecurityGroup:                         "sg-1",
+			IdentifierNatGateway:                                 "ngw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch:        "vsw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneSuffix:         "z0",
+			"Zones/cn-beijing-a/" + IdentifierZoneNATGWElasticIP: "eip-1",
+			"Zones/cn-beijing-a/" + ZoneNATGWElasticIPAddress:    "1.2.3.4",
+		}))
+	})
+
+	It("should report all ambiguous matches", func() {
+		vpcs = []*aliclient.VPC{{VpcId: "vpc-1"}, {VpcId: "vpc-2"}}
+		actor.EXPECT().FindVpcsByTags(ctx, gomock.Any()).Return(vpcs, nil)
+		actor.EXPECT().FindSecurityGroupsByTags(ctx, gomock.Any()).Return([]*aliclient.SecurityGroup{{SecurityGroupId: "sg-1"}, {SecurityGroupId: "sg-2"}}, nil)
+		actor.EXPECT().FindNatGatewayByTags(ctx, gomock.Any()).Return(nil, nil)
+		actor.EXPECT().FindVSwitchesByTags(ctx, gomock.Any()).Return(nil, nil)
+
+		_, err := adopt()
+		Expect(err).To(MatchError(And(ContainSubstring("VPC (vpc-1, vpc-2)"), ContainSubstring("security group (sg-1, sg-2)"))))
+	})
+
+	Context("with dual-stack", func() {
+		var ipv6EgressOnlyRules []*aliclient.IPv6EgressOnlyRule
+
+		BeforeEach(func() {
+			config.DualStack = &apisalicloud.DualStack{Enabled: true}
+			ipv6EgressOnlyRules = []*aliclient.IPv6EgressOnlyRule{
+				{Name: "other", Ipv6EgressOnlyRuleId: "rule-other", InstanceType: "Ipv6Address", InstanceId: "ipv6-other"},
+			}
+		})
+
+		expectIPv6Finders := func() {
+			expectFinders()
+			actor.EXPECT().FindIpv6GatewaysByTags(ctx, gomock.Any()).Return([]*aliclient.IPv6Gateway{{Ipv6GatewayId: "ipv6gw-1"}}, nil)
+			actor.EXPECT().ListIpv6EgressOnlyRules(ctx, "ipv6gw-1").Return(ipv6EgressOnlyRules, nil)
+		}
+
+		It("should adopt the IPv6 internet bandwidth by the egress-only rules of the shoot", func() {
+			ipv6EgressOnlyRules = append(ipv6EgressOnlyRules, &aliclient.IPv6EgressOnlyRule{
+				Name: namespace + "-egress-only", Ipv6EgressOnlyRuleId: "rule-1", InstanceType: "Ipv6Address", InstanceId: "ipv6-1",
+			})
+			expectIPv6Finders()
+
+			state, err := adopt()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(HaveKeyWithValue(IdentifierIPV6Gateway, "ipv6gw-1"))
+			Expect(state).To(HaveKeyWithValue(IdentifierIPv6InternetBandwidth, "ipv6gw-1"))
+		})
+
+		It("should adopt the IPv6 internet bandwidth by the node addresses with bandwidth", func() {
+			expectIPv6Finders()
+			actor.EXPECT().ListIpv6AddressesByVSwitch(ctx, "vsw-1").Return([]*aliclient.IPv6Address{
+				{Ipv6AddressId: "ipv6-lb", AssociatedInstanceType: "SlbInstance", InternetBandwidthId: "bw-lb"},
+				{Ipv6AddressId: "ipv6-1", AssociatedInstanceType: "EcsInstance", InternetBandwidthId: "bw-1"},
+			}, nil)
+
+			state, err := adopt()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(HaveKeyWithValue(IdentifierIPv6InternetBandwidth, "ipv6gw-1"))
+		})
+
+		It("should not adopt the IPv6 internet bandwidth if it has not been opened", func() {
+			expectIPv6Finders()
+			actor.EXPECT().ListIpv6AddressesByVSwitch(ctx, "vsw-1").Return([]*aliclient.IPv6Address{
+				{Ipv6AddressId: "ipv6-1", AssociatedInstanceType: "EcsInstance"},
+			}, nil)
+
+			state, err := adopt()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(HaveKeyWithValue(IdentifierIPV6Gateway, "ipv6gw-1"))
+			Expect(state).NotTo(HaveKey(IdentifierIPv6InternetBandwidth))
+		})
+	})
+
+	Context("with NAS", func() {
+		BeforeEach(func() {
+			config.NAS = &apisalicloud.NAS{}
+		})
+
+		It("should adopt the NAS file system and its mount targets", func() {
+			expectFinders()
+			actor.EXPECT().FindNASFileSystemsByTags(ctx, aliclient.Tags{
+				"kubernetes.io/cluster/" + namespace: TagValueCluster,
+				TagKeyName:                           namespace + "-nas",
+			}).Return([]*aliclient.NASFileSystem{{FileSystemId: "nas-1"}}, nil)
+			actor.EXPECT().ListNASMountTargets(ctx, "nas-1").Return([]*aliclient.NASMountTarget{
+				{FileSystemId: "nas-1", VSwitchId: "vsw-other", MountTargetDomain: "other.nas.aliyuncs.com"},
+				{FileSystemId: "nas-1", VSwitchId: "vsw-1", MountTargetDomain: "mt-1.nas.aliyuncs.com"},
+			}, nil)
+
+			state, err := adopt()
+			Expect(err).NotTo(HaveOccurred())
+			Expect(state).To(HaveKeyWithValue(IdentifierNASFileSystem, "nas-1"))
+			Expect(state).To(HaveKeyWithValue("Zones/cn-beijing-a/"+IdentifierZoneNASMountTarget, "mt-1.nas.aliyuncs.com"))
+		})
+
+		It("should not adopt ambiguous NAS file systems", func() {
+			expectFinders()
+			actor.EXPECT().FindNASFileSystemsByTags(ctx, gomock.Any()).Return([]*aliclient.NASFileSystem{{FileSystemId: "nas-1"}, {FileSystemId: "nas-2"}}, nil)
+
+			state, err := adopt()
+			Expect(err).To(MatchError(ContainSubstring("NAS file system (nas-1, nas-2)")))
+			Expect(state).To(BeEmpty())
+		})
+	})
+})
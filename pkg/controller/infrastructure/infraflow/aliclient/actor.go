@@ -8,12 +8,14 @@ import (
 	"context"
 	"fmt"
 	"reflect"
+	"slices"
 	"strconv"
 	"time"
 
 	alierrors "github.com/aliyun/alibaba-cloud-sdk-go/sdk/errors"
 	"github.com/aliyun/alibaba-cloud-sdk-go/sdk/requests"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/ecs"
+	"github.com/aliyun/alibaba-cloud-sdk-go/services/nas"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/nlb"
 	"github.com/aliyun/alibaba-cloud-sdk-go/services/vpc"
 	"github.com/go-logr/logr"
@@ -21,6 +23,7 @@ import (
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
 	alicloudclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud/client/sls"
 )
 
 // Actor ia a interface to package alicloud api call
@@ -65,6 +68,10 @@ type Actor interface {
 	FindSNatEntriesByNatGateway(ctx context.Context, ngwId string) ([]*SNATEntry, error)
 	DeleteSNatEntry(ctx context.Context, id, snatTableId string) error
 
+	CreateNatIp(ctx context.Context, natIp *NatIP) (*NatIP, error)
+	ListNatIps(ctx context.Context, ngwId string) ([]*NatIP, error)
+	DeleteNatIp(ctx context.Context, id, ngwId string) error
+
 	CreateTags(ctx context.Context, resources []string, tags Tags, resourceType string) error
 	DeleteTags(ctx context.Context, resources []string, tags Tags, resourceType string) error
 
@@ -92,6 +99,10 @@ type Actor interface {
 
 	EnableVpcIpv6(ctx context.Context, vpcId string) error
 	GetVpcIpv6Info(ctx context.Context, vpcId string) (string, error)
+	// AssociateVpcCidrBlock associates a secondary IPv4 CIDR block with the VPC and waits until it is available.
+	AssociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) error
+	// UnassociateVpcCidrBlock removes a secondary IPv4 CIDR block from the VPC.
+	UnassociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) error
 
 	CreateIpv6Gateway(ctx context.Context, gw *IPv6Gateway) (*IPv6Gateway, error)
 	GetIpv6Gateway(ctx context.Context, id string) (*IPv6Gateway, error)
@@ -99,21 +110,67 @@ type Actor interface {
 	FindIpv6GatewaysByTags(ctx context.Context, tags Tags) ([]*IPv6Gateway, error)
 	DeleteIpv6Gateway(ctx context.Context, id string) error
 
+	CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error)
+	GetFlowLog(ctx context.Context, id string) (*FlowLog, error)
+	FindFlowLogsByTags(ctx context.Context, tags Tags) ([]*FlowLog, error)
+	DeleteFlowLog(ctx context.Context, id string) error
+
+	// ListIpv6AddressesByVSwitch returns the IPv6 addresses in the given vswitch.
+	ListIpv6AddressesByVSwitch(ctx context.Context, vSwitchId string) ([]*IPv6Address, error)
+	// AllocateIpv6InternetBandwidth opens internet bandwidth for an IPv6 address on the IPv6 gateway.
+	AllocateIpv6InternetBandwidth(ctx context.Context, ipv6GatewayId, ipv6AddressId string, bandwidth int, internetChargeType string) error
+	// ModifyIpv6InternetBandwidth changes the internet bandwidth of an IPv6 address.
+	ModifyIpv6InternetBandwidth(ctx context.Context, ipv6AddressId, internetBandwidthId string, bandwidth int) error
+	// DeleteIpv6InternetBandwidth releases the internet bandwidth of an IPv6 address.
+	DeleteIpv6InternetBandwidth(ctx context.Context, ipv6AddressId, internetBandwidthId string) error
+	ListIpv6EgressOnlyRules(ctx context.Context, ipv6GatewayId string) ([]*IPv6EgressOnlyRule, error)
+	CreateIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId string, rule *IPv6EgressOnlyRule) (*IPv6EgressOnlyRule, error)
+	DeleteIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId, id string) error
+
 	SetVSwitchIpv6CidrBlock(ctx context.Context, vSwitchId string, ipv6CidrBlock int) error
 	GetVSwitchIpv6CidrBlock(ctx context.Context, vSwitchId string) (string, error)
 
+	// SetNatGatewayDeletionProtection enables or disables deletion protection on a NAT gateway.
+	SetNatGatewayDeletionProtection(ctx context.Context, id string, enable bool) error
+	// SetEIPDeletionProtection enables or disables deletion protection on an EIP.
+	SetEIPDeletionProtection(ctx context.Context, id string, enable bool) error
+
 	// FindNLBsByTags returns NLB instances matching the given tags.
 	FindNLBsByTags(ctx context.Context, tags Tags) ([]*NLBInfo, error)
 	// SetNLBDeletionProtection enables or disables deletion protection on an NLB instance.
 	SetNLBDeletionProtection(ctx context.Context, loadBalancerID string, enable bool) error
 	// DeleteNLB deletes the NLB instance with the given ID and waits until it is gone.
 	DeleteNLB(ctx context.Context, loadBalancerID string) error
+
+	// CreateNASFileSystem creates and tags a NAS file system and waits until it is running.
+	CreateNASFileSystem(ctx context.Context, fileSystem *NASFileSystem) (*NASFileSystem, error)
+	GetNASFileSystem(ctx context.Context, id string) (*NASFileSystem, error)
+	FindNASFileSystemsByTags(ctx context.Context, tags Tags) ([]*NASFileSystem, error)
+	// DeleteNASFileSystem deletes the NAS file system with the given ID and waits until it is gone.
+	DeleteNASFileSystem(ctx context.Context, id string) error
+	// ListNASMountTargets returns the mount targets of the NAS file system with the given ID.
+	ListNASMountTargets(ctx context.Context, fileSystemId string) ([]*NASMountTarget, error)
+	// CreateNASMountTarget creates a mount target of a NAS file system and waits until it is active.
+	CreateNASMountTarget(ctx context.Context, mountTarget *NASMountTarget) (*NASMountTarget, error)
+	// DeleteNASMountTarget deletes a mount target of a NAS file system and waits until it is gone.
+	DeleteNASMountTarget(ctx context.Context, fileSystemId, mountTargetDomain string) error
+
+	// CreateSLSProject creates and tags an SLS project and waits until it can be found.
+	CreateSLSProject(ctx context.Context, project *SLSProject) (*SLSProject, error)
+	GetSLSProject(ctx context.Context, name string) (*SLSProject, error)
+	// DeleteSLSProject deletes the SLS project with the given name together with its logstores.
+	DeleteSLSProject(ctx context.Context, name string) error
+	CreateSLSLogstore(ctx context.Context, logstore *SLSLogstore) (*SLSLogstore, error)
+	GetSLSLogstore(ctx context.Context, projectName, name string) (*SLSLogstore, error)
+	DeleteSLSLogstore(ctx context.Context, projectName, name string) error
 }
 
 type actor struct {
 	vpcClient    alicloudclient.VPC
 	ecsClient    alicloudclient.ECS
 	nlbClient    alicloudclient.NLB
+	nasClient    alicloudclient.NAS
+	slsClient    alicloudclient.SLS
 	Logger       logr.Logger
 	PollInterval time.Duration
 }
@@ -135,10 +192,20 @@ func NewActor(accessKeyID, secretAccessKey, region string) (Actor, error) {
 	if err != nil {
 		return nil, err
 	}
+	nasClient, err := clientFactory.NewNASClient(region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, err
+	}
+	slsClient, err := clientFactory.NewSLSClient(region, accessKeyID, secretAccessKey)
+	if err != nil {
+		return nil, err
+	}
 	return &actor{
 		vpcClient:    vpcClient,
 		ecsClient:    ecsClient,
 		nlbClient:    nlbClient,
+		nasClient:    nasClient,
+		slsClient:    slsClient,
 		Logger:       log.Log.WithName("alicloud-client"),
 		PollInterval: 5 * time.Second,
 	}, nil
@@ -533,6 +600,77 @@ func (c *actor) DeleteSNatEntry(ctx context.Context, id, snatTableId string) err
 	return nil
 }
 
+func (c *actor) CreateNatIp(ctx context.Context, natIp *NatIP) (*NatIP, error) {
+	req := vpc.CreateCreateNatIpRequest()
+	req.NatGatewayId = natIp.NatGatewayId
+	req.NatIp = natIp.IpAddress
+	req.NatIpName = natIp.Name
+
+	resp, err := callApi(c.vpcClient.CreateNatIp, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *NatIP
+	err = wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+		created, err = c.getNatIp(resp.NatIpId, natIp.NatGatewayId)
+		if err != nil {
+			return false, err
+		}
+		if created == nil {
+			return false, nil
+		}
+		if *created.Status != "Available" {
+			return false, nil
+		}
+		return true, nil
+	})
+
+	if err != nil {
+		return nil, err
+	}
+
+	return created, nil
+}
+
+func (c *actor) getNatIp(id, ngwId string) (*NatIP, error) {
+	req := vpc.CreateListNatIpsRequest()
+	req.NatGatewayId = ngwId
+	req.NatIpIds = &[]string{id}
+	resp, err := c.describeNatIp(req)
+
+	return single(resp, err)
+}
+
+func (c *actor) ListNatIps(_ context.Context, ngwId string) ([]*NatIP, error) {
+	req := vpc.CreateListNatIpsRequest()
+	req.NatGatewayId = ngwId
+	return c.describeNatIp(req)
+}
+
+func (c *actor) DeleteNatIp(ctx context.Context, id, ngwId string) error {
+	current, err := c.getNatIp(id, ngwId)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := vpc.CreateDeleteNatIpRequest()
+	req.NatIpId = id
+	_, err = callApi(c.vpcClient.DeleteNatIp, req)
+	if err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, 5*time.Second, false, func(_ context.Context) (bool, error) {
+		current, err := c.getNatIp(id, ngwId)
+		if err != nil {
+			return false, err
+		}
+		return current == nil, nil
+	})
+}
+
 func (c *actor) ModifyEIP(_ context.Context, id string, eip *EIP) error {
 	req := vpc.CreateModifyEipAddressAttributeRequest()
 	req.AllocationId = id
@@ -726,6 +864,9 @@ func (c *actor) CreateNatGateway(ctx context.Context, ngw *NatGateway) (*NatGate
 	req.VpcId = *ngw.VpcId
 	req.VSwitchId = ngw.AvailableVSwitches[0]
 	req.NatType = "Enhanced"
+	if ngw.NetworkType != "" {
+		req.NetworkType = ngw.NetworkType
+	}
 	resp, err := callApi(c.vpcClient.CreateNatGateway, req)
 	if err != nil {
 		return nil, err
@@ -1214,6 +1355,22 @@ func (c *actor) describeSNATEntry(req *vpc.DescribeSnatTableEntriesRequest) ([]*
 	return entryList, nil
 }
 
+func (c *actor) describeNatIp(req *vpc.ListNatIpsRequest) ([]*NatIP, error) {
+	var natIpList []*NatIP
+
+	respList, err := page_call(c.vpcClient.ListNatIps, req)
+	if err != nil {
+		return nil, err
+	}
+	for _, resp := range respList {
+		for _, item := range resp.NatIps {
+			natIpList = append(natIpList, c.fromNatIp(item))
+		}
+	}
+
+	return natIpList, nil
+}
+
 func (c *actor) describeEIP(req *vpc.DescribeEipAddressesRequest) ([]*EIP, error) {
 	var eipList []*EIP
 
@@ -1339,6 +1496,7 @@ func (c *actor) fromVSwitch(item vpc.VSwitch) (*VSwitch, error) {
 		Status:        &item.Status,
 		VSwitchId:     item.VSwitchId,
 		Ipv6CidrBlock: item.Ipv6CidrBlock,
+		RouteTableId:  item.RouteTable.RouteTableId,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1350,11 +1508,14 @@ func (c *actor) fromVSwitch(item vpc.VSwitch) (*VSwitch, error) {
 
 func (c *actor) fromNatGateway(item vpc.NatGateway) (*NatGateway, error) {
 	ngw := &NatGateway{
-		Name:         item.Name,
-		NatGatewayId: item.NatGatewayId,
-		VpcId:        &item.VpcId,
-		Status:       &item.Status,
-		VswitchId:    &item.NatGatewayPrivateInfo.VswitchId,
+		Name:               item.Name,
+		NatGatewayId:       item.NatGatewayId,
+		VpcId:              &item.VpcId,
+		Status:             &item.Status,
+		VswitchId:          &item.NatGatewayPrivateInfo.VswitchId,
+		DeletionProtection: item.DeletionProtection,
+		NetworkType:        item.NetworkType,
+		PrivateIpAddress:   item.NatGatewayPrivateInfo.PrivateIpAddress,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1382,6 +1543,17 @@ func (c *actor) fromSNATEntry(item vpc.SnatTableEntry) (*SNATEntry, error) {
 	return entry, nil
 }
 
+func (c *actor) fromNatIp(item vpc.NatIp) *NatIP {
+	return &NatIP{
+		Name:         item.NatIpName,
+		NatGatewayId: item.NatGatewayId,
+		NatIpId:      item.NatIpId,
+		IpAddress:    item.NatIp,
+		IsDefault:    item.IsDefault,
+		Status:       &item.NatIpStatus,
+	}
+}
+
 func (c *actor) fromEip(item vpc.EipAddress) (*EIP, error) {
 	eip := &EIP{
 		Name:               item.Name,
@@ -1392,6 +1564,7 @@ func (c *actor) fromEip(item vpc.EipAddress) (*EIP, error) {
 		InstanceType:       &item.InstanceType,
 		InstanceId:         &item.InstanceId,
 		IpAddress:          item.IpAddress,
+		DeletionProtection: item.DeletionProtection,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1409,6 +1582,12 @@ func (c *actor) fromVpc(item vpc.Vpc) (*VPC, error) {
 		Status:        &item.Status,
 		Ipv6CidrBlock: item.Ipv6CidrBlock,
 	}
+	if len(item.SecondaryCidrBlocks.SecondaryCidrBlock) > 0 {
+		v.SecondaryCidrBlocks = append([]string{}, item.SecondaryCidrBlocks.SecondaryCidrBlock...)
+	}
+	if len(item.RouterTableIds.RouterTableIds) > 0 {
+		v.RouteTableIds = append([]string{}, item.RouterTableIds.RouterTableIds...)
+	}
 
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1469,11 +1648,16 @@ func page_call[REQ any, RESP any](call func(req *REQ) (*RESP, error), req *REQ)
 		"DescribeSnatTableEntriesRequest",
 		"DescribeRouteTableListRequest",
 		"DescribeIpv6GatewaysRequest",
+		"DescribeIpv6AddressesRequest",
+		"DescribeIpv6EgressOnlyRulesRequest",
+		"DescribeFileSystemsRequest",
+		"DescribeMountTargetsRequest",
 	}
 	type2_req_type_name_list := []string{
 		"ListTagResourcesRequest",
 		"DescribeSecurityGroupsRequest",
 		"DescribeRouteEntryListRequest",
+		"ListNatIpsRequest",
 	}
 
 	reqTypeName := reflect.ValueOf(req).Elem().Type().Name()
@@ -1804,11 +1988,12 @@ func (c *actor) describeRouteTableList(req *vpc.DescribeRouteTableListRequest) (
 func (c *actor) fromRouteTable(item vpc.RouterTableListType) *RouteTable {
 	status := item.Status
 	rt := &RouteTable{
-		Name:         item.RouteTableName,
-		RouteTableId: item.RouteTableId,
-		VpcId:        item.VpcId,
-		Status:       &status,
-		VSwitchIds:   item.VSwitchIds.VSwitchId,
+		Name:           item.RouteTableName,
+		RouteTableId:   item.RouteTableId,
+		VpcId:          item.VpcId,
+		Status:         &status,
+		VSwitchIds:     item.VSwitchIds.VSwitchId,
+		RouteTableType: item.RouteTableType,
 	}
 	tags := Tags{}
 	for _, t := range item.Tags.Tag {
@@ -1883,6 +2068,30 @@ func (c *actor) GetVpcIpv6Info(_ context.Context, vpcId string) (string, error)
 	return v.Ipv6CidrBlock, nil
 }
 
+func (c *actor) AssociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) error {
+	req := vpc.CreateAssociateVpcCidrBlockRequest()
+	req.VpcId = vpcId
+	req.SecondaryCidrBlock = cidrBlock
+	if _, err := callApi(c.vpcClient.AssociateVpcCidrBlock, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		v, err := c.getVpc(vpcId)
+		if err != nil {
+			return false, err
+		}
+		return v != nil && slices.Contains(v.SecondaryCidrBlocks, cidrBlock), nil
+	})
+}
+
+func (c *actor) UnassociateVpcCidrBlock(_ context.Context, vpcId, cidrBlock string) error {
+	req := vpc.CreateUnassociateVpcCidrBlockRequest()
+	req.VpcId = vpcId
+	req.SecondaryCidrBlock = cidrBlock
+	_, err := callApi(c.vpcClient.UnassociateVpcCidrBlock, req)
+	return err
+}
+
 func (c *actor) CreateIpv6Gateway(ctx context.Context, gw *IPv6Gateway) (*IPv6Gateway, error) {
 	req := vpc.CreateCreateIpv6GatewayRequest()
 	req.VpcId = gw.VpcId
@@ -2002,6 +2211,251 @@ func (c *actor) DeleteIpv6Gateway(ctx context.Context, id string) error {
 	})
 }
 
+func (c *actor) ListIpv6AddressesByVSwitch(_ context.Context, vSwitchId string) ([]*IPv6Address, error) {
+	req := vpc.CreateDescribeIpv6AddressesRequest()
+	req.VSwitchId = vSwitchId
+	respList, err := page_call(c.vpcClient.DescribeIpv6Addresses, req)
+	if err != nil {
+		return nil, err
+	}
+	var addresses []*IPv6Address
+	for _, resp := range respList {
+		for _, item := range resp.Ipv6Addresses.Ipv6Address {
+			addresses = append(addresses, &IPv6Address{
+				Ipv6AddressId:          item.Ipv6AddressId,
+				Ipv6Address:            item.Ipv6Address,
+				VSwitchId:              item.VSwitchId,
+				AssociatedInstanceId:   item.AssociatedInstanceId,
+				AssociatedInstanceType: item.AssociatedInstanceType,
+				InternetBandwidthId:    item.Ipv6InternetBandwidth.Ipv6InternetBandwidthId,
+				Bandwidth:              item.Ipv6InternetBandwidth.Bandwidth,
+				InternetChargeType:     item.Ipv6InternetBandwidth.InternetChargeType,
+			})
+		}
+	}
+	return addresses, nil
+}
+
+func (c *actor) AllocateIpv6InternetBandwidth(_ context.Context, ipv6GatewayId, ipv6AddressId string, bandwidth int, internetChargeType string) error {
+	req := vpc.CreateAllocateIpv6InternetBandwidthRequest()
+	req.Ipv6GatewayId = ipv6GatewayId
+	req.Ipv6AddressId = ipv6AddressId
+	req.Bandwidth = requests.NewInteger(bandwidth)
+	req.InternetChargeType = internetChargeType
+	_, err := callApi(c.vpcClient.AllocateIpv6InternetBandwidth, req)
+	return err
+}
+
+func (c *actor) ModifyIpv6InternetBandwidth(_ context.Context, ipv6AddressId, internetBandwidthId string, bandwidth int) error {
+	req := vpc.CreateModifyIpv6InternetBandwidthRequest()
+	req.Ipv6AddressId = ipv6AddressId
+	req.Ipv6InternetBandwidthId = internetBandwidthId
+	req.Bandwidth = requests.NewInteger(bandwidth)
+	_, err := callApi(c.vpcClient.ModifyIpv6InternetBandwidth, req)
+	return err
+}
+
+func (c *actor) DeleteIpv6InternetBandwidth(_ context.Context, ipv6AddressId, internetBandwidthId string) error {
+	req := vpc.CreateDeleteIpv6InternetBandwidthRequest()
+	req.Ipv6AddressId = ipv6AddressId
+	req.Ipv6InternetBandwidthId = internetBandwidthId
+	_, err := callApi(c.vpcClient.DeleteIpv6InternetBandwidth, req)
+	return err
+}
+
+func (c *actor) ListIpv6EgressOnlyRules(_ context.Context, ipv6GatewayId string) ([]*IPv6EgressOnlyRule, error) {
+	req := vpc.CreateDescribeIpv6EgressOnlyRulesRequest()
+	req.Ipv6GatewayId = ipv6GatewayId
+	return c.describeIpv6EgressOnlyRules(req)
+}
+
+func (c *actor) describeIpv6EgressOnlyRules(req *vpc.DescribeIpv6EgressOnlyRulesRequest) ([]*IPv6EgressOnlyRule, error) {
+	respList, err := page_call(c.vpcClient.DescribeIpv6EgressOnlyRules, req)
+	if err != nil {
+		return nil, err
+	}
+	var rules []*IPv6EgressOnlyRule
+	for _, resp := range respList {
+		for _, item := range resp.Ipv6EgressOnlyRules.Ipv6EgressOnlyRule {
+			status := item.Status
+			rules = append(rules, &IPv6EgressOnlyRule{
+				Name:                 item.Name,
+				Ipv6EgressOnlyRuleId: item.Ipv6EgressOnlyRuleId,
+				InstanceType:         item.InstanceType,
+				InstanceId:           item.InstanceId,
+				Status:               &status,
+			})
+		}
+	}
+	return rules, nil
+}
+
+func (c *actor) getIpv6EgressOnlyRule(ipv6GatewayId, id string) (*IPv6EgressOnlyRule, error) {
+	req := vpc.CreateDescribeIpv6EgressOnlyRulesRequest()
+	req.Ipv6GatewayId = ipv6GatewayId
+	req.Ipv6EgressOnlyRuleId = id
+	return single(c.describeIpv6EgressOnlyRules(req))
+}
+
+func (c *actor) CreateIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId string, rule *IPv6EgressOnlyRule) (*IPv6EgressOnlyRule, error) {
+	req := vpc.CreateCreateIpv6EgressOnlyRuleRequest()
+	req.Ipv6GatewayId = ipv6GatewayId
+	req.Name = rule.Name
+	req.InstanceType = rule.InstanceType
+	req.InstanceId = rule.InstanceId
+	resp, err := callApi(c.vpcClient.CreateIpv6EgressOnlyRule, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *IPv6EgressOnlyRule
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.getIpv6EgressOnlyRule(ipv6GatewayId, resp.Ipv6EgressRuleId)
+		if err != nil {
+			return false, err
+		}
+		return created != nil && *created.Status == "Available", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) DeleteIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId, id string) error {
+	req := vpc.CreateDeleteIpv6EgressOnlyRuleRequest()
+	req.Ipv6EgressOnlyRuleId = id
+	if _, err := callApi(c.vpcClient.DeleteIpv6EgressOnlyRule, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		rule, err := c.getIpv6EgressOnlyRule(ipv6GatewayId, id)
+		if err != nil {
+			return false, err
+		}
+		return rule == nil, nil
+	})
+}
+
+func (c *actor) CreateFlowLog(ctx context.Context, flowLog *FlowLog) (*FlowLog, error) {
+	req := vpc.CreateCreateFlowLogRequest()
+	req.FlowLogName = flowLog.Name
+	req.ResourceType = flowLog.ResourceType
+	req.ResourceId = flowLog.ResourceId
+	req.TrafficType = flowLog.TrafficType
+	req.ProjectName = flowLog.ProjectName
+	req.LogStoreName = flowLog.LogStoreName
+	var reqTag []vpc.CreateFlowLogTag
+	for k, v := range flowLog.Tags {
+		reqTag = append(reqTag, vpc.CreateFlowLogTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTag
+	resp, err := callApi(c.vpcClient.CreateFlowLog, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *FlowLog
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.getFlowLog(resp.FlowLogId)
+		if err != nil {
+			return false, err
+		}
+		if created == nil {
+			return false, nil
+		}
+		return *created.Status == "Active", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) GetFlowLog(_ context.Context, id string) (*FlowLog, error) {
+	return c.getFlowLog(id)
+}
+
+func (c *actor) getFlowLog(id string) (*FlowLog, error) {
+	req := vpc.CreateDescribeFlowLogsRequest()
+	req.FlowLogId = id
+	resp, err := c.describeFlowLogs(req)
+	return single(resp, err)
+}
+
+func (c *actor) FindFlowLogsByTags(_ context.Context, tags Tags) ([]*FlowLog, error) {
+	req := vpc.CreateDescribeFlowLogsRequest()
+	var reqTags []vpc.DescribeFlowLogsTags
+	for k, v := range tags {
+		reqTags = append(reqTags, vpc.DescribeFlowLogsTags{Key: k, Value: v})
+	}
+	req.Tags = &reqTags
+	return c.describeFlowLogs(req)
+}
+
+// describeFlowLogs pages through the flow logs itself, as DescribeFlowLogs reports the total count as string.
+func (c *actor) describeFlowLogs(req *vpc.DescribeFlowLogsRequest) ([]*FlowLog, error) {
+	const pageSize = 50
+	var flowLogs []*FlowLog
+	req.PageSize = requests.NewInteger(pageSize)
+	for page := 1; ; page++ {
+		req.PageNumber = requests.NewInteger(page)
+		resp, err := callApi(c.vpcClient.DescribeFlowLogs, req)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range resp.FlowLogs.FlowLog {
+			flowLogs = append(flowLogs, fromFlowLog(item))
+		}
+		total, err := strconv.Atoi(resp.TotalCount)
+		if err != nil || page*pageSize >= total || len(resp.FlowLogs.FlowLog) == 0 {
+			break
+		}
+	}
+	return flowLogs, nil
+}
+
+func fromFlowLog(item vpc.FlowLog) *FlowLog {
+	status := item.Status
+	tags := Tags{}
+	for _, t := range item.Tags.Tag {
+		tags[t.Key] = t.Value
+	}
+	return &FlowLog{
+		Tags:         tags,
+		Name:         item.FlowLogName,
+		FlowLogId:    item.FlowLogId,
+		ResourceType: item.ResourceType,
+		ResourceId:   item.ResourceId,
+		TrafficType:  item.TrafficType,
+		ProjectName:  item.ProjectName,
+		LogStoreName: item.LogStoreName,
+		Status:       &status,
+	}
+}
+
+func (c *actor) DeleteFlowLog(ctx context.Context, id string) error {
+	current, err := c.getFlowLog(id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := vpc.CreateDeleteFlowLogRequest()
+	req.FlowLogId = id
+	if _, err := callApi(c.vpcClient.DeleteFlowLog, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		flowLog, err := c.getFlowLog(id)
+		if err != nil {
+			return false, err
+		}
+		return flowLog == nil, nil
+	})
+}
+
 func (c *actor) SetVSwitchIpv6CidrBlock(ctx context.Context, vSwitchId string, ipv6CidrBlock int) error {
 	req := vpc.CreateModifyVSwitchAttributeRequest()
 	req.VSwitchId = vSwitchId
@@ -2108,6 +2562,23 @@ func (c *actor) SetNLBDeletionProtection(_ context.Context, loadBalancerID strin
 	return err
 }
 
+func (c *actor) SetNatGatewayDeletionProtection(_ context.Context, id string, enable bool) error {
+	return c.setDeletionProtection(id, "NATGW", enable)
+}
+
+func (c *actor) SetEIPDeletionProtection(_ context.Context, id string, enable bool) error {
+	return c.setDeletionProtection(id, "EIP", enable)
+}
+
+func (c *actor) setDeletionProtection(id, resourceType string, enable bool) error {
+	req := vpc.CreateDeletionProtectionRequest()
+	req.InstanceId = id
+	req.Type = resourceType
+	req.ProtectionEnable = requests.NewBoolean(enable)
+	_, err := callApi(c.vpcClient.DeletionProtection, req)
+	return err
+}
+
 func (c *actor) DeleteNLB(ctx context.Context, loadBalancerID string) error {
 	current, err := c.getNLB(loadBalancerID)
 	if err != nil {
@@ -2129,3 +2600,321 @@ func (c *actor) DeleteNLB(ctx context.Context, loadBalancerID string) error {
 		return info == nil, nil
 	})
 }
+
+func (c *actor) CreateNASFileSystem(ctx context.Context, fileSystem *NASFileSystem) (*NASFileSystem, error) {
+	req := nas.CreateCreateFileSystemRequest()
+	req.ProtocolType = "NFS"
+	req.FileSystemType = "standard"
+	req.ChargeType = "PayAsYouGo"
+	req.StorageType = fileSystem.StorageType
+	req.ZoneId = fileSystem.ZoneId
+	req.Description = fileSystem.Description
+	resp, err := callApi(c.nasClient.CreateFileSystem, req)
+	if err != nil {
+		return nil, err
+	}
+
+	if len(fileSystem.Tags) > 0 {
+		tagReq := nas.CreateTagResourcesRequest()
+		tagReq.ResourceType = "filesystem"
+		tagReq.ResourceId = &[]string{resp.FileSystemId}
+		var reqTags []nas.TagResourcesTag
+		for k, v := range fileSystem.Tags {
+			reqTags = append(reqTags, nas.TagResourcesTag{Key: k, Value: v})
+		}
+		tagReq.Tag = &reqTags
+		if _, err := callApi(c.nasClient.TagResources, tagReq); err != nil {
+			return nil, err
+		}
+	}
+
+	var created *NASFileSystem
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.getNASFileSystem(resp.FileSystemId)
+		if err != nil {
+			return false, err
+		}
+		if created == nil {
+			return false, nil
+		}
+		return *created.Status == "Running", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) GetNASFileSystem(_ context.Context, id string) (*NASFileSystem, error) {
+	return c.getNASFileSystem(id)
+}
+
+func (c *actor) getNASFileSystem(id string) (*NASFileSystem, error) {
+	req := nas.CreateDescribeFileSystemsRequest()
+	req.FileSystemId = id
+	fileSystems, err := c.describeNASFileSystems(req)
+	if err != nil {
+		if serverErr, ok := err.(*alierrors.ServerError); ok && serverErr.ErrorCode() == "InvalidFileSystem.NotFound" {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return single(fileSystems, nil)
+}
+
+func (c *actor) FindNASFileSystemsByTags(_ context.Context, tags Tags) ([]*NASFileSystem, error) {
+	req := nas.CreateDescribeFileSystemsRequest()
+	var reqTags []nas.DescribeFileSystemsTag
+	for k, v := range tags {
+		reqTags = append(reqTags, nas.DescribeFileSystemsTag{Key: k, Value: v})
+	}
+	req.Tag = &reqTags
+	return c.describeNASFileSystems(req)
+}
+
+func (c *actor) describeNASFileSystems(req *nas.DescribeFileSystemsRequest) ([]*NASFileSystem, error) {
+	respList, err := page_call(c.nasClient.DescribeFileSystems, req)
+	if err != nil {
+		return nil, err
+	}
+	var fileSystems []*NASFileSystem
+	for _, resp := range respList {
+		for _, item := range resp.FileSystems.FileSystem {
+			fileSystems = append(fileSystems, fromNASFileSystem(item))
+		}
+	}
+	return fileSystems, nil
+}
+
+func fromNASFileSystem(item nas.FileSystem) *NASFileSystem {
+	status := item.Status
+	tags := Tags{}
+	for _, t := range item.Tags.Tag {
+		tags[t.Key] = t.Value
+	}
+	return &NASFileSystem{
+		Tags:         tags,
+		FileSystemId: item.FileSystemId,
+		Description:  item.Description,
+		ZoneId:       item.ZoneId,
+		StorageType:  item.StorageType,
+		Status:       &status,
+	}
+}
+
+func (c *actor) DeleteNASFileSystem(ctx context.Context, id string) error {
+	current, err := c.getNASFileSystem(id)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := nas.CreateDeleteFileSystemRequest()
+	req.FileSystemId = id
+	if _, err := callApi(c.nasClient.DeleteFileSystem, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		fileSystem, err := c.getNASFileSystem(id)
+		if err != nil {
+			return false, err
+		}
+		return fileSystem == nil, nil
+	})
+}
+
+func (c *actor) ListNASMountTargets(_ context.Context, fileSystemId string) ([]*NASMountTarget, error) {
+	return c.listNASMountTargets(fileSystemId)
+}
+
+func (c *actor) listNASMountTargets(fileSystemId string) ([]*NASMountTarget, error) {
+	req := nas.CreateDescribeMountTargetsRequest()
+	req.FileSystemId = fileSystemId
+	respList, err := page_call(c.nasClient.DescribeMountTargets, req)
+	if err != nil {
+		return nil, err
+	}
+	var mountTargets []*NASMountTarget
+	for _, resp := range respList {
+		for _, item := range resp.MountTargets.MountTarget {
+			status := item.Status
+			mountTargets = append(mountTargets, &NASMountTarget{
+				FileSystemId:      fileSystemId,
+				MountTargetDomain: item.MountTargetDomain,
+				VpcId:             item.VpcId,
+				VSwitchId:         item.VswId,
+				Status:            &status,
+			})
+		}
+	}
+	return mountTargets, nil
+}
+
+func (c *actor) getNASMountTarget(fileSystemId, mountTargetDomain string) (*NASMountTarget, error) {
+	mountTargets, err := c.listNASMountTargets(fileSystemId)
+	if err != nil {
+		return nil, err
+	}
+	for _, mountTarget := range mountTargets {
+		if mountTarget.MountTargetDomain == mountTargetDomain {
+			return mountTarget, nil
+		}
+	}
+	return nil, nil
+}
+
+func (c *actor) CreateNASMountTarget(ctx context.Context, mountTarget *NASMountTarget) (*NASMountTarget, error) {
+	req := nas.CreateCreateMountTargetRequest()
+	req.FileSystemId = mountTarget.FileSystemId
+	req.NetworkType = "Vpc"
+	req.VpcId = mountTarget.VpcId
+	req.VSwitchId = mountTarget.VSwitchId
+	req.AccessGroupName = "DEFAULT_VPC_GROUP_NAME"
+	resp, err := callApi(c.nasClient.CreateMountTarget, req)
+	if err != nil {
+		return nil, err
+	}
+
+	var created *NASMountTarget
+	err = wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		created, err = c.getNASMountTarget(mountTarget.FileSystemId, resp.MountTargetDomain)
+		if err != nil {
+			return false, err
+		}
+		if created == nil {
+			return false, nil
+		}
+		return *created.Status == "Active", nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	return created, nil
+}
+
+func (c *actor) DeleteNASMountTarget(ctx context.Context, fileSystemId, mountTargetDomain string) error {
+	current, err := c.getNASMountTarget(fileSystemId, mountTargetDomain)
+	if err != nil {
+		return err
+	}
+	if current == nil {
+		return nil
+	}
+	req := nas.CreateDeleteMountTargetRequest()
+	req.FileSystemId = fileSystemId
+	req.MountTargetDomain = mountTargetDomain
+	if _, err := callApi(c.nasClient.DeleteMountTarget, req); err != nil {
+		return err
+	}
+	return wait.PollUntilContextCancel(ctx, c.PollInterval, false, func(_ context.Context) (bool, error) {
+		mountTarget, err := c.getNASMountTarget(fileSystemId, mountTargetDomain)
+		if err != nil {
+			return false, err
+		}
+		return mountTarget == nil, nil
+	})
+}
+
+func (c *actor) CreateSLSProject(ctx context.Context, project *SLSProject) (*SLSProject, error) {
+	if err := c.slsClient.CreateProject(&sls.Project{ProjectName: project.Name, Description: project.Description}); err != nil {
+		return nil, err
+	}
+
+	if len(project.Tags) > 0 {
+		var tags []sls.Tag
+		for k, v := range project.Tags {
+			tags = append(tags, sls.Tag{Key: k, Value: v})
+		}
+		if err := c.slsClient.TagProject(project.Name, tags); err != nil {
+			return nil, err
+		}
+	}
+
+	var created *SLSProject
+	err := wait.PollUntilContextCancel(ctx, c.PollInterval, true, func(_ context.Context) (bool, error) {
+		var err error
+		created, err = c.getSLSProject(project.Name)
+		if err != nil {
+			return false, err
+		}
+		return created != nil, nil
+	})
+	if err != nil {
+		return nil, err
+	}
+	created.Tags = project.Tags
+	return created, nil
+}
+
+func (c *actor) GetSLSProject(_ context.Context, name string) (*SLSProject, error) {
+	return c.getSLSProject(name)
+}
+
+func (c *actor) getSLSProject(name string) (*SLSProject, error) {
+	project, err := c.slsClient.GetProject(name)
+	if err != nil {
+		if isSLSError(err, sls.ErrorCodeProjectNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	status := project.Status
+	return &SLSProject{
+		Name:        project.ProjectName,
+		Description: project.Description,
+		Status:      &status,
+	}, nil
+}
+
+func (c *actor) DeleteSLSProject(_ context.Context, name string) error {
+	if err := c.slsClient.DeleteProject(name); err != nil && !isSLSError(err, sls.ErrorCodeProjectNotExist) {
+		return err
+	}
+	return nil
+}
+
+func (c *actor) CreateSLSLogstore(_ context.Context, logstore *SLSLogstore) (*SLSLogstore, error) {
+	err := c.slsClient.CreateLogStore(logstore.ProjectName, &sls.LogStore{
+		LogStoreName: logstore.Name,
+		TTL:          logstore.TTL,
+		ShardCount:   logstore.ShardCount,
+	})
+	if err != nil {
+		return nil, err
+	}
+	return c.getSLSLogstore(logstore.ProjectName, logstore.Name)
+}
+
+func (c *actor) GetSLSLogstore(_ context.Context, projectName, name string) (*SLSLogstore, error) {
+	return c.getSLSLogstore(projectName, name)
+}
+
+func (c *actor) getSLSLogstore(projectName, name string) (*SLSLogstore, error) {
+	logstore, err := c.slsClient.GetLogStore(projectName, name)
+	if err != nil {
+		if isSLSError(err, sls.ErrorCodeProjectNotExist) || isSLSError(err, sls.ErrorCodeLogStoreNotExist) {
+			return nil, nil
+		}
+		return nil, err
+	}
+	return &SLSLogstore{
+		ProjectName: projectName,
+		Name:        logstore.LogStoreName,
+		TTL:         logstore.TTL,
+		ShardCount:  logstore.ShardCount,
+	}, nil
+}
+
+func (c *actor) DeleteSLSLogstore(_ context.Context, projectName, name string) error {
+	err := c.slsClient.DeleteLogStore(projectName, name)
+	if err != nil && !isSLSError(err, sls.ErrorCodeProjectNotExist) && !isSLSError(err, sls.ErrorCodeLogStoreNotExist) {
+		return err
+	}
+	return nil
+}
+
+func isSLSError(err error, code string) bool {
+	slsErr, ok := err.(*sls.Error)
+	return ok && slsErr.Code == code
+}
@@ -41,6 +41,20 @@ func (m *MockActor) EXPECT() *MockActorMockRecorder {
 	return m.recorder
 }
 
+// AllocateIpv6InternetBandwidth mocks base method.
+func (m *MockActor) AllocateIpv6InternetBandwidth(ctx context.Context, ipv6GatewayId, ipv6AddressId string, bandwidth int, internetChargeType string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateIpv6InternetBandwidth", ctx, ipv6GatewayId, ipv6AddressId, bandwidth, internetChargeType)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AllocateIpv6InternetBandwidth indicates an expected call of AllocateIpv6InternetBandwidth.
+func (mr *MockActorMockRecorder) AllocateIpv6InternetBandwidth(ctx, ipv6GatewayId, ipv6AddressId, bandwidth, internetChargeType any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIpv6InternetBandwidth", reflect.TypeOf((*MockActor)(nil).AllocateIpv6InternetBandwidth), ctx, ipv6GatewayId, ipv6AddressId, bandwidth, internetChargeType)
+}
+
 // AssociateEIP mocks base method.
 func (m *MockActor) AssociateEIP(ctx context.Context, id, to, insType string) error {
 	m.ctrl.T.Helper()
@@ -69,6 +83,20 @@ func (mr *MockActorMockRecorder) AssociateRouteTable(ctx, routeTableId, vSwitchI
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateRouteTable", reflect.TypeOf((*MockActor)(nil).AssociateRouteTable), ctx, routeTableId, vSwitchId)
 }
 
+// AssociateVpcCidrBlock mocks base method.
+func (m *MockActor) AssociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateVpcCidrBlock", ctx, vpcId, cidrBlock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// AssociateVpcCidrBlock indicates an expected call of AssociateVpcCidrBlock.
+func (mr *MockActorMockRecorder) AssociateVpcCidrBlock(ctx, vpcId, cidrBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateVpcCidrBlock", reflect.TypeOf((*MockActor)(nil).AssociateVpcCidrBlock), ctx, vpcId, cidrBlock)
+}
+
 // AuthorizeSecurityGroupRule mocks base method.
 func (m *MockActor) AuthorizeSecurityGroupRule(ctx context.Context, sgId string, rule aliclient.SecurityGroupRule) error {
 	m.ctrl.T.Helper()
@@ -98,6 +126,36 @@ func (mr *MockActorMockRecorder) CreateEIP(ctx, eip any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateEIP", reflect.TypeOf((*MockActor)(nil).CreateEIP), ctx, eip)
 }
 
+// CreateFlowLog mocks base method.
+func (m *MockActor) CreateFlowLog(ctx context.Context, flowLog *aliclient.FlowLog) (*aliclient.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlowLog", ctx, flowLog)
+	ret0, _ := ret[0].(*aliclient.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlowLog indicates an expected call of CreateFlowLog.
+func (mr *MockActorMockRecorder) CreateFlowLog(ctx, flowLog any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLog", reflect.TypeOf((*MockActor)(nil).CreateFlowLog), ctx, flowLog)
+}
+
+// CreateIpv6EgressOnlyRule mocks base method.
+func (m *MockActor) CreateIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId string, rule *aliclient.IPv6EgressOnlyRule) (*aliclient.IPv6EgressOnlyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv6EgressOnlyRule", ctx, ipv6GatewayId, rule)
+	ret0, _ := ret[0].(*aliclient.IPv6EgressOnlyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv6EgressOnlyRule indicates an expected call of CreateIpv6EgressOnlyRule.
+func (mr *MockActorMockRecorder) CreateIpv6EgressOnlyRule(ctx, ipv6GatewayId, rule any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6EgressOnlyRule", reflect.TypeOf((*MockActor)(nil).CreateIpv6EgressOnlyRule), ctx, ipv6GatewayId, rule)
+}
+
 // CreateIpv6Gateway mocks base method.
 func (m *MockActor) CreateIpv6Gateway(ctx context.Context, gw *aliclient.IPv6Gateway) (*aliclient.IPv6Gateway, error) {
 	m.ctrl.T.Helper()
@@ -113,6 +171,36 @@ func (mr *MockActorMockRecorder) CreateIpv6Gateway(ctx, gw any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6Gateway", reflect.TypeOf((*MockActor)(nil).CreateIpv6Gateway), ctx, gw)
 }
 
+// CreateNASFileSystem mocks base method.
+func (m *MockActor) CreateNASFileSystem(ctx context.Context, fileSystem *aliclient.NASFileSystem) (*aliclient.NASFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNASFileSystem", ctx, fileSystem)
+	ret0, _ := ret[0].(*aliclient.NASFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNASFileSystem indicates an expected call of CreateNASFileSystem.
+func (mr *MockActorMockRecorder) CreateNASFileSystem(ctx, fileSystem any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNASFileSystem", reflect.TypeOf((*MockActor)(nil).CreateNASFileSystem), ctx, fileSystem)
+}
+
+// CreateNASMountTarget mocks base method.
+func (m *MockActor) CreateNASMountTarget(ctx context.Context, mountTarget *aliclient.NASMountTarget) (*aliclient.NASMountTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNASMountTarget", ctx, mountTarget)
+	ret0, _ := ret[0].(*aliclient.NASMountTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNASMountTarget indicates an expected call of CreateNASMountTarget.
+func (mr *MockActorMockRecorder) CreateNASMountTarget(ctx, mountTarget any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNASMountTarget", reflect.TypeOf((*MockActor)(nil).CreateNASMountTarget), ctx, mountTarget)
+}
+
 // CreateNatGateway mocks base method.
 func (m *MockActor) CreateNatGateway(ctx context.Context, ngw *aliclient.NatGateway) (*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -128,6 +216,21 @@ func (mr *MockActorMockRecorder) CreateNatGateway(ctx, ngw any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockActor)(nil).CreateNatGateway), ctx, ngw)
 }
 
+// CreateNatIp mocks base method.
+func (m *MockActor) CreateNatIp(ctx context.Context, natIp *aliclient.NatIP) (*aliclient.NatIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNatIp", ctx, natIp)
+	ret0, _ := ret[0].(*aliclient.NatIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNatIp indicates an expected call of CreateNatIp.
+func (mr *MockActorMockRecorder) CreateNatIp(ctx, natIp any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatIp", reflect.TypeOf((*MockActor)(nil).CreateNatIp), ctx, natIp)
+}
+
 // CreateRouteEntry mocks base method.
 func (m *MockActor) CreateRouteEntry(ctx context.Context, routeTableId string, entry *aliclient.RouteEntry) (*aliclient.RouteEntry, error) {
 	m.ctrl.T.Helper()
@@ -158,6 +261,36 @@ func (mr *MockActorMockRecorder) CreateRouteTable(ctx, rt any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateRouteTable", reflect.TypeOf((*MockActor)(nil).CreateRouteTable), ctx, rt)
 }
 
+// CreateSLSLogstore mocks base method.
+func (m *MockActor) CreateSLSLogstore(ctx context.Context, logstore *aliclient.SLSLogstore) (*aliclient.SLSLogstore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSLSLogstore", ctx, logstore)
+	ret0, _ := ret[0].(*aliclient.SLSLogstore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSLSLogstore indicates an expected call of CreateSLSLogstore.
+func (mr *MockActorMockRecorder) CreateSLSLogstore(ctx, logstore any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLSLogstore", reflect.TypeOf((*MockActor)(nil).CreateSLSLogstore), ctx, logstore)
+}
+
+// CreateSLSProject mocks base method.
+func (m *MockActor) CreateSLSProject(ctx context.Context, project *aliclient.SLSProject) (*aliclient.SLSProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateSLSProject", ctx, project)
+	ret0, _ := ret[0].(*aliclient.SLSProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateSLSProject indicates an expected call of CreateSLSProject.
+func (mr *MockActorMockRecorder) CreateSLSProject(ctx, project any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateSLSProject", reflect.TypeOf((*MockActor)(nil).CreateSLSProject), ctx, project)
+}
+
 // CreateSNatEntry mocks base method.
 func (m *MockActor) CreateSNatEntry(ctx context.Context, entry *aliclient.SNATEntry) (*aliclient.SNATEntry, error) {
 	m.ctrl.T.Helper()
@@ -246,6 +379,34 @@ func (mr *MockActorMockRecorder) DeleteEIP(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteEIP", reflect.TypeOf((*MockActor)(nil).DeleteEIP), ctx, id)
 }
 
+// DeleteFlowLog mocks base method.
+func (m *MockActor) DeleteFlowLog(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLog", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteFlowLog indicates an expected call of DeleteFlowLog.
+func (mr *MockActorMockRecorder) DeleteFlowLog(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLog", reflect.TypeOf((*MockActor)(nil).DeleteFlowLog), ctx, id)
+}
+
+// DeleteIpv6EgressOnlyRule mocks base method.
+func (m *MockActor) DeleteIpv6EgressOnlyRule(ctx context.Context, ipv6GatewayId, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6EgressOnlyRule", ctx, ipv6GatewayId, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIpv6EgressOnlyRule indicates an expected call of DeleteIpv6EgressOnlyRule.
+func (mr *MockActorMockRecorder) DeleteIpv6EgressOnlyRule(ctx, ipv6GatewayId, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6EgressOnlyRule", reflect.TypeOf((*MockActor)(nil).DeleteIpv6EgressOnlyRule), ctx, ipv6GatewayId, id)
+}
+
 // DeleteIpv6Gateway mocks base method.
 func (m *MockActor) DeleteIpv6Gateway(ctx context.Context, id string) error {
 	m.ctrl.T.Helper()
@@ -260,6 +421,48 @@ func (mr *MockActorMockRecorder) DeleteIpv6Gateway(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6Gateway", reflect.TypeOf((*MockActor)(nil).DeleteIpv6Gateway), ctx, id)
 }
 
+// DeleteIpv6InternetBandwidth mocks base method.
+func (m *MockActor) DeleteIpv6InternetBandwidth(ctx context.Context, ipv6AddressId, internetBandwidthId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6InternetBandwidth", ctx, ipv6AddressId, internetBandwidthId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteIpv6InternetBandwidth indicates an expected call of DeleteIpv6InternetBandwidth.
+func (mr *MockActorMockRecorder) DeleteIpv6InternetBandwidth(ctx, ipv6AddressId, internetBandwidthId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6InternetBandwidth", reflect.TypeOf((*MockActor)(nil).DeleteIpv6InternetBandwidth), ctx, ipv6AddressId, internetBandwidthId)
+}
+
+// DeleteNASFileSystem mocks base method.
+func (m *MockActor) DeleteNASFileSystem(ctx context.Context, id string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNASFileSystem", ctx, id)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNASFileSystem indicates an expected call of DeleteNASFileSystem.
+func (mr *MockActorMockRecorder) DeleteNASFileSystem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNASFileSystem", reflect.TypeOf((*MockActor)(nil).DeleteNASFileSystem), ctx, id)
+}
+
+// DeleteNASMountTarget mocks base method.
+func (m *MockActor) DeleteNASMountTarget(ctx context.Context, fileSystemId, mountTargetDomain string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNASMountTarget", ctx, fileSystemId, mountTargetDomain)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNASMountTarget indicates an expected call of DeleteNASMountTarget.
+func (mr *MockActorMockRecorder) DeleteNASMountTarget(ctx, fileSystemId, mountTargetDomain any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNASMountTarget", reflect.TypeOf((*MockActor)(nil).DeleteNASMountTarget), ctx, fileSystemId, mountTargetDomain)
+}
+
 // DeleteNLB mocks base method.
 func (m *MockActor) DeleteNLB(ctx context.Context, loadBalancerID string) error {
 	m.ctrl.T.Helper()
@@ -288,6 +491,20 @@ func (mr *MockActorMockRecorder) DeleteNatGateway(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockActor)(nil).DeleteNatGateway), ctx, id)
 }
 
+// DeleteNatIp mocks base method.
+func (m *MockActor) DeleteNatIp(ctx context.Context, id, ngwId string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNatIp", ctx, id, ngwId)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteNatIp indicates an expected call of DeleteNatIp.
+func (mr *MockActorMockRecorder) DeleteNatIp(ctx, id, ngwId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatIp", reflect.TypeOf((*MockActor)(nil).DeleteNatIp), ctx, id, ngwId)
+}
+
 // DeleteRouteEntry mocks base method.
 func (m *MockActor) DeleteRouteEntry(ctx context.Context, routeTableId string, entry *aliclient.RouteEntry) error {
 	m.ctrl.T.Helper()
@@ -316,6 +533,34 @@ func (mr *MockActorMockRecorder) DeleteRouteTable(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteRouteTable", reflect.TypeOf((*MockActor)(nil).DeleteRouteTable), ctx, id)
 }
 
+// DeleteSLSLogstore mocks base method.
+func (m *MockActor) DeleteSLSLogstore(ctx context.Context, projectName, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSLSLogstore", ctx, projectName, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSLSLogstore indicates an expected call of DeleteSLSLogstore.
+func (mr *MockActorMockRecorder) DeleteSLSLogstore(ctx, projectName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLSLogstore", reflect.TypeOf((*MockActor)(nil).DeleteSLSLogstore), ctx, projectName, name)
+}
+
+// DeleteSLSProject mocks base method.
+func (m *MockActor) DeleteSLSProject(ctx context.Context, name string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteSLSProject", ctx, name)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// DeleteSLSProject indicates an expected call of DeleteSLSProject.
+func (mr *MockActorMockRecorder) DeleteSLSProject(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteSLSProject", reflect.TypeOf((*MockActor)(nil).DeleteSLSProject), ctx, name)
+}
+
 // DeleteSNatEntry mocks base method.
 func (m *MockActor) DeleteSNatEntry(ctx context.Context, id, snatTableId string) error {
 	m.ctrl.T.Helper()
@@ -415,6 +660,21 @@ func (mr *MockActorMockRecorder) FindEIPsByTags(ctx, tags any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindEIPsByTags", reflect.TypeOf((*MockActor)(nil).FindEIPsByTags), ctx, tags)
 }
 
+// FindFlowLogsByTags mocks base method.
+func (m *MockActor) FindFlowLogsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindFlowLogsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindFlowLogsByTags indicates an expected call of FindFlowLogsByTags.
+func (mr *MockActorMockRecorder) FindFlowLogsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindFlowLogsByTags", reflect.TypeOf((*MockActor)(nil).FindFlowLogsByTags), ctx, tags)
+}
+
 // FindIpv6GatewayByVPC mocks base method.
 func (m *MockActor) FindIpv6GatewayByVPC(ctx context.Context, vpcId string) (*aliclient.IPv6Gateway, error) {
 	m.ctrl.T.Helper()
@@ -445,6 +705,21 @@ func (mr *MockActorMockRecorder) FindIpv6GatewaysByTags(ctx, tags any) *gomock.C
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindIpv6GatewaysByTags", reflect.TypeOf((*MockActor)(nil).FindIpv6GatewaysByTags), ctx, tags)
 }
 
+// FindNASFileSystemsByTags mocks base method.
+func (m *MockActor) FindNASFileSystemsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.NASFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "FindNASFileSystemsByTags", ctx, tags)
+	ret0, _ := ret[0].([]*aliclient.NASFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// FindNASFileSystemsByTags indicates an expected call of FindNASFileSystemsByTags.
+func (mr *MockActorMockRecorder) FindNASFileSystemsByTags(ctx, tags any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "FindNASFileSystemsByTags", reflect.TypeOf((*MockActor)(nil).FindNASFileSystemsByTags), ctx, tags)
+}
+
 // FindNLBsByTags mocks base method.
 func (m *MockActor) FindNLBsByTags(ctx context.Context, tags aliclient.Tags) ([]*aliclient.NLBInfo, error) {
 	m.ctrl.T.Helper()
@@ -625,6 +900,21 @@ func (mr *MockActorMockRecorder) GetEIPByAddress(ctx, ipAddress any) *gomock.Cal
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetEIPByAddress", reflect.TypeOf((*MockActor)(nil).GetEIPByAddress), ctx, ipAddress)
 }
 
+// GetFlowLog mocks base method.
+func (m *MockActor) GetFlowLog(ctx context.Context, id string) (*aliclient.FlowLog, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetFlowLog", ctx, id)
+	ret0, _ := ret[0].(*aliclient.FlowLog)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetFlowLog indicates an expected call of GetFlowLog.
+func (mr *MockActorMockRecorder) GetFlowLog(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetFlowLog", reflect.TypeOf((*MockActor)(nil).GetFlowLog), ctx, id)
+}
+
 // GetIpv6Gateway mocks base method.
 func (m *MockActor) GetIpv6Gateway(ctx context.Context, id string) (*aliclient.IPv6Gateway, error) {
 	m.ctrl.T.Helper()
@@ -640,6 +930,21 @@ func (mr *MockActorMockRecorder) GetIpv6Gateway(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetIpv6Gateway", reflect.TypeOf((*MockActor)(nil).GetIpv6Gateway), ctx, id)
 }
 
+// GetNASFileSystem mocks base method.
+func (m *MockActor) GetNASFileSystem(ctx context.Context, id string) (*aliclient.NASFileSystem, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetNASFileSystem", ctx, id)
+	ret0, _ := ret[0].(*aliclient.NASFileSystem)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetNASFileSystem indicates an expected call of GetNASFileSystem.
+func (mr *MockActorMockRecorder) GetNASFileSystem(ctx, id any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetNASFileSystem", reflect.TypeOf((*MockActor)(nil).GetNASFileSystem), ctx, id)
+}
+
 // GetNatGateway mocks base method.
 func (m *MockActor) GetNatGateway(ctx context.Context, id string) (*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -685,6 +990,36 @@ func (mr *MockActorMockRecorder) GetRouteTable(ctx, id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetRouteTable", reflect.TypeOf((*MockActor)(nil).GetRouteTable), ctx, id)
 }
 
+// GetSLSLogstore mocks base method.
+func (m *MockActor) GetSLSLogstore(ctx context.Context, projectName, name string) (*aliclient.SLSLogstore, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLSLogstore", ctx, projectName, name)
+	ret0, _ := ret[0].(*aliclient.SLSLogstore)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSLSLogstore indicates an expected call of GetSLSLogstore.
+func (mr *MockActorMockRecorder) GetSLSLogstore(ctx, projectName, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLSLogstore", reflect.TypeOf((*MockActor)(nil).GetSLSLogstore), ctx, projectName, name)
+}
+
+// GetSLSProject mocks base method.
+func (m *MockActor) GetSLSProject(ctx context.Context, name string) (*aliclient.SLSProject, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetSLSProject", ctx, name)
+	ret0, _ := ret[0].(*aliclient.SLSProject)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetSLSProject indicates an expected call of GetSLSProject.
+func (mr *MockActorMockRecorder) GetSLSProject(ctx, name any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSLSProject", reflect.TypeOf((*MockActor)(nil).GetSLSProject), ctx, name)
+}
+
 // GetSNatEntry mocks base method.
 func (m *MockActor) GetSNatEntry(ctx context.Context, id, snatTableId string) (*aliclient.SNATEntry, error) {
 	m.ctrl.T.Helper()
@@ -805,6 +1140,51 @@ func (mr *MockActorMockRecorder) ListEnhanhcedNatGatewayAvailableZones(ctx, regi
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnhanhcedNatGatewayAvailableZones", reflect.TypeOf((*MockActor)(nil).ListEnhanhcedNatGatewayAvailableZones), ctx, region)
 }
 
+// ListIpv6AddressesByVSwitch mocks base method.
+func (m *MockActor) ListIpv6AddressesByVSwitch(ctx context.Context, vSwitchId string) ([]*aliclient.IPv6Address, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv6AddressesByVSwitch", ctx, vSwitchId)
+	ret0, _ := ret[0].([]*aliclient.IPv6Address)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6AddressesByVSwitch indicates an expected call of ListIpv6AddressesByVSwitch.
+func (mr *MockActorMockRecorder) ListIpv6AddressesByVSwitch(ctx, vSwitchId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6AddressesByVSwitch", reflect.TypeOf((*MockActor)(nil).ListIpv6AddressesByVSwitch), ctx, vSwitchId)
+}
+
+// ListIpv6EgressOnlyRules mocks base method.
+func (m *MockActor) ListIpv6EgressOnlyRules(ctx context.Context, ipv6GatewayId string) ([]*aliclient.IPv6EgressOnlyRule, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListIpv6EgressOnlyRules", ctx, ipv6GatewayId)
+	ret0, _ := ret[0].([]*aliclient.IPv6EgressOnlyRule)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListIpv6EgressOnlyRules indicates an expected call of ListIpv6EgressOnlyRules.
+func (mr *MockActorMockRecorder) ListIpv6EgressOnlyRules(ctx, ipv6GatewayId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListIpv6EgressOnlyRules", reflect.TypeOf((*MockActor)(nil).ListIpv6EgressOnlyRules), ctx, ipv6GatewayId)
+}
+
+// ListNASMountTargets mocks base method.
+func (m *MockActor) ListNASMountTargets(ctx context.Context, fileSystemId string) ([]*aliclient.NASMountTarget, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNASMountTargets", ctx, fileSystemId)
+	ret0, _ := ret[0].([]*aliclient.NASMountTarget)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNASMountTargets indicates an expected call of ListNASMountTargets.
+func (mr *MockActorMockRecorder) ListNASMountTargets(ctx, fileSystemId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNASMountTargets", reflect.TypeOf((*MockActor)(nil).ListNASMountTargets), ctx, fileSystemId)
+}
+
 // ListNatGateways mocks base method.
 func (m *MockActor) ListNatGateways(ctx context.Context, ids []string) ([]*aliclient.NatGateway, error) {
 	m.ctrl.T.Helper()
@@ -850,6 +1230,21 @@ func (mr *MockActorMockRecorder) ListNatGatewaysByVSwitchInVPC(ctx, vpcId, vswit
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNatGatewaysByVSwitchInVPC", reflect.TypeOf((*MockActor)(nil).ListNatGatewaysByVSwitchInVPC), ctx, vpcId, vswitchIds)
 }
 
+// ListNatIps mocks base method.
+func (m *MockActor) ListNatIps(ctx context.Context, ngwId string) ([]*aliclient.NatIP, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNatIps", ctx, ngwId)
+	ret0, _ := ret[0].([]*aliclient.NatIP)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNatIps indicates an expected call of ListNatIps.
+func (mr *MockActorMockRecorder) ListNatIps(ctx, ngwId any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNatIps", reflect.TypeOf((*MockActor)(nil).ListNatIps), ctx, ngwId)
+}
+
 // ListRouteEntriesByRouteTable mocks base method.
 func (m *MockActor) ListRouteEntriesByRouteTable(ctx context.Context, routeTableId string) ([]*aliclient.RouteEntry, error) {
 	m.ctrl.T.Helper()
@@ -924,6 +1319,20 @@ func (mr *MockActorMockRecorder) ModifyEIP(ctx, id, eip any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEIP", reflect.TypeOf((*MockActor)(nil).ModifyEIP), ctx, id, eip)
 }
 
+// ModifyIpv6InternetBandwidth mocks base method.
+func (m *MockActor) ModifyIpv6InternetBandwidth(ctx context.Context, ipv6AddressId, internetBandwidthId string, bandwidth int) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyIpv6InternetBandwidth", ctx, ipv6AddressId, internetBandwidthId, bandwidth)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// ModifyIpv6InternetBandwidth indicates an expected call of ModifyIpv6InternetBandwidth.
+func (mr *MockActorMockRecorder) ModifyIpv6InternetBandwidth(ctx, ipv6AddressId, internetBandwidthId, bandwidth any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyIpv6InternetBandwidth", reflect.TypeOf((*MockActor)(nil).ModifyIpv6InternetBandwidth), ctx, ipv6AddressId, internetBandwidthId, bandwidth)
+}
+
 // RevokeSecurityGroupRule mocks base method.
 func (m *MockActor) RevokeSecurityGroupRule(ctx context.Context, sgId, ruleId, direction string) error {
 	m.ctrl.T.Helper()
@@ -938,6 +1347,20 @@ func (mr *MockActorMockRecorder) RevokeSecurityGroupRule(ctx, sgId, ruleId, dire
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "RevokeSecurityGroupRule", reflect.TypeOf((*MockActor)(nil).RevokeSecurityGroupRule), ctx, sgId, ruleId, direction)
 }
 
+// SetEIPDeletionProtection mocks base method.
+func (m *MockActor) SetEIPDeletionProtection(ctx context.Context, id string, enable bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetEIPDeletionProtection", ctx, id, enable)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetEIPDeletionProtection indicates an expected call of SetEIPDeletionProtection.
+func (mr *MockActorMockRecorder) SetEIPDeletionProtection(ctx, id, enable any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetEIPDeletionProtection", reflect.TypeOf((*MockActor)(nil).SetEIPDeletionProtection), ctx, id, enable)
+}
+
 // SetNLBDeletionProtection mocks base method.
 func (m *MockActor) SetNLBDeletionProtection(ctx context.Context, loadBalancerID string, enable bool) error {
 	m.ctrl.T.Helper()
@@ -952,6 +1375,20 @@ func (mr *MockActorMockRecorder) SetNLBDeletionProtection(ctx, loadBalancerID, e
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNLBDeletionProtection", reflect.TypeOf((*MockActor)(nil).SetNLBDeletionProtection), ctx, loadBalancerID, enable)
 }
 
+// SetNatGatewayDeletionProtection mocks base method.
+func (m *MockActor) SetNatGatewayDeletionProtection(ctx context.Context, id string, enable bool) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "SetNatGatewayDeletionProtection", ctx, id, enable)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// SetNatGatewayDeletionProtection indicates an expected call of SetNatGatewayDeletionProtection.
+func (mr *MockActorMockRecorder) SetNatGatewayDeletionProtection(ctx, id, enable any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "SetNatGatewayDeletionProtection", reflect.TypeOf((*MockActor)(nil).SetNatGatewayDeletionProtection), ctx, id, enable)
+}
+
 // SetVSwitchIpv6CidrBlock mocks base method.
 func (m *MockActor) SetVSwitchIpv6CidrBlock(ctx context.Context, vSwitchId string, ipv6CidrBlock int) error {
 	m.ctrl.T.Helper()
@@ -994,6 +1431,20 @@ func (mr *MockActorMockRecorder) UnassociateRouteTable(ctx, routeTableId, vSwitc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateRouteTable", reflect.TypeOf((*MockActor)(nil).UnassociateRouteTable), ctx, routeTableId, vSwitchId)
 }
 
+// UnassociateVpcCidrBlock mocks base method.
+func (m *MockActor) UnassociateVpcCidrBlock(ctx context.Context, vpcId, cidrBlock string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassociateVpcCidrBlock", ctx, vpcId, cidrBlock)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// UnassociateVpcCidrBlock indicates an expected call of UnassociateVpcCidrBlock.
+func (mr *MockActorMockRecorder) UnassociateVpcCidrBlock(ctx, vpcId, cidrBlock any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateVpcCidrBlock", reflect.TypeOf((*MockActor)(nil).UnassociateVpcCidrBlock), ctx, vpcId, cidrBlock)
+}
+
 // MockFactory is a mock of Factory interface.
 type MockFactory struct {
 	ctrl     *gomock.Controller
@@ -26,6 +26,10 @@ type VPC struct {
 	CidrBlock     string
 	Status        *string
 	Ipv6CidrBlock string // IPv6 CIDR for VPC (like "2408:xxxx::/56"), empty for not enabled
+	// SecondaryCidrBlocks are the additional IPv4 CIDR blocks associated with the VPC.
+	SecondaryCidrBlocks []string
+	// RouteTableIds are the IDs of the route tables of the VPC.
+	RouteTableIds []string
 }
 
 // VSwitch is the struct for a vswitch object
@@ -38,6 +42,7 @@ type VSwitch struct {
 	ZoneId        string
 	Status        *string
 	Ipv6CidrBlock string // IPv6 CIDR for vswitch (like "2408:xxxx:0:N::/64"), empty for not enabled
+	RouteTableId  string // ID of the route table associated with the vswitch
 }
 
 // NatGateway is the struct for a nat gateway object
@@ -50,8 +55,20 @@ type NatGateway struct {
 	Status             *string
 	AvailableVSwitches []string
 	SNATTableIDs       []string
+	DeletionProtection bool
+	// NetworkType is "internet" for an internet NAT gateway or "intranet" for a VPC NAT gateway.
+	NetworkType string
+	// PrivateIpAddress is the private IP address of the NAT gateway in its vswitch.
+	PrivateIpAddress string
 }
 
+const (
+	// NatGatewayNetworkTypeInternet is the network type of an internet NAT gateway.
+	NatGatewayNetworkTypeInternet = "internet"
+	// NatGatewayNetworkTypeIntranet is the network type of a VPC NAT gateway.
+	NatGatewayNetworkTypeIntranet = "intranet"
+)
+
 // EIP is the struct for a eip object
 type EIP struct {
 	Tags
@@ -64,6 +81,7 @@ type EIP struct {
 	InstanceType       *string
 	InstanceId         *string
 	IpAddress          string
+	DeletionProtection bool
 }
 
 // SNATEntry is the struct for a snat entry object
@@ -77,6 +95,16 @@ type SNATEntry struct {
 	Status       *string
 }
 
+// NatIP is the struct for a NAT IP address of a VPC NAT gateway
+type NatIP struct {
+	Name         string
+	NatGatewayId string
+	NatIpId      string
+	IpAddress    string
+	IsDefault    bool
+	Status       *string
+}
+
 // SecurityGroup is the struct for a SecurityGroup object
 type SecurityGroup struct {
 	Tags
@@ -108,6 +136,8 @@ type RouteTable struct {
 	VpcId        string
 	VSwitchIds   []string
 	Status       *string
+	// RouteTableType is "System" for the system route table of the VPC or "Custom".
+	RouteTableType string
 }
 
 // RouteEntry is the struct for a route entry in a route table
@@ -130,6 +160,41 @@ type IPv6Gateway struct {
 	Status        *string
 }
 
+// FlowLog is the struct for a VPC flow log delivered to Simple Log Service
+type FlowLog struct {
+	Tags
+	Name         string
+	FlowLogId    string
+	ResourceType string
+	ResourceId   string
+	TrafficType  string
+	ProjectName  string
+	LogStoreName string
+	Status       *string
+}
+
+// IPv6Address is the struct for an IPv6 address in a vswitch and its internet bandwidth
+type IPv6Address struct {
+	Ipv6AddressId          string
+	Ipv6Address            string
+	VSwitchId              string
+	AssociatedInstanceId   string
+	AssociatedInstanceType string
+	// InternetBandwidthId is empty if no internet bandwidth is allocated for the address
+	InternetBandwidthId string
+	Bandwidth           int
+	InternetChargeType  string
+}
+
+// IPv6EgressOnlyRule is the struct for an egress-only rule of an IPv6 gateway
+type IPv6EgressOnlyRule struct {
+	Name                 string
+	Ipv6EgressOnlyRuleId string
+	InstanceType         string
+	InstanceId           string
+	Status               *string
+}
+
 // NLBInfo is the struct for an NLB (Network Load Balancer) instance
 type NLBInfo struct {
 	LoadBalancerId string
@@ -137,3 +202,39 @@ type NLBInfo struct {
 	VpcId          string
 	Status         *string
 }
+
+// NASFileSystem is the struct for a NAS (File Storage) file system
+type NASFileSystem struct {
+	Tags
+	FileSystemId string
+	Description  string
+	ZoneId       string
+	StorageType  string
+	Status       *string
+}
+
+// SLSProject is the struct for a Simple Log Service (SLS) project
+type SLSProject struct {
+	Tags
+	Name        string
+	Description string
+	Status      *string
+}
+
+// SLSLogstore is the struct for a logstore of an SLS project
+type SLSLogstore struct {
+	ProjectName string
+	Name        string
+	// TTL is the retention period of the logs in days.
+	TTL        int
+	ShardCount int
+}
+
+// NASMountTarget is the struct for a mount target of a NAS file system in a vswitch
+type NASMountTarget struct {
+	FileSystemId      string
+	MountTargetDomain string
+	VpcId             string
+	VSwitchId         string
+	Status            *string
+}
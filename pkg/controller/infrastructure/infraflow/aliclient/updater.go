@@ -8,6 +8,7 @@ import (
 	"context"
 	"encoding/json"
 	"reflect"
+	"slices"
 )
 
 // Updater is used for reconcile based with flow
@@ -62,6 +63,12 @@ func (u *updater) UpdateEIP(ctx context.Context, desired, current *EIP) (modifie
 		}
 		modified = true
 	}
+	if desired.DeletionProtection != current.DeletionProtection {
+		if err = u.actor.SetEIPDeletionProtection(ctx, current.EipId, desired.DeletionProtection); err != nil {
+			return
+		}
+		modified = true
+	}
 	tagModified, err := u.updateTags(ctx, current.EipId, desired.Tags, current.Tags, "EIP")
 	if err != nil {
 		return
@@ -72,7 +79,17 @@ func (u *updater) UpdateEIP(ctx context.Context, desired, current *EIP) (modifie
 }
 
 func (u *updater) UpdateNatgateway(ctx context.Context, desired, current *NatGateway) (modified bool, err error) {
-	modified, err = u.updateTags(ctx, current.NatGatewayId, desired.Tags, current.Tags, "NATGATEWAY")
+	if desired.DeletionProtection != current.DeletionProtection {
+		if err = u.actor.SetNatGatewayDeletionProtection(ctx, current.NatGatewayId, desired.DeletionProtection); err != nil {
+			return
+		}
+		modified = true
+	}
+	tagModified, err := u.updateTags(ctx, current.NatGatewayId, desired.Tags, current.Tags, "NATGATEWAY")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified
 	return
 }
 
@@ -82,7 +99,28 @@ func (u *updater) UpdateVSwitch(ctx context.Context, desired, current *VSwitch)
 }
 
 func (u *updater) UpdateVpc(ctx context.Context, desired, current *VPC) (modified bool, err error) {
-	modified, err = u.updateTags(ctx, current.VpcId, desired.Tags, current.Tags, "VPC")
+	for _, cidr := range desired.SecondaryCidrBlocks {
+		if !slices.Contains(current.SecondaryCidrBlocks, cidr) {
+			if err = u.actor.AssociateVpcCidrBlock(ctx, current.VpcId, cidr); err != nil {
+				return
+			}
+			modified = true
+		}
+	}
+	for _, cidr := range current.SecondaryCidrBlocks {
+		if !slices.Contains(desired.SecondaryCidrBlocks, cidr) {
+			if err = u.actor.UnassociateVpcCidrBlock(ctx, current.VpcId, cidr); err != nil {
+				return
+			}
+			modified = true
+		}
+	}
+	tagModified, err := u.updateTags(ctx, current.VpcId, desired.Tags, current.Tags, "VPC")
+	if err != nil {
+		return
+	}
+	modified = modified || tagModified
+
 	return
 }
 
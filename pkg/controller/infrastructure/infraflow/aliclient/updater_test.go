@@ -0,0 +1,85 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package aliclient_test
+
+import (
+	"context"
+
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+)
+
+var _ = Describe("Updater", func() {
+	var (
+		ctx     context.Context
+		ctrl    *gomock.Controller
+		actor   *mockaliclient.MockActor
+		updater Updater
+
+		tags Tags
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+		updater = NewUpdater(actor)
+		tags = Tags{"Name": "shoot--foo--bar-natgw"}
+	})
+
+	Describe("#UpdateEIP", func() {
+		DescribeTable("should toggle the deletion protection",
+			func(desiredProtection, currentProtection bool) {
+				desired := &EIP{Bandwidth: "100", DeletionProtection: desiredProtection, Tags: tags}
+				current := &EIP{EipId: "eip-1", Bandwidth: "100", DeletionProtection: currentProtection, Tags: tags}
+				actor.EXPECT().SetEIPDeletionProtection(ctx, "eip-1", desiredProtection)
+
+				Expect(updater.UpdateEIP(ctx, desired, current)).To(BeTrue())
+			},
+			Entry("enable", true, false),
+			Entry("disable", false, true),
+		)
+
+		DescribeTable("should not modify the deletion protection if it is unchanged",
+			func(protection bool) {
+				desired := &EIP{Bandwidth: "100", DeletionProtection: protection, Tags: tags}
+				current := &EIP{EipId: "eip-1", Bandwidth: "100", DeletionProtection: protection, Tags: tags}
+
+				Expect(updater.UpdateEIP(ctx, desired, current)).To(BeFalse())
+			},
+			Entry("enabled", true),
+			Entry("disabled", false),
+		)
+	})
+
+	Describe("#UpdateNatgateway", func() {
+		DescribeTable("should toggle the deletion protection",
+			func(desiredProtection, currentProtection bool) {
+				desired := &NatGateway{DeletionProtection: desiredProtection, Tags: tags}
+				current := &NatGateway{NatGatewayId: "ngw-1", DeletionProtection: currentProtection, Tags: tags}
+				actor.EXPECT().SetNatGatewayDeletionProtection(ctx, "ngw-1", desiredProtection)
+
+				Expect(updater.UpdateNatgateway(ctx, desired, current)).To(BeTrue())
+			},
+			Entry("enable", true, false),
+			Entry("disable", false, true),
+		)
+
+		DescribeTable("should not modify the deletion protection if it is unchanged",
+			func(protection bool) {
+				desired := &NatGateway{DeletionProtection: protection, Tags: tags}
+				current := &NatGateway{NatGatewayId: "ngw-1", DeletionProtection: protection, Tags: tags}
+
+				Expect(updater.UpdateNatgateway(ctx, desired, current)).To(BeFalse())
+			},
+			Entry("enabled", true),
+			Entry("disabled", false),
+		)
+	})
+})
@@ -15,6 +15,7 @@ import (
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
 	aliapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
@@ -29,6 +30,8 @@ const (
 
 	// ChildIdZones is the child key for the zones
 	ChildIdZones = "Zones"
+	// ChildIdEIPPool is the child key for the retained EIPs of deleted zones, keyed by their ids
+	ChildIdEIPPool = "EIPPool"
 
 	// IdentifierVPC is the key for the VPC id
 	IdentifierVPC = "VPC"
@@ -38,19 +41,39 @@ const (
 	IdentifierZoneVSwitch = "VSwitch"
 	// IdentifierNatGateway is the key for the id of natgateway
 	IdentifierNatGateway = "NatGateway"
+	// IdentifierVPCNatGateway is the key for the id of the VPC NAT gateway used by zones with a NAT gateway of type VPC
+	IdentifierVPCNatGateway = "VPCNatGateway"
 	// IdentifierZoneNATGWElasticIP is the key for the id of the elastic IP resource used for the NAT gateway
 	IdentifierZoneNATGWElasticIP = "NATGatewayElasticIP"
 	//ZoneNATGWElasticIPAddress is the ipaddress of the elastic IP resource used for the NAT gateway
 	ZoneNATGWElasticIPAddress = "NATGatewayElasticIPAddress"
+	// ZoneVPCNATGWIPAddress is the private SNAT IP address of the VPC NAT gateway used by the zone
+	ZoneVPCNATGWIPAddress = "VPCNATGatewayIPAddress"
 	// IdentifierNodesSecurityGroup is the key for the id of the nodes security group
 	IdentifierNodesSecurityGroup = "NodesSecurityGroup"
 	// IdentifierIPV6Gateway is the key for the id of ipv6gateway
 	IdentifierIPV6Gateway = "IPV6Gateway"
+	// IdentifierIPv6InternetBandwidth is the key for the id of the IPv6 gateway on which the IPv6 internet bandwidth
+	// of the node addresses is opened
+	IdentifierIPv6InternetBandwidth = "IPv6InternetBandwidth"
 	// IdentifierRouteTable is the key for the id of the custom route table
 	IdentifierRouteTable = "RouteTable"
 
+	// IdentifierZoneFlowLog is the key for the id of the flow log of the vswitch of a zone
+	IdentifierZoneFlowLog = "FlowLog"
+	// IdentifierSLSProject is the key for the name of the SLS project created for the flow logs
+	IdentifierSLSProject = "SLSProject"
+	// IdentifierSLSLogstore is the key for the logstore created for the flow logs as "<project>/<logstore>"
+	IdentifierSLSLogstore = "SLSLogstore"
+	// IdentifierNASFileSystem is the key for the id of the NAS file system
+	IdentifierNASFileSystem = "NASFileSystem"
+	// IdentifierZoneNASMountTarget is the key for the domain of the mount target of the NAS file system in a zone
+	IdentifierZoneNASMountTarget = "NASMountTarget"
+
 	// IdentifierZoneSuffix is the key for the suffix used for a zone
 	IdentifierZoneSuffix = "Suffix"
+	// MarkerZoneVSwitchNotOwned is the key for marking the vswitch of a zone as existing vswitch not owned by the shoot
+	MarkerZoneVSwitchNotOwned = "VSwitchNotOwned"
 
 	// MarkerMigratedFromTerraform is the key for marking the state for successful state migration from Terraformer
 	MarkerMigratedFromTerraform = "MigratedFromTerraform"
@@ -80,6 +103,13 @@ func NewFlowContext(log logr.Logger, credentials *alicloud.Credentials,
 	if err != nil {
 		return nil, err
 	}
+	return NewFlowContextWithActor(log, actor, infra, config, oldState, persistor, cluster), nil
+}
+
+// NewFlowContextWithActor creates a new FlowContext object which uses the given actor to access Alicloud.
+func NewFlowContextWithActor(log logr.Logger, actor aliclient.Actor,
+	infra *extensionsv1alpha1.Infrastructure, config *aliapi.InfrastructureConfig,
+	oldState shared.FlatMap, persistor shared.FlowStatePersistor, cluster *extensioncontroller.Cluster) *FlowContext {
 	canDelete := false
 	updater := aliclient.NewUpdater(actor)
 	whiteboard := shared.NewWhiteboard()
@@ -101,6 +131,7 @@ func NewFlowContext(log logr.Logger, credentials *alicloud.Credentials,
 		cluster:          cluster,
 		canDelete:        canDelete,
 	}
+	flowContext.Owner = infra.Namespace
 	flowContext.commonTags = aliclient.Tags{
 		flowContext.tagKeyCluster(): TagValueCluster,
 		TagKeyName:                  infra.Namespace,
@@ -108,7 +139,7 @@ func NewFlowContext(log logr.Logger, credentials *alicloud.Credentials,
 	if config.Networks.VPC.ID != nil {
 		flowContext.state.SetPtr(IdentifierVPC, config.Networks.VPC.ID)
 	}
-	return flowContext, nil
+	return flowContext
 }
 
 func (c *FlowContext) tagKeyCluster() string {
@@ -146,6 +177,54 @@ func (c *FlowContext) dualStackEnabled() bool {
 	return c.config.DualStack != nil && c.config.DualStack.Enabled
 }
 
+// egressModeNone returns true if no NAT gateways are used and the egress traffic relies on the default route of the VPC.
+func (c *FlowContext) egressModeNone() bool {
+	return c.config.Networks.VPC.EgressMode != nil && *c.config.Networks.VPC.EgressMode == aliapi.EgressModeNone
+}
+
+// useVPCNatGateway returns true if the egress traffic of the zone is translated by the VPC NAT gateway.
+func (c *FlowContext) useVPCNatGateway(zoneName string) bool {
+	zone := c.getZoneConfig(zoneName)
+	return zone != nil && helper.NatGatewayTypeOfZone(*zone) == aliapi.NatGatewayTypeVPC
+}
+
+func (c *FlowContext) hasVPCNatGatewayZones() bool {
+	for _, zone := range c.config.Networks.Zones {
+		if c.useVPCNatGateway(zone.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// hasInternetNatGatewayZones returns true if the egress traffic of any zone is translated by the internet NAT gateway.
+func (c *FlowContext) hasInternetNatGatewayZones() bool {
+	for _, zone := range c.config.Networks.Zones {
+		if !c.useVPCNatGateway(zone.Name) {
+			return true
+		}
+	}
+	return false
+}
+
+// getSNATIPAddress returns the private IP address the egress traffic of the zone is translated to by the VPC NAT
+// gateway, or nil if the default private IP address of the VPC NAT gateway is used.
+func (c *FlowContext) getSNATIPAddress(zoneName string) *string {
+	zone := c.getZoneConfig(zoneName)
+	if zone == nil || zone.NatGateway == nil {
+		return nil
+	}
+	return zone.NatGateway.SNATIPAddress
+}
+
+func (c *FlowContext) deletionProtectionEnabled() bool {
+	return c.config.DeletionProtection != nil && *c.config.DeletionProtection
+}
+
+func (c *FlowContext) retainEIPsEnabled() bool {
+	return c.config.RetainEIPs != nil && *c.config.RetainEIPs
+}
+
 func (c *FlowContext) commonTagsWithSuffix(suffix string) aliclient.Tags {
 	tags := c.commonTags.Clone()
 	tags[TagKeyName] = fmt.Sprintf("%s-%s", c.namespace, suffix)
@@ -193,6 +272,28 @@ func (c *FlowContext) getAllVSwitchids() []string {
 	return ids
 }
 
+// getInternetNatGatewayVSwitchIds returns the vswitch IDs like getAllVSwitchids, but without the vswitches of the
+// zones using the VPC NAT gateway.
+func (c *FlowContext) getInternetNatGatewayVSwitchIds() []string {
+	ids := []string{}
+	for _, id := range c.getAllVSwitchids() {
+		if !c.isVPCNatGatewayVSwitch(id) {
+			ids = append(ids, id)
+		}
+	}
+	return ids
+}
+
+func (c *FlowContext) isVPCNatGatewayVSwitch(vswitchId string) bool {
+	zones := c.state.GetChild(ChildIdZones)
+	for _, key := range zones.GetChildrenKeys() {
+		if id := zones.GetChild(key).Get(IdentifierZoneVSwitch); id != nil && *id == vswitchId {
+			return c.useVPCNatGateway(key)
+		}
+	}
+	return false
+}
+
 func (c *FlowContext) clusterTags() aliclient.Tags {
 	tags := aliclient.Tags{}
 	tags[c.tagKeyCluster()] = TagValueCluster
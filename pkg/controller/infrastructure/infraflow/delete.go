@@ -16,13 +16,14 @@ import (
 func (c *FlowContext) Delete(ctx context.Context) error {
 	if c.state.IsEmpty() {
 		// nothing to do, e.g. if cluster was created with wrong credentials
+		c.ForgetFailedTasks()
 		return nil
 	}
 	g := c.buildDeleteGraph()
-	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
-		return flow.Causes(err)
+	if err := c.RunFlow(ctx, g); err != nil {
+		return err
 	}
+	c.ForgetFailedTasks()
 	return nil
 }
 
@@ -30,18 +31,35 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 	deleteVPC := c.config.Networks.VPC.ID == nil
 	g := flow.NewGraph("Alicloud infrastructure destruction")
 
+	// the mount targets of the NAS file system must be deleted before their vswitches
+	deleteNAS := c.AddTask(g, "delete NAS",
+		c.deleteNAS,
+		DoIf(c.config.NAS != nil || c.hasNASFileSystem()), Timeout(defaultLongTimeout))
+
 	deleteZones := c.AddTask(g, "delete vswitch",
 		c.deleteZones,
-		Timeout(defaultLongTimeout))
+		Timeout(defaultLongTimeout), Dependencies(deleteNAS))
+
+	// the SLS project and logstore are deleted once no flow log delivers to them anymore
+	_ = c.AddTask(g, "delete SLS logstore",
+		c.deleteSLSLogstore,
+		DoIf(c.hasSLSLogstore()), Timeout(defaultTimeout), Dependencies(deleteZones))
+
+	deleteEIPPool := c.AddTask(g, "delete eip pool",
+		c.releaseEIPPool,
+		Timeout(defaultLongTimeout), Dependencies(deleteZones))
 
 	deleteSecurityGroup := c.AddTask(g, "delete security group",
 		c.deleteSecurityGroup,
 		Timeout(defaultTimeout))
+	deleteIPv6EgressOnlyRules := c.AddTask(g, "delete ipv6 egress-only rules",
+		c.deleteIPv6EgressOnlyRules,
+		DoIf(c.dualStackEnabled()), Timeout(defaultLongTimeout))
 	// only delete Ipv6Gateway for managed VPC
 	deleteIpv6Gateway := c.AddTask(g, "delete ipv6 gateway",
 		c.deleteIpv6Gateway,
 		DoIf(c.dualStackEnabled() && c.config.Networks.VPC.ID == nil), Timeout(defaultLongTimeout),
-		Dependencies(deleteZones))
+		Dependencies(deleteZones, deleteIPv6EgressOnlyRules))
 
 	deleteRouteTable := c.AddTask(g, "delete route table",
 		c.deleteRouteTable,
@@ -49,7 +67,7 @@ func (c *FlowContext) buildDeleteGraph() *flow.Graph {
 
 	_ = c.AddTask(g, "delete VPC",
 		c.deleteVpc,
-		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones, deleteSecurityGroup, deleteRouteTable, deleteIpv6Gateway))
+		DoIf(deleteVPC && c.hasVPC()), Timeout(defaultTimeout), Dependencies(deleteZones, deleteEIPPool, deleteSecurityGroup, deleteRouteTable, deleteIpv6Gateway))
 
 	return g
 }
@@ -69,6 +87,7 @@ func (c *FlowContext) deleteIpv6Gateway(ctx context.Context) error {
 		if err := c.actor.DeleteIpv6Gateway(ctx, current.Ipv6GatewayId); err != nil {
 			return err
 		}
+		c.ResourceDeleted("IPv6 gateway", current.Ipv6GatewayId)
 	}
 	c.state.SetAsDeleted(IdentifierIPV6Gateway)
 	return c.PersistState(ctx, true)
@@ -109,6 +128,7 @@ func (c *FlowContext) deleteRouteTable(ctx context.Context) error {
 		if err := c.actor.DeleteRouteTable(ctx, current.RouteTableId); err != nil {
 			return err
 		}
+		c.ResourceDeleted("route table", current.RouteTableId)
 	}
 	c.state.SetAsDeleted(IdentifierRouteTable)
 	return c.PersistState(ctx, true)
@@ -134,6 +154,7 @@ func (c *FlowContext) deleteSecurityGroup(ctx context.Context) error {
 		if err := c.actor.DeleteSecurityGroup(ctx, current.SecurityGroupId); err != nil {
 			return err
 		}
+		c.ResourceDeleted("security group", current.SecurityGroupId)
 	}
 	c.state.SetAsDeleted(IdentifierNodesSecurityGroup)
 	return nil
@@ -154,6 +175,7 @@ func (c *FlowContext) deleteVpc(ctx context.Context) error {
 		if err := c.actor.DeleteVpc(ctx, current.VpcId); err != nil {
 			return err
 		}
+		c.ResourceDeleted("VPC", current.VpcId)
 	}
 	c.state.SetAsDeleted(IdentifierVPC)
 	return nil
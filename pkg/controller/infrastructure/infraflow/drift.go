@@ -0,0 +1,279 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"slices"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// Drift describes a single deviation between the flow state and the live state of a resource.
+type Drift struct {
+	// Resource is a short description of the drifted resource, e.g. "VSwitch vsw-xxx (zone cn-beijing-a)".
+	Resource string
+	// Message describes the deviation.
+	Message string
+}
+
+func (d Drift) String() string {
+	return d.Resource + ": " + d.Message
+}
+
+type driftReport []Drift
+
+func (r *driftReport) add(resource, format string, args ...any) {
+	*r = append(*r, Drift{Resource: resource, Message: fmt.Sprintf(format, args...)})
+}
+
+// DetectDrift compares the resources recorded in the flow state with their live state.
+// It checks existence, CIDRs, SNAT entries, EIP associations and route entries and returns
+// every deviation found. Resources which have not been recorded in the state are ignored.
+func (c *FlowContext) DetectDrift(ctx context.Context) ([]Drift, error) {
+	report := driftReport{}
+
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return report, nil
+	}
+	vpc, err := c.actor.GetVpc(ctx, *vpcId)
+	if err != nil {
+		return nil, err
+	}
+	if vpc == nil {
+		report.add("VPC "+*vpcId, "not found")
+		return report, nil
+	}
+	if c.config.Networks.VPC.ID == nil && c.config.Networks.VPC.CIDR != nil && vpc.CidrBlock != *c.config.Networks.VPC.CIDR {
+		report.add("VPC "+*vpcId, "CIDR is %s, expected %s", vpc.CidrBlock, *c.config.Networks.VPC.CIDR)
+	}
+	for _, cidr := range c.config.Networks.VPC.SecondaryCIDRs {
+		if !slices.Contains(vpc.SecondaryCidrBlocks, cidr) {
+			report.add("VPC "+*vpcId, "secondary CIDR %s is not associated", cidr)
+		}
+	}
+
+	if sgId := c.state.Get(IdentifierNodesSecurityGroup); sgId != nil {
+		sg, err := c.actor.GetSecurityGroup(ctx, *sgId)
+		if err != nil {
+			return nil, err
+		}
+		if sg == nil {
+			report.add("SecurityGroup "+*sgId, "not found")
+		}
+	}
+
+	ngw, snatEntries, err := c.detectNatGatewayDrift(ctx, &report, "NatGateway", c.state.Get(IdentifierNatGateway))
+	if err != nil {
+		return nil, err
+	}
+	vpcNgw, vpcSnatEntries, err := c.detectNatGatewayDrift(ctx, &report, "VPC NatGateway", c.state.Get(IdentifierVPCNatGateway))
+	if err != nil {
+		return nil, err
+	}
+
+	var vswitchIds []string
+	processedZones := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+
+		var vswitchId string
+		if c.useVPCNatGateway(zone.Name) {
+			vswitchId, err = c.detectZoneDrift(ctx, &report, zone.Name, vpcNgw, vpcSnatEntries)
+		} else {
+			vswitchId, err = c.detectZoneDrift(ctx, &report, zone.Name, ngw, snatEntries)
+		}
+		if err != nil {
+			return nil, err
+		}
+		if vswitchId != "" {
+			vswitchIds = append(vswitchIds, vswitchId)
+		}
+	}
+
+	if c.useCustomRouteTable() {
+		if err := c.detectRouteTableDrift(ctx, &report, ngw, vswitchIds); err != nil {
+			return nil, err
+		}
+	}
+
+	return report, nil
+}
+
+// detectNatGatewayDrift checks that the recorded NAT gateway exists and returns it together with its SNAT entries.
+func (c *FlowContext) detectNatGatewayDrift(ctx context.Context, report *driftReport, kind string, ngwId *string) (*aliclient.NatGateway, []*aliclient.SNATEntry, error) {
+	if ngwId == nil {
+		return nil, nil, nil
+	}
+	ngw, err := c.actor.GetNatGateway(ctx, *ngwId)
+	if err != nil {
+		return nil, nil, err
+	}
+	if ngw == nil {
+		report.add(kind+" "+*ngwId, "not found")
+		return nil, nil, nil
+	}
+	snatEntries, err := c.actor.FindSNatEntriesByNatGateway(ctx, ngw.NatGatewayId)
+	if err != nil {
+		return nil, nil, err
+	}
+	return ngw, snatEntries, nil
+}
+
+// detectZoneDrift checks the vswitch, the NAT gateway EIP and the SNAT entries of a zone.
+// It returns the ID of the live vswitch of the zone or an empty string if there is none.
+func (c *FlowContext) detectZoneDrift(ctx context.Context, report *driftReport, zoneName string, ngw *aliclient.NatGateway, snatEntries []*aliclient.SNATEntry) (string, error) {
+	zone := c.getZoneConfig(zoneName)
+	child := c.getZoneChild(zoneName)
+
+	var vsw *aliclient.VSwitch
+	if vswitchId := child.Get(IdentifierZoneVSwitch); vswitchId != nil {
+		resource := fmt.Sprintf("VSwitch %s (zone %s)", *vswitchId, zoneName)
+		current, err := c.actor.GetVSwitch(ctx, *vswitchId)
+		if err != nil {
+			return "", err
+		}
+		if current == nil {
+			report.add(resource, "not found")
+		} else {
+			vsw = current
+			cidrBlock := zone.Workers
+			if cidrBlock == "" {
+				cidrBlock = zone.Worker
+			}
+			if vsw.ZoneId != zoneName {
+				report.add(resource, "zone is %s, expected %s", vsw.ZoneId, zoneName)
+			}
+			if vsw.CidrBlock != cidrBlock {
+				report.add(resource, "CIDR is %s, expected %s", vsw.CidrBlock, cidrBlock)
+			}
+		}
+	} else {
+		report.add("Zone "+zoneName, "no vswitch recorded in state")
+	}
+
+	eipId := child.Get(IdentifierZoneNATGWElasticIP)
+	if zone.NatGateway != nil && zone.NatGateway.EIPAllocationID != nil {
+		eipId = zone.NatGateway.EIPAllocationID
+	}
+	var eip *aliclient.EIP
+	if eipId != nil {
+		resource := fmt.Sprintf("EIP %s (zone %s)", *eipId, zoneName)
+		current, err := c.actor.GetEIP(ctx, *eipId)
+		if err != nil {
+			return "", err
+		}
+		if current == nil {
+			report.add(resource, "not found")
+		} else {
+			eip = current
+			if address := child.Get(ZoneNATGWElasticIPAddress); address != nil && *address != eip.IpAddress {
+				report.add(resource, "IP address is %s, expected %s", eip.IpAddress, *address)
+			}
+			if ngw != nil && (eip.Status == nil || *eip.Status != "InUse" || eip.InstanceId == nil || *eip.InstanceId != ngw.NatGatewayId) {
+				report.add(resource, "not associated with NatGateway %s (status %s, instance %s)",
+					ngw.NatGatewayId, derefOrEmpty(eip.Status), derefOrEmpty(eip.InstanceId))
+			}
+		}
+	}
+
+	if vsw == nil || ngw == nil {
+		return vswitchIdOf(vsw), nil
+	}
+	for _, snatTableId := range ngw.SNATTableIDs {
+		resource := fmt.Sprintf("SNAT entry for vswitch %s in table %s", vsw.VSwitchId, snatTableId)
+		var entry *aliclient.SNATEntry
+		for _, item := range snatEntries {
+			if item.SnatTableId == snatTableId && item.VSwitchId == vsw.VSwitchId {
+				entry = item
+				break
+			}
+		}
+		if entry == nil {
+			report.add(resource, "not found")
+			continue
+		}
+		if eip != nil && entry.IpAddress != eip.IpAddress {
+			report.add(resource, "SNAT IP is %s, expected %s", entry.IpAddress, eip.IpAddress)
+		}
+		if ngw.NetworkType == aliclient.NatGatewayNetworkTypeIntranet {
+			if expected := vpcSnatIPAddress(ngw, c.getSNATIPAddress(zoneName)); entry.IpAddress != expected {
+				report.add(resource, "SNAT IP is %s, expected %s", entry.IpAddress, expected)
+			}
+		}
+	}
+	return vsw.VSwitchId, nil
+}
+
+func (c *FlowContext) detectRouteTableDrift(ctx context.Context, report *driftReport, ngw *aliclient.NatGateway, vswitchIds []string) error {
+	routeTableId := c.state.Get(IdentifierRouteTable)
+	if routeTableId == nil {
+		return nil
+	}
+	resource := "RouteTable " + *routeTableId
+	rt, err := c.actor.GetRouteTable(ctx, *routeTableId)
+	if err != nil {
+		return err
+	}
+	if rt == nil {
+		report.add(resource, "not found")
+		return nil
+	}
+
+	if ngw != nil {
+		if err := c.detectRouteEntryDrift(ctx, report, resource, rt.RouteTableId, "0.0.0.0/0", ngw.NatGatewayId); err != nil {
+			return err
+		}
+	}
+	if c.dualStackEnabled() {
+		if ipv6GwId := c.state.Get(IdentifierIPV6Gateway); ipv6GwId != nil {
+			if err := c.detectRouteEntryDrift(ctx, report, resource, rt.RouteTableId, "::/0", *ipv6GwId); err != nil {
+				return err
+			}
+		}
+	}
+	for _, vswId := range vswitchIds {
+		if !contains(rt.VSwitchIds, vswId) {
+			report.add(resource, "vswitch %s is not associated", vswId)
+		}
+	}
+	return nil
+}
+
+func (c *FlowContext) detectRouteEntryDrift(ctx context.Context, report *driftReport, resource, routeTableId, destination, nextHopId string) error {
+	entry, err := c.actor.FindRouteEntryByDest(ctx, routeTableId, destination)
+	if err != nil {
+		return err
+	}
+	if entry == nil {
+		report.add(resource, "route entry %s not found", destination)
+		return nil
+	}
+	if entry.NextHopId != nextHopId {
+		report.add(resource, "route entry %s points to %s, expected %s", destination, entry.NextHopId, nextHopId)
+	}
+	return nil
+}
+
+func vswitchIdOf(vsw *aliclient.VSwitch) string {
+	if vsw == nil {
+		return ""
+	}
+	return vsw.VSwitchId
+}
+
+func derefOrEmpty(s *string) string {
+	if s == nil {
+		return ""
+	}
+	return *s
+}
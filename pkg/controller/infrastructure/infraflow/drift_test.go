@@ -0,0 +1,130 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("DetectDrift", func() {
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		vpc   *aliclient.VPC
+		sg    *aliclient.SecurityGroup
+		ngw   *aliclient.NatGateway
+		vsw   *aliclient.VSwitch
+		eip   *aliclient.EIP
+		snats []*aliclient.SNATEntry
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19"}},
+			},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC:                                        "vpc-1",
+			IdentifierNodesSecurityGroup:                         "sg-1",
+			IdentifierNatGateway:                                 "ngw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch:        "vsw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneNATGWElasticIP: "eip-1",
+			"Zones/cn-beijing-a/" + ZoneNATGWElasticIPAddress:    "1.2.3.4",
+		}
+
+		vpc = &aliclient.VPC{VpcId: "vpc-1", CidrBlock: "10.0.0.0/16"}
+		sg = &aliclient.SecurityGroup{SecurityGroupId: "sg-1"}
+		ngw = &aliclient.NatGateway{NatGatewayId: "ngw-1", SNATTableIDs: []string{"snat-table-1"}}
+		vsw = &aliclient.VSwitch{VSwitchId: "vsw-1", ZoneId: "cn-beijing-a", CidrBlock: "10.0.0.0/19"}
+		eip = &aliclient.EIP{EipId: "eip-1", IpAddress: "1.2.3.4", Status: ptr.To("InUse"), InstanceId: ptr.To("ngw-1")}
+		snats = []*aliclient.SNATEntry{{SnatTableId: "snat-table-1", VSwitchId: "vsw-1", IpAddress: "1.2.3.4"}}
+	})
+
+	expectLiveState := func() {
+		actor.EXPECT().GetVpc(ctx, "vpc-1").Return(vpc, nil)
+		actor.EXPECT().GetSecurityGroup(ctx, "sg-1").Return(sg, nil)
+		actor.EXPECT().GetNatGateway(ctx, "ngw-1").Return(ngw, nil)
+		actor.EXPECT().FindSNatEntriesByNatGateway(ctx, "ngw-1").Return(snats, nil)
+		actor.EXPECT().GetVSwitch(ctx, "vsw-1").Return(vsw, nil)
+		actor.EXPECT().GetEIP(ctx, "eip-1").Return(eip, nil)
+	}
+
+	detectDrift := func() ([]Drift, error) {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil).DetectDrift(ctx)
+	}
+
+	It("should report no drift if the live state matches the flow state", func() {
+		expectLiveState()
+
+		Expect(detectDrift()).To(BeEmpty())
+	})
+
+	It("should ignore resources which are not recorded in the flow state", func() {
+		state = shared.FlatMap{}
+
+		Expect(detectDrift()).To(BeEmpty())
+	})
+
+	It("should report a missing VPC and stop", func() {
+		actor.EXPECT().GetVpc(ctx, "vpc-1").Return(nil, nil)
+
+		Expect(detectDrift()).To(ConsistOf(Drift{Resource: "VPC vpc-1", Message: "not found"}))
+	})
+
+	It("should report deviations of the zone resources", func() {
+		vsw.CidrBlock = "10.0.32.0/19"
+		eip.InstanceId = nil
+		eip.Status = ptr.To("Available")
+		snats = nil
+		expectLiveState()
+
+		Expect(detectDrift()).To(ConsistOf(
+			Drift{Resource: "VSwitch vsw-1 (zone cn-beijing-a)", Message: "CIDR is 10.0.32.0/19, expected 10.0.0.0/19"},
+			Drift{Resource: "EIP eip-1 (zone cn-beijing-a)", Message: "not associated with NatGateway ngw-1 (status Available, instance )"},
+			Drift{Resource: "SNAT entry for vswitch vsw-1 in table snat-table-1", Message: "not found"},
+		))
+	})
+
+	It("should report missing secondary CIDRs and a missing security group", func() {
+		config.Networks.VPC.SecondaryCIDRs = []string{"172.16.0.0/16"}
+		sg = nil
+		expectLiveState()
+
+		Expect(detectDrift()).To(ConsistOf(
+			Drift{Resource: "VPC vpc-1", Message: "secondary CIDR 172.16.0.0/16 is not associated"},
+			Drift{Resource: "SecurityGroup sg-1", Message: "not found"},
+		))
+	})
+})
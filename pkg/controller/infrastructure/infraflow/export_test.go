@@ -0,0 +1,96 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// EnsureVSwitches exports ensureVSwitches for testing.
+func (c *FlowContext) EnsureVSwitches(ctx context.Context) error {
+	return c.ensureVSwitches(ctx)
+}
+
+// DeleteZones exports deleteZones for testing.
+func (c *FlowContext) DeleteZones(ctx context.Context) error {
+	return c.deleteZones(ctx)
+}
+
+// EnsureIPv6InternetBandwidth exports ensureIPv6InternetBandwidth for testing.
+func (c *FlowContext) EnsureIPv6InternetBandwidth(ctx context.Context) error {
+	return c.ensureIPv6InternetBandwidth(ctx)
+}
+
+// DeleteIPv6EgressOnlyRules exports deleteIPv6EgressOnlyRules for testing.
+func (c *FlowContext) DeleteIPv6EgressOnlyRules(ctx context.Context) error {
+	return c.deleteIPv6EgressOnlyRules(ctx)
+}
+
+// EnsureManagedNatGateway exports ensureManagedNatGateway for testing.
+func (c *FlowContext) EnsureManagedNatGateway(ctx context.Context) error {
+	return c.ensureManagedNatGateway(ctx)
+}
+
+// EnsureVPCNatGateway exports ensureVPCNatGateway for testing.
+func (c *FlowContext) EnsureVPCNatGateway(ctx context.Context) error {
+	return c.ensureVPCNatGateway(ctx)
+}
+
+// EnsureVPCSnatEntry exports ensureVPCSnatEntry for testing.
+func (c *FlowContext) EnsureVPCSnatEntry(ctx context.Context, zoneName string) error {
+	return c.ensureVPCSnatEntry(zoneName)(ctx)
+}
+
+// EnsureRouteTable exports ensureRouteTable for testing.
+func (c *FlowContext) EnsureRouteTable(ctx context.Context) error {
+	return c.ensureRouteTable(ctx)
+}
+
+// EnsureElasticIP exports ensureElasticIP for testing.
+func (c *FlowContext) EnsureElasticIP(ctx context.Context, zoneName, internetChargeType string) error {
+	return c.ensureElasticIP(zoneName, internetChargeType)(ctx)
+}
+
+// DeleteElasticIP exports deleteElasticIP for testing.
+func (c *FlowContext) DeleteElasticIP(ctx context.Context, zoneName string, retain bool) error {
+	return c.deleteElasticIP(zoneName, retain)(ctx)
+}
+
+// MoveEIPToPool exports moveEIPToPool for testing.
+func (c *FlowContext) MoveEIPToPool(ctx context.Context, eip *aliclient.EIP) error {
+	return c.moveEIPToPool(ctx, eip)
+}
+
+// TakeEIPFromPool exports takeEIPFromPool for testing.
+func (c *FlowContext) TakeEIPFromPool(ctx context.Context, desired *aliclient.EIP) (*aliclient.EIP, error) {
+	return c.takeEIPFromPool(ctx, desired)
+}
+
+// ReleaseEIPPool exports releaseEIPPool for testing.
+func (c *FlowContext) ReleaseEIPPool(ctx context.Context) error {
+	return c.releaseEIPPool(ctx)
+}
+
+// EnsureFlowLogs exports ensureFlowLogs for testing.
+func (c *FlowContext) EnsureFlowLogs(ctx context.Context) error {
+	return c.ensureFlowLogs(ctx)
+}
+
+// DeleteFlowLog exports deleteFlowLog for testing.
+func (c *FlowContext) DeleteFlowLog(ctx context.Context, zoneName string) error {
+	return c.deleteFlowLog(zoneName)(ctx)
+}
+
+// EnsureSLSLogstore exports ensureSLSLogstore for testing.
+func (c *FlowContext) EnsureSLSLogstore(ctx context.Context) error {
+	return c.ensureSLSLogstore(ctx)
+}
+
+// DeleteSLSLogstore exports deleteSLSLogstore for testing.
+func (c *FlowContext) DeleteSLSLogstore(ctx context.Context) error {
+	return c.deleteSLSLogstore(ctx)
+}
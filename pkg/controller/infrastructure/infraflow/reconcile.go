@@ -7,10 +7,12 @@ package infraflow
 import (
 	"context"
 	"fmt"
+	"slices"
 	"strings"
 	"time"
 
 	"github.com/gardener/gardener/pkg/utils/flow"
+	"k8s.io/apimachinery/pkg/util/sets"
 
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
 	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
@@ -24,11 +26,7 @@ const (
 // Reconcile creates and runs the flow to reconcile the Alicloud infrastructure.
 func (c *FlowContext) Reconcile(ctx context.Context) error {
 	g := c.buildReconcileGraph()
-	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
-		return flow.Causes(err)
-	}
-	return nil
+	return c.RunFlow(ctx, g)
 }
 
 func (c *FlowContext) buildReconcileGraph() *flow.Graph {
@@ -46,21 +44,46 @@ func (c *FlowContext) buildReconcileGraph() *flow.Graph {
 		c.ensureVSwitches,
 		Timeout(defaultLongTimeout), Dependencies(ensureVpc))
 
+	ensureSLSLogstore := c.AddTask(g, "ensure SLS logstore",
+		c.ensureSLSLogstore,
+		DoIf(c.createSLSLogstore()), Timeout(defaultLongTimeout))
+
+	_ = c.AddTask(g, "ensure flow logs",
+		c.ensureFlowLogs,
+		DoIf(c.config.FlowLogs != nil || c.hasFlowLogs()), Timeout(defaultLongTimeout), Dependencies(ensureVSwitches, ensureSLSLogstore))
+
+	_ = c.AddTask(g, "ensure NAS",
+		c.ensureNAS,
+		DoIf(c.config.NAS != nil), Timeout(defaultLongTimeout), Dependencies(ensureVSwitches))
+
 	ensureIpv6Gateway := c.AddTask(g, "ensure ipv6 gateway",
 		c.ensureIpv6Gateway,
 		DoIf(c.dualStackEnabled()), Timeout(defaultLongTimeout), Dependencies(ensureVpc))
 
+	_ = c.AddTask(g, "ensure ipv6 internet bandwidth",
+		c.ensureIPv6InternetBandwidth,
+		DoIf(c.ipv6InternetBandwidthEnabled() || (c.dualStackEnabled() && c.state.Get(IdentifierIPv6InternetBandwidth) != nil)),
+		Timeout(defaultLongTimeout), Dependencies(ensureVSwitches, ensureIpv6Gateway))
+
 	ensureNatGateway := c.AddTask(g, "ensure natgateway",
 		c.ensureNatGateway,
-		Timeout(defaultLongTimeout), Dependencies(ensureVSwitches))
+		DoIf(!c.egressModeNone() && c.hasInternetNatGatewayZones()), Timeout(defaultLongTimeout), Dependencies(ensureVSwitches))
+
+	ensureVPCNatGateway := c.AddTask(g, "ensure vpc natgateway",
+		c.ensureVPCNatGateway,
+		DoIf(c.hasVPCNatGatewayZones()), Timeout(defaultLongTimeout), Dependencies(ensureNatGateway))
 
 	ensureRouteTable := c.AddTask(g, "ensure route table",
 		c.ensureRouteTable,
 		DoIf(c.useCustomRouteTable()), Timeout(defaultTimeout), Dependencies(ensureNatGateway, ensureIpv6Gateway))
 
-	_ = c.AddTask(g, "ensure zones",
+	ensureZones := c.AddTask(g, "ensure zones",
 		c.ensureZones,
-		Timeout(defaultLongTimeout), Dependencies(ensureNatGateway, ensureRouteTable))
+		DoIf(!c.egressModeNone()), Timeout(defaultLongTimeout), Dependencies(ensureNatGateway, ensureVPCNatGateway, ensureRouteTable))
+
+	_ = c.AddTask(g, "release eip pool",
+		c.releaseEIPPool,
+		DoIf(!c.retainEIPsEnabled() && c.hasPooledEIPs()), Timeout(defaultLongTimeout), Dependencies(ensureVSwitches, ensureZones))
 
 	return g
 }
@@ -168,6 +191,7 @@ func (c *FlowContext) ensureSecurityGroup(ctx context.Context) error {
 		if current == nil {
 			return fmt.Errorf("failed to create security group")
 		}
+		c.ResourceCreated("security group", current.SecurityGroupId)
 	}
 	c.state.Set(IdentifierNodesSecurityGroup, current.SecurityGroupId)
 	if _, err := c.updater.UpdateSecurityGroup(ctx, desired, current); err != nil {
@@ -210,6 +234,16 @@ func (c *FlowContext) ensureExistingVpc(ctx context.Context) error {
 	}
 	c.state.Set(IdentifierVPC, vpcID)
 
+	// secondary CIDRs are only added to an existing VPC, as it may be shared with other clusters
+	for _, cidr := range c.config.Networks.VPC.SecondaryCIDRs {
+		if !slices.Contains(current.SecondaryCidrBlocks, cidr) {
+			log.Info("associating secondary CIDR with VPC", "vpc", vpcID, "cidr", cidr)
+			if err := c.actor.AssociateVpcCidrBlock(ctx, vpcID, cidr); err != nil {
+				return fmt.Errorf("failed to associate secondary CIDR %s with VPC %s: %w", cidr, vpcID, err)
+			}
+		}
+	}
+
 	if c.dualStackEnabled() {
 		ipv6Cidr, err := c.actor.GetVpcIpv6Info(ctx, vpcID)
 		if err != nil {
@@ -232,9 +266,10 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 	}
 
 	desired := &aliclient.VPC{
-		Tags:      c.commonTags,
-		CidrBlock: *c.config.Networks.VPC.CIDR,
-		Name:      c.namespace + "-vpc",
+		Tags:                c.commonTags,
+		CidrBlock:           *c.config.Networks.VPC.CIDR,
+		Name:                c.namespace + "-vpc",
+		SecondaryCidrBlocks: c.config.Networks.VPC.SecondaryCIDRs,
 	}
 
 	current, err := findExisting(ctx, c.state.Get(IdentifierVPC), c.commonTags,
@@ -259,6 +294,7 @@ func (c *FlowContext) ensureManagedVpc(ctx context.Context) error {
 		if created == nil {
 			return fmt.Errorf("failed to create VPC")
 		}
+		c.ResourceCreated("VPC", created.VpcId)
 
 		c.state.Set(IdentifierVPC, created.VpcId)
 		_, err = c.updater.UpdateVpc(ctx, desired, created)
@@ -385,7 +421,7 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	log.Info("using managed NatGateway")
 
-	availableVSwitches := c.getAllVSwitchids()
+	availableVSwitches := c.getInternetNatGatewayVSwitchIds()
 	if len(availableVSwitches) == 0 {
 		return fmt.Errorf("no available VSwitch can found for natgateway")
 	}
@@ -398,6 +434,7 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 		Name:               c.namespace + "-natgw",
 		VpcId:              vpcId,
 		AvailableVSwitches: availableVSwitches,
+		DeletionProtection: c.deletionProtectionEnabled(),
 	}
 	stored_ngwId := c.state.Get(IdentifierNatGateway)
 	current, err := findExisting(ctx, stored_ngwId, c.commonTagsWithSuffix("natgw"),
@@ -412,8 +449,11 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 			if err != nil {
 				return err
 			}
-			if len(ngw_in_vsw_list) > 0 {
-				current = ngw_in_vsw_list[0]
+			for _, ngw := range ngw_in_vsw_list {
+				if ngw.NetworkType != aliclient.NatGatewayNetworkTypeIntranet {
+					current = ngw
+					break
+				}
 			}
 		}
 	}
@@ -438,6 +478,7 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 		if created == nil {
 			return fmt.Errorf("create NatGateway failed")
 		}
+		c.ResourceCreated("NAT gateway", created.NatGatewayId)
 
 		c.state.Set(IdentifierNatGateway, created.NatGatewayId)
 		_, err = c.updater.UpdateNatgateway(ctx, desired, created)
@@ -448,6 +489,116 @@ func (c *FlowContext) ensureManagedNatGateway(ctx context.Context) error {
 	return c.PersistState(ctx, true)
 }
 
+// ensureVPCNatGateway ensures the VPC NAT gateway which translates the egress traffic of the zones with a NAT
+// gateway of type VPC to its private IP address or to the configured SNAT IP addresses. It is placed in the vswitch
+// of the first of these zones.
+func (c *FlowContext) ensureVPCNatGateway(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+
+	var availableVSwitches []string
+	for _, zone := range c.config.Networks.Zones {
+		if !c.useVPCNatGateway(zone.Name) {
+			continue
+		}
+		if vswitchId := c.getZoneChild(zone.Name).Get(IdentifierZoneVSwitch); vswitchId != nil {
+			availableVSwitches = append(availableVSwitches, *vswitchId)
+		}
+	}
+	if len(availableVSwitches) == 0 {
+		return fmt.Errorf("no available VSwitch can found for vpc natgateway")
+	}
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return fmt.Errorf("IdentifierVPC is nil")
+	}
+	desired := &aliclient.NatGateway{
+		Tags:               c.commonTagsWithSuffix("vpc-natgw"),
+		Name:               c.namespace + "-vpc-natgw",
+		VpcId:              vpcId,
+		AvailableVSwitches: availableVSwitches,
+		DeletionProtection: c.deletionProtectionEnabled(),
+		NetworkType:        aliclient.NatGatewayNetworkTypeIntranet,
+	}
+	current, err := findExisting(ctx, c.state.Get(IdentifierVPCNatGateway), c.commonTagsWithSuffix("vpc-natgw"),
+		c.actor.GetNatGateway, c.actor.FindNatGatewayByTags)
+	if err != nil {
+		return err
+	}
+
+	if current != nil {
+		c.state.Set(IdentifierVPCNatGateway, current.NatGatewayId)
+		if !contains(desired.AvailableVSwitches, *current.VswitchId) {
+			return fmt.Errorf("the vpc natgateway should be deleted")
+		}
+		if _, err := c.updater.UpdateNatgateway(ctx, desired, current); err != nil {
+			return err
+		}
+	} else {
+		log.Info("creating vpc natgateway ...")
+		waiter := informOnWaiting(log, 10*time.Second, "still creating vpc natgateway...")
+		created, err := c.actor.CreateNatGateway(ctx, desired)
+		waiter.Done(err)
+		if err != nil {
+			return fmt.Errorf("create VPC NatGateway failed %w", err)
+		}
+		if created == nil {
+			return fmt.Errorf("create VPC NatGateway failed")
+		}
+		c.ResourceCreated("VPC NAT gateway", created.NatGatewayId)
+
+		c.state.Set(IdentifierVPCNatGateway, created.NatGatewayId)
+		if _, err := c.updater.UpdateNatgateway(ctx, desired, created); err != nil {
+			return err
+		}
+	}
+	if err := c.PersistState(ctx, true); err != nil {
+		return err
+	}
+	return c.ensureVPCNatIps(ctx, *c.state.Get(IdentifierVPCNatGateway))
+}
+
+// ensureVPCNatIps ensures the NAT IP addresses of the VPC NAT gateway for the configured SNAT IP addresses. NAT IP
+// addresses which are no longer configured are deleted, their SNAT entries have been removed together with the zones.
+func (c *FlowContext) ensureVPCNatIps(ctx context.Context, ngwId string) error {
+	log := c.LogFromContext(ctx)
+	name := c.namespace + "-snat-ip"
+
+	desired := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if address := c.getSNATIPAddress(zone.Name); address != nil && c.useVPCNatGateway(zone.Name) {
+			desired.Insert(*address)
+		}
+	}
+	current, err := c.actor.ListNatIps(ctx, ngwId)
+	if err != nil {
+		return err
+	}
+	existing := sets.New[string]()
+	for _, natIp := range current {
+		existing.Insert(natIp.IpAddress)
+		if natIp.IsDefault || natIp.Name != name || desired.Has(natIp.IpAddress) {
+			continue
+		}
+		log.Info("deleting NAT IP address ...", "natIpId", natIp.NatIpId, "ipAddress", natIp.IpAddress)
+		if err := c.actor.DeleteNatIp(ctx, natIp.NatIpId, ngwId); err != nil {
+			return err
+		}
+	}
+	for _, address := range sets.List(desired.Difference(existing)) {
+		log.Info("creating NAT IP address ...", "ipAddress", address)
+		created, err := c.actor.CreateNatIp(ctx, &aliclient.NatIP{
+			Name:         name,
+			NatGatewayId: ngwId,
+			IpAddress:    address,
+		})
+		if err != nil {
+			return fmt.Errorf("create NAT IP address %s failed: %w", address, err)
+		}
+		c.ResourceCreated("NAT IP address", created.NatIpId)
+	}
+	return nil
+}
+
 func getZoneName(item *aliclient.VSwitch) string {
 	return item.ZoneId
 }
@@ -479,6 +630,7 @@ func (c *FlowContext) ensureIpv6Gateway(ctx context.Context) error {
 		if err != nil {
 			return fmt.Errorf("failed to create IPv6 Gateway: %w", err)
 		}
+		c.ResourceCreated("IPv6 gateway", current.Ipv6GatewayId)
 		c.state.Set(IdentifierIPV6Gateway, current.Ipv6GatewayId)
 		if _, err := c.updater.UpdateIpv6Gateway(ctx, desired, current); err != nil {
 			return err
@@ -499,7 +651,7 @@ func (c *FlowContext) ensureRouteTable(ctx context.Context) error {
 	log := c.LogFromContext(ctx)
 	vpcId := c.state.Get(IdentifierVPC)
 	natGwId := c.state.Get(IdentifierNatGateway)
-	if vpcId == nil || natGwId == nil {
+	if vpcId == nil || (natGwId == nil && c.hasInternetNatGatewayZones()) {
 		return fmt.Errorf("VPC or NatGateway not ready for route table")
 	}
 
@@ -519,6 +671,7 @@ func (c *FlowContext) ensureRouteTable(ctx context.Context) error {
 		if err != nil {
 			return err
 		}
+		c.ResourceCreated("route table", current.RouteTableId)
 	}
 	c.state.Set(IdentifierRouteTable, current.RouteTableId)
 	if _, err := c.updater.UpdateRouteTable(ctx, desired, current); err != nil {
@@ -529,14 +682,16 @@ func (c *FlowContext) ensureRouteTable(ctx context.Context) error {
 		return err
 	}
 
-	// Ensure default route 0.0.0.0/0 → NatGateway
-	if err := c.ensureRouteEntry(ctx, current.RouteTableId, &aliclient.RouteEntry{
-		DestinationCidrBlock: "0.0.0.0/0",
-		NextHopType:          "NatGateway",
-		NextHopId:            *natGwId,
-		Name:                 c.namespace + "-rt-default",
-	}); err != nil {
-		return err
+	// Ensure default route 0.0.0.0/0 → NatGateway, the routes of the zones using the VPC NAT gateway are maintained by the user
+	if c.hasInternetNatGatewayZones() {
+		if err := c.ensureRouteEntry(ctx, current.RouteTableId, &aliclient.RouteEntry{
+			DestinationCidrBlock: "0.0.0.0/0",
+			NextHopType:          "NatGateway",
+			NextHopId:            *natGwId,
+			Name:                 c.namespace + "-rt-default",
+		}); err != nil {
+			return err
+		}
 	}
 
 	// Ensure ::/0 → IPv6Gateway when dualStack is enabled
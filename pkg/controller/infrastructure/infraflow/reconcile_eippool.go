@@ -0,0 +1,117 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"time"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const eipPoolSuffix = "eip-natgw-pool"
+
+// moveEIPToPool keeps the unassociated EIP of a deleted zone in the EIP pool of the shoot. The EIP is tagged as
+// pooled, so that it is found again even if the state is lost.
+func (c *FlowContext) moveEIPToPool(ctx context.Context, eip *aliclient.EIP) error {
+	c.LogFromContext(ctx).Info("moving eip to pool ...", "AllocationId", eip.EipId)
+	desired := &aliclient.EIP{
+		Tags:               c.commonTagsWithSuffix(eipPoolSuffix),
+		Bandwidth:          eip.Bandwidth,
+		DeletionProtection: eip.DeletionProtection,
+	}
+	if _, err := c.updater.UpdateEIP(ctx, desired, eip); err != nil {
+		return err
+	}
+	c.state.GetChild(ChildIdEIPPool).Set(eip.EipId, eip.EipId)
+	return c.PersistState(ctx, true)
+}
+
+// takeEIPFromPool returns an available EIP of the pool with the internet charge type of the desired EIP or nil if
+// there is none. The caller retags the EIP for its zone and removes it from the pool.
+func (c *FlowContext) takeEIPFromPool(ctx context.Context, desired *aliclient.EIP) (*aliclient.EIP, error) {
+	pool := c.state.GetChild(ChildIdEIPPool)
+	poolName := c.commonTagsWithSuffix(eipPoolSuffix)[TagKeyName]
+	for _, eipId := range pool.Keys() {
+		if pool.Get(eipId) == nil {
+			continue
+		}
+		eip, err := c.actor.GetEIP(ctx, eipId)
+		if err != nil {
+			return nil, err
+		}
+		if eip == nil || eip.Tags[TagKeyName] != poolName {
+			// released or already taken, e.g. if the state has not been persisted afterwards
+			pool.Set(eipId, "")
+			continue
+		}
+		if eip.Status == nil || *eip.Status != "Available" || eip.InternetChargeType != desired.InternetChargeType {
+			continue
+		}
+		c.LogFromContext(ctx).Info("reusing eip from pool ...", "AllocationId", eip.EipId)
+		return eip, nil
+	}
+	return nil, nil
+}
+
+// findPooledEIPs returns the EIPs of the pool, either recorded in the state or found by the pool tags.
+func (c *FlowContext) findPooledEIPs(ctx context.Context) ([]*aliclient.EIP, error) {
+	poolTags := c.commonTagsWithSuffix(eipPoolSuffix)
+	found, err := c.actor.FindEIPsByTags(ctx, poolTags)
+	if err != nil {
+		return nil, err
+	}
+	pooled := map[string]*aliclient.EIP{}
+	for _, eip := range found {
+		pooled[eip.EipId] = eip
+	}
+	for eipId := range c.state.GetChild(ChildIdEIPPool).AsMap() {
+		if _, ok := pooled[eipId]; ok {
+			continue
+		}
+		eip, err := c.actor.GetEIP(ctx, eipId)
+		if err != nil {
+			return nil, err
+		}
+		if eip != nil && eip.Tags[TagKeyName] == poolTags[TagKeyName] {
+			pooled[eipId] = eip
+		}
+	}
+	var eips []*aliclient.EIP
+	for _, eip := range pooled {
+		eips = append(eips, eip)
+	}
+	return eips, nil
+}
+
+// releaseEIPPool releases all EIPs of the pool. It is called on shoot deletion or if the retention is disabled.
+func (c *FlowContext) releaseEIPPool(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	eips, err := c.findPooledEIPs(ctx)
+	if err != nil {
+		return err
+	}
+	pool := c.state.GetChild(ChildIdEIPPool)
+	for _, eip := range eips {
+		if err := c.liftEIPDeletionProtection(ctx, eip); err != nil {
+			return err
+		}
+		log.Info("deleting pooled eip ...", "AllocationId", eip.EipId)
+		waiter := informOnWaiting(log, 5*time.Second, "still deleting eip ...", "AllocationId", eip.EipId)
+		err := c.actor.DeleteEIP(ctx, eip.EipId)
+		waiter.Done(err)
+		if err != nil {
+			return err
+		}
+		c.ResourceDeleted("EIP", eip.EipId)
+		pool.Set(eip.EipId, "")
+	}
+	c.state.CleanChild(ChildIdEIPPool)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) hasPooledEIPs() bool {
+	return len(c.state.GetChild(ChildIdEIPPool).AsMap()) > 0
+}
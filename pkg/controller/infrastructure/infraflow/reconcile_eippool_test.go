@@ -0,0 +1,234 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("EIP pool", func() {
+	const (
+		zoneName = "cn-beijing-b"
+		poolName = "shoot--foo--bar-eip-natgw-pool"
+		eipName  = "shoot--foo--bar-eip-natgw-z1"
+	)
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		tagsWithName func(name string) aliclient.Tags
+		pooledEIP    func(eipId string) *aliclient.EIP
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{ID: ptr.To("vpc-1")},
+				Zones: []apisalicloud.Zone{{Name: zoneName, Workers: "10.0.32.0/19"}},
+			},
+			RetainEIPs: ptr.To(true),
+		}
+		state = shared.FlatMap{
+			IdentifierVPC: "vpc-1",
+			"Zones/" + zoneName + "/" + IdentifierZoneSuffix: "z1",
+		}
+
+		tagsWithName = func(name string) aliclient.Tags {
+			return aliclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1", TagKeyName: name}
+		}
+		pooledEIP = func(eipId string) *aliclient.EIP {
+			return &aliclient.EIP{
+				EipId:              eipId,
+				Tags:               tagsWithName(poolName),
+				Bandwidth:          "100",
+				InternetChargeType: "PayByTraffic",
+				Status:             ptr.To("Available"),
+			}
+		}
+	})
+
+	newFlowContext := func() *FlowContext {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil)
+	}
+
+	Describe("#MoveEIPToPool", func() {
+		It("should retag the EIP and record it in the pool", func() {
+			eip := &aliclient.EIP{EipId: "eip-1", Tags: tagsWithName(eipName), Bandwidth: "100", Status: ptr.To("Available")}
+			actor.EXPECT().DeleteTags(ctx, []string{"eip-1"}, aliclient.Tags{TagKeyName: eipName}, "EIP")
+			actor.EXPECT().CreateTags(ctx, []string{"eip-1"}, aliclient.Tags{TagKeyName: poolName}, "EIP")
+
+			flowContext := newFlowContext()
+			Expect(flowContext.MoveEIPToPool(ctx, eip)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(ChildIdEIPPool+"/eip-1", "eip-1"))
+		})
+	})
+
+	Describe("#TakeEIPFromPool", func() {
+		BeforeEach(func() {
+			state[ChildIdEIPPool+"/eip-1"] = "eip-1"
+			state[ChildIdEIPPool+"/eip-2"] = "eip-2"
+			state[ChildIdEIPPool+"/eip-3"] = "eip-3"
+			state[ChildIdEIPPool+"/eip-4"] = "eip-4"
+		})
+
+		It("should drop stale ids, skip other charge types and return an available EIP", func() {
+			taken := pooledEIP("eip-2")
+			taken.Tags = tagsWithName(eipName)
+			payByBandwidth := pooledEIP("eip-3")
+			payByBandwidth.InternetChargeType = "PayByBandwidth"
+			actor.EXPECT().GetEIP(ctx, "eip-1").Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-2").Return(taken, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-3").Return(payByBandwidth, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-4").Return(pooledEIP("eip-4"), nil)
+
+			flowContext := newFlowContext()
+			eip, err := flowContext.TakeEIPFromPool(ctx, &aliclient.EIP{InternetChargeType: "PayByTraffic"})
+			Expect(err).NotTo(HaveOccurred())
+			Expect(eip).To(Equal(pooledEIP("eip-4")))
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-1"))
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-2"))
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(ChildIdEIPPool+"/eip-3", "eip-3"))
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(ChildIdEIPPool+"/eip-4", "eip-4"))
+		})
+
+		It("should return nil if no EIP is available", func() {
+			inUse := pooledEIP("eip-4")
+			inUse.Status = ptr.To("InUse")
+			actor.EXPECT().GetEIP(ctx, "eip-1").Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-2").Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-3").Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-4").Return(inUse, nil)
+
+			Expect(newFlowContext().TakeEIPFromPool(ctx, &aliclient.EIP{InternetChargeType: "PayByTraffic"})).To(BeNil())
+		})
+	})
+
+	Describe("#EnsureElasticIP", func() {
+		It("should retag an EIP taken from the pool for the zone", func() {
+			state[ChildIdEIPPool+"/eip-4"] = "eip-4"
+			actor.EXPECT().FindEIPsByTags(ctx, tagsWithName(eipName)).Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-4").Return(pooledEIP("eip-4"), nil)
+			actor.EXPECT().DeleteTags(ctx, []string{"eip-4"}, aliclient.Tags{TagKeyName: poolName}, "EIP")
+			actor.EXPECT().CreateTags(ctx, []string{"eip-4"}, aliclient.Tags{TagKeyName: eipName}, "EIP")
+			actor.EXPECT().CreateEIP(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureElasticIP(ctx, zoneName, "PayByTraffic")).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneNATGWElasticIP, "eip-4"))
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-4"))
+		})
+
+		It("should not take an EIP from the pool if the retention is disabled", func() {
+			config.RetainEIPs = nil
+			state[ChildIdEIPPool+"/eip-4"] = "eip-4"
+			actor.EXPECT().FindEIPsByTags(ctx, tagsWithName(eipName)).Return(nil, nil)
+			actor.EXPECT().CreateEIP(ctx, gomock.Any()).Return(&aliclient.EIP{EipId: "eip-5", Tags: tagsWithName(eipName), Bandwidth: "100"}, nil)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureElasticIP(ctx, zoneName, "PayByTraffic")).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneNATGWElasticIP, "eip-5"))
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(ChildIdEIPPool+"/eip-4", "eip-4"))
+		})
+	})
+
+	Describe("#ReleaseEIPPool", func() {
+		It("should release the EIPs found by tags and recorded in the state", func() {
+			state[ChildIdEIPPool+"/eip-1"] = "eip-1"
+			state[ChildIdEIPPool+"/eip-2"] = "eip-2"
+			protected := pooledEIP("eip-1")
+			protected.DeletionProtection = true
+			actor.EXPECT().FindEIPsByTags(ctx, tagsWithName(poolName)).Return([]*aliclient.EIP{protected}, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-2").Return(pooledEIP("eip-2"), nil)
+			gomock.InOrder(
+				actor.EXPECT().SetEIPDeletionProtection(ctx, "eip-1", false),
+				actor.EXPECT().DeleteEIP(ctx, "eip-1"),
+			)
+			actor.EXPECT().DeleteEIP(ctx, "eip-2")
+
+			flowContext := newFlowContext()
+			Expect(flowContext.ReleaseEIPPool(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-1"))
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-2"))
+		})
+
+		It("should not release EIPs recorded in the state which have been taken", func() {
+			state[ChildIdEIPPool+"/eip-1"] = "eip-1"
+			taken := pooledEIP("eip-1")
+			taken.Tags = tagsWithName(eipName)
+			actor.EXPECT().FindEIPsByTags(ctx, tagsWithName(poolName)).Return(nil, nil)
+			actor.EXPECT().GetEIP(ctx, "eip-1").Return(taken, nil)
+			actor.EXPECT().DeleteEIP(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.ReleaseEIPPool(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-1"))
+		})
+	})
+
+	Describe("#DeleteZoneByVSwitches", func() {
+		var eip *aliclient.EIP
+
+		BeforeEach(func() {
+			state["Zones/"+zoneName+"/"+IdentifierZoneVSwitch] = "vsw-b"
+			state["Zones/"+zoneName+"/"+MarkerZoneVSwitchNotOwned] = "true"
+			state["Zones/"+zoneName+"/"+IdentifierZoneNATGWElasticIP] = "eip-b"
+			eip = &aliclient.EIP{EipId: "eip-b", Tags: tagsWithName(eipName), Bandwidth: "100", Status: ptr.To("Available")}
+
+			actor.EXPECT().FindFlowLogsByTags(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			actor.EXPECT().FindNatGatewayByTags(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+			actor.EXPECT().GetEIP(gomock.Any(), "eip-b").Return(eip, nil).Times(2)
+			actor.EXPECT().DeleteVSwitch(gomock.Any(), gomock.Any()).Times(0)
+		})
+
+		It("should move the EIP of the zone to the pool if retainEIPs is set", func() {
+			actor.EXPECT().DeleteTags(gomock.Any(), []string{"eip-b"}, aliclient.Tags{TagKeyName: eipName}, "EIP")
+			actor.EXPECT().CreateTags(gomock.Any(), []string{"eip-b"}, aliclient.Tags{TagKeyName: poolName}, "EIP")
+			actor.EXPECT().DeleteEIP(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			vsw := &aliclient.VSwitch{VSwitchId: "vsw-b", VpcId: ptr.To("vpc-1"), ZoneId: zoneName, CidrBlock: "10.0.32.0/19"}
+			Expect(flowContext.DeleteZoneByVSwitches(ctx, []*aliclient.VSwitch{vsw}, true)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(ChildIdEIPPool+"/eip-b", "eip-b"))
+			Expect(flowContext.ExportState()).NotTo(HaveKey("Zones/" + zoneName + "/" + IdentifierZoneNATGWElasticIP))
+		})
+
+		It("should release the EIP of the zone if retainEIPs is not set", func() {
+			actor.EXPECT().DeleteEIP(gomock.Any(), "eip-b")
+
+			flowContext := newFlowContext()
+			vsw := &aliclient.VSwitch{VSwitchId: "vsw-b", VpcId: ptr.To("vpc-1"), ZoneId: zoneName, CidrBlock: "10.0.32.0/19"}
+			Expect(flowContext.DeleteZoneByVSwitches(ctx, []*aliclient.VSwitch{vsw}, false)).To(Succeed())
+			Expect(flowContext.ExportState()).NotTo(HaveKey(ChildIdEIPPool + "/eip-b"))
+		})
+	})
+})
@@ -0,0 +1,215 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	defaultFlowLogTrafficType = "All"
+
+	// defaultSLSLogstoreTTL and defaultSLSLogstoreShardCount are used for the logstore created for the flow logs.
+	defaultSLSLogstoreTTL        = 30
+	defaultSLSLogstoreShardCount = 2
+)
+
+// hasFlowLogs returns true if a flow log is recorded for any zone, e.g. to delete it after the flow logs have been
+// disabled.
+func (c *FlowContext) hasFlowLogs() bool {
+	zones := c.state.GetChild(ChildIdZones)
+	for _, key := range zones.GetChildrenKeys() {
+		if zones.GetChild(key).Get(IdentifierZoneFlowLog) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// createSLSLogstore returns true if the SLS project and logstore of the flow logs are created by the flow.
+func (c *FlowContext) createSLSLogstore() bool {
+	return c.config.FlowLogs != nil && c.config.FlowLogs.Create != nil && *c.config.FlowLogs.Create
+}
+
+// hasSLSLogstore returns true if an SLS project or logstore has been created by the flow.
+func (c *FlowContext) hasSLSLogstore() bool {
+	return c.state.Get(IdentifierSLSProject) != nil || c.state.Get(IdentifierSLSLogstore) != nil
+}
+
+// ensureSLSLogstore creates the SLS project and the logstore of the flow logs if they do not exist. Only the resources
+// created by the flow are recorded in the state, so that existing ones are not deleted with the shoot.
+func (c *FlowContext) ensureSLSLogstore(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	projectName := c.config.FlowLogs.Project
+	project, err := c.actor.GetSLSProject(ctx, projectName)
+	if err != nil {
+		return err
+	}
+	if project == nil {
+		log.Info("creating SLS project ...", "ProjectName", projectName)
+		desired := &aliclient.SLSProject{
+			Tags:        c.commonTagsWithSuffix("flowlogs"),
+			Name:        projectName,
+			Description: fmt.Sprintf("flow logs of %s", c.namespace),
+		}
+		if _, err := c.actor.CreateSLSProject(ctx, desired); err != nil {
+			return fmt.Errorf("create SLS project %s failed: %w", projectName, err)
+		}
+		c.ResourceCreated("SLS project", projectName)
+		c.state.Set(IdentifierSLSProject, projectName)
+		if err := c.PersistState(ctx, true); err != nil {
+			return err
+		}
+	}
+
+	logstoreName := c.config.FlowLogs.Logstore
+	logstore, err := c.actor.GetSLSLogstore(ctx, projectName, logstoreName)
+	if err != nil {
+		return err
+	}
+	if logstore == nil {
+		log.Info("creating SLS logstore ...", "ProjectName", projectName, "LogstoreName", logstoreName)
+		desired := &aliclient.SLSLogstore{
+			ProjectName: projectName,
+			Name:        logstoreName,
+			TTL:         defaultSLSLogstoreTTL,
+			ShardCount:  defaultSLSLogstoreShardCount,
+		}
+		if _, err := c.actor.CreateSLSLogstore(ctx, desired); err != nil {
+			return fmt.Errorf("create SLS logstore %s in project %s failed: %w", logstoreName, projectName, err)
+		}
+		c.ResourceCreated("SLS logstore", logstoreName)
+		c.state.Set(IdentifierSLSLogstore, projectName+"/"+logstoreName)
+	}
+	return c.PersistState(ctx, true)
+}
+
+// deleteSLSLogstore deletes the SLS logstore and project of the flow logs which have been created by the flow.
+func (c *FlowContext) deleteSLSLogstore(ctx context.Context) error {
+	log := c.LogFromContext(ctx)
+	if logstore := c.state.Get(IdentifierSLSLogstore); logstore != nil {
+		projectName, logstoreName, _ := strings.Cut(*logstore, "/")
+		log.Info("deleting SLS logstore ...", "ProjectName", projectName, "LogstoreName", logstoreName)
+		if err := c.actor.DeleteSLSLogstore(ctx, projectName, logstoreName); err != nil {
+			return err
+		}
+		c.ResourceDeleted("SLS logstore", logstoreName)
+		c.state.SetAsDeleted(IdentifierSLSLogstore)
+	}
+	if projectName := c.state.Get(IdentifierSLSProject); projectName != nil {
+		log.Info("deleting SLS project ...", "ProjectName", *projectName)
+		if err := c.actor.DeleteSLSProject(ctx, *projectName); err != nil {
+			return err
+		}
+		c.ResourceDeleted("SLS project", *projectName)
+		c.state.SetAsDeleted(IdentifierSLSProject)
+	}
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) flowLogTags(zoneName string) aliclient.Tags {
+	return c.commonTagsWithSuffix("flowlog-" + c.getZoneSuffix(zoneName))
+}
+
+// ensureFlowLogs ensures a flow log for the vswitch of every zone delivering to the configured SLS logstore.
+// If the flow logs are disabled, the flow logs recorded in the state are deleted.
+func (c *FlowContext) ensureFlowLogs(ctx context.Context) error {
+	processedZones := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+
+		if c.config.FlowLogs == nil {
+			if err := c.deleteFlowLog(zone.Name)(ctx); err != nil {
+				return err
+			}
+			continue
+		}
+		if err := c.ensureFlowLog(ctx, zone.Name); err != nil {
+			return err
+		}
+	}
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) ensureFlowLog(ctx context.Context, zoneName string) error {
+	log := c.LogFromContext(ctx)
+	child := c.getZoneChild(zoneName)
+	vswitchId := child.Get(IdentifierZoneVSwitch)
+	if vswitchId == nil {
+		return fmt.Errorf("IdentifierZoneVSwitch is nil")
+	}
+	trafficType := defaultFlowLogTrafficType
+	if c.config.FlowLogs.TrafficType != nil {
+		trafficType = *c.config.FlowLogs.TrafficType
+	}
+	desired := &aliclient.FlowLog{
+		Tags:         c.flowLogTags(zoneName),
+		Name:         fmt.Sprintf("%s-flowlog-%s", c.namespace, c.getZoneSuffix(zoneName)),
+		ResourceType: "VSwitch",
+		ResourceId:   *vswitchId,
+		TrafficType:  trafficType,
+		ProjectName:  c.config.FlowLogs.Project,
+		LogStoreName: c.config.FlowLogs.Logstore,
+	}
+	current, err := findExisting(ctx, child.Get(IdentifierZoneFlowLog), desired.Tags, c.actor.GetFlowLog, c.actor.FindFlowLogsByTags)
+	if err != nil {
+		return err
+	}
+
+	// the destination and the traffic type of a flow log cannot be modified, so it is replaced
+	if current != nil && (current.ResourceId != desired.ResourceId || current.TrafficType != desired.TrafficType ||
+		current.ProjectName != desired.ProjectName || current.LogStoreName != desired.LogStoreName) {
+		log.Info("replacing flow log ...", "FlowLogId", current.FlowLogId, "zoneName", zoneName)
+		if err := c.actor.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
+			return err
+		}
+		c.ResourceDeleted("flow log", current.FlowLogId)
+		current = nil
+	}
+
+	if current == nil {
+		log.Info("creating flow log ...", "VSwitchId", *vswitchId, "zoneName", zoneName)
+		created, err := c.actor.CreateFlowLog(ctx, desired)
+		if err != nil {
+			return fmt.Errorf("create flow log for vswitch %s failed: %w", *vswitchId, err)
+		}
+		c.ResourceCreated("flow log", created.FlowLogId)
+		current = created
+	}
+	child.Set(IdentifierZoneFlowLog, current.FlowLogId)
+	return nil
+}
+
+func (c *FlowContext) deleteFlowLog(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneFlowLog) {
+			return nil
+		}
+		current, err := findExisting(ctx, child.Get(IdentifierZoneFlowLog), c.flowLogTags(zoneName), c.actor.GetFlowLog, c.actor.FindFlowLogsByTags)
+		if err != nil {
+			return err
+		}
+		if current != nil {
+			c.LogFromContext(ctx).Info("deleting flow log ...", "FlowLogId", current.FlowLogId, "zoneName", zoneName)
+			if err := c.actor.DeleteFlowLog(ctx, current.FlowLogId); err != nil {
+				return err
+			}
+			c.ResourceDeleted("flow log", current.FlowLogId)
+		}
+		child.SetAsDeleted(IdentifierZoneFlowLog)
+		return nil
+	}
+}
@@ -0,0 +1,208 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("Flow logs", func() {
+	const zoneName = "cn-beijing-a"
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		flowLogTags aliclient.Tags
+		current     *aliclient.FlowLog
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{{Name: zoneName, Workers: "10.0.0.0/19"}},
+			},
+			FlowLogs: &apisalicloud.FlowLogs{Project: "shoot-logs", Logstore: "flowlogs"},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC: "vpc-1",
+			"Zones/" + zoneName + "/" + IdentifierZoneVSwitch: "vsw-a",
+			"Zones/" + zoneName + "/" + IdentifierZoneSuffix:  "z0",
+			"Zones/" + zoneName + "/" + IdentifierZoneFlowLog: "fl-1",
+		}
+
+		flowLogTags = aliclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1", TagKeyName: "shoot--foo--bar-flowlog-z0"}
+		current = &aliclient.FlowLog{
+			Tags:         flowLogTags,
+			Name:         "shoot--foo--bar-flowlog-z0",
+			FlowLogId:    "fl-1",
+			ResourceType: "VSwitch",
+			ResourceId:   "vsw-a",
+			TrafficType:  "All",
+			ProjectName:  "shoot-logs",
+			LogStoreName: "flowlogs",
+		}
+	})
+
+	newFlowContext := func() *FlowContext {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil)
+	}
+
+	Describe("#EnsureFlowLogs", func() {
+		It("should keep an unchanged flow log", func() {
+			actor.EXPECT().GetFlowLog(ctx, "fl-1").Return(current, nil)
+			actor.EXPECT().DeleteFlowLog(gomock.Any(), gomock.Any()).Times(0)
+			actor.EXPECT().CreateFlowLog(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureFlowLogs(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneFlowLog, "fl-1"))
+		})
+
+		It("should replace the flow log if its destination or traffic type changes", func() {
+			config.FlowLogs.Logstore = "other"
+			config.FlowLogs.TrafficType = ptr.To("Drop")
+			actor.EXPECT().GetFlowLog(ctx, "fl-1").Return(current, nil)
+			desired := *current
+			desired.FlowLogId = ""
+			desired.LogStoreName = "other"
+			desired.TrafficType = "Drop"
+			gomock.InOrder(
+				actor.EXPECT().DeleteFlowLog(ctx, "fl-1"),
+				actor.EXPECT().CreateFlowLog(ctx, &desired).Return(&aliclient.FlowLog{FlowLogId: "fl-2"}, nil),
+			)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureFlowLogs(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneFlowLog, "fl-2"))
+		})
+
+		It("should delete the flow log if the flow logs are disabled", func() {
+			config.FlowLogs = nil
+			actor.EXPECT().GetFlowLog(ctx, "fl-1").Return(current, nil)
+			actor.EXPECT().DeleteFlowLog(ctx, "fl-1")
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureFlowLogs(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneFlowLog, "<deleted>"))
+		})
+	})
+
+	Describe("#DeleteFlowLog", func() {
+		It("should delete the flow log found by its tags", func() {
+			actor.EXPECT().GetFlowLog(ctx, "fl-1").Return(nil, nil)
+			current.FlowLogId = "fl-2"
+			actor.EXPECT().FindFlowLogsByTags(ctx, flowLogTags).Return([]*aliclient.FlowLog{current}, nil)
+			actor.EXPECT().DeleteFlowLog(ctx, "fl-2")
+
+			flowContext := newFlowContext()
+			Expect(flowContext.DeleteFlowLog(ctx, zoneName)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneFlowLog, "<deleted>"))
+		})
+
+		It("should not delete anything if the flow log is gone", func() {
+			actor.EXPECT().GetFlowLog(ctx, "fl-1").Return(nil, nil)
+			actor.EXPECT().FindFlowLogsByTags(ctx, flowLogTags).Return(nil, nil)
+			actor.EXPECT().DeleteFlowLog(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.DeleteFlowLog(ctx, zoneName)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/"+zoneName+"/"+IdentifierZoneFlowLog, "<deleted>"))
+		})
+
+		It("should skip a flow log which is already deleted", func() {
+			state["Zones/"+zoneName+"/"+IdentifierZoneFlowLog] = "<deleted>"
+
+			Expect(newFlowContext().DeleteFlowLog(ctx, zoneName)).To(Succeed())
+		})
+	})
+
+	Describe("#EnsureSLSLogstore", func() {
+		BeforeEach(func() {
+			config.FlowLogs.Create = ptr.To(true)
+		})
+
+		It("should create and record the SLS project and logstore", func() {
+			actor.EXPECT().GetSLSProject(ctx, "shoot-logs").Return(nil, nil)
+			actor.EXPECT().CreateSLSProject(ctx, &aliclient.SLSProject{
+				Tags:        aliclient.Tags{"kubernetes.io/cluster/shoot--foo--bar": "1", TagKeyName: "shoot--foo--bar-flowlogs"},
+				Name:        "shoot-logs",
+				Description: "flow logs of shoot--foo--bar",
+			}).Return(&aliclient.SLSProject{Name: "shoot-logs"}, nil)
+			actor.EXPECT().GetSLSLogstore(ctx, "shoot-logs", "flowlogs").Return(nil, nil)
+			actor.EXPECT().CreateSLSLogstore(ctx, &aliclient.SLSLogstore{ProjectName: "shoot-logs", Name: "flowlogs", TTL: 30, ShardCount: 2}).
+				Return(&aliclient.SLSLogstore{ProjectName: "shoot-logs", Name: "flowlogs"}, nil)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureSLSLogstore(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierSLSProject, "shoot-logs"))
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierSLSLogstore, "shoot-logs/flowlogs"))
+		})
+
+		It("should neither create nor record an existing SLS project and logstore", func() {
+			actor.EXPECT().GetSLSProject(ctx, "shoot-logs").Return(&aliclient.SLSProject{Name: "shoot-logs"}, nil)
+			actor.EXPECT().GetSLSLogstore(ctx, "shoot-logs", "flowlogs").Return(&aliclient.SLSLogstore{ProjectName: "shoot-logs", Name: "flowlogs"}, nil)
+			actor.EXPECT().CreateSLSProject(gomock.Any(), gomock.Any()).Times(0)
+			actor.EXPECT().CreateSLSLogstore(gomock.Any(), gomock.Any()).Times(0)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureSLSLogstore(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).NotTo(HaveKey(IdentifierSLSProject))
+			Expect(flowContext.ExportState()).NotTo(HaveKey(IdentifierSLSLogstore))
+		})
+	})
+
+	Describe("#DeleteSLSLogstore", func() {
+		It("should delete the recorded SLS logstore and project", func() {
+			state[IdentifierSLSProject] = "shoot-logs"
+			state[IdentifierSLSLogstore] = "shoot-logs/flowlogs"
+			gomock.InOrder(
+				actor.EXPECT().DeleteSLSLogstore(ctx, "shoot-logs", "flowlogs"),
+				actor.EXPECT().DeleteSLSProject(ctx, "shoot-logs"),
+			)
+
+			flowContext := newFlowContext()
+			Expect(flowContext.DeleteSLSLogstore(ctx)).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierSLSProject, "<deleted>"))
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierSLSLogstore, "<deleted>"))
+		})
+
+		It("should keep an SLS project which has not been created by the flow", func() {
+			state[IdentifierSLSLogstore] = "shoot-logs/flowlogs"
+			actor.EXPECT().DeleteSLSLogstore(ctx, "shoot-logs", "flowlogs")
+			actor.EXPECT().DeleteSLSProject(gomock.Any(), gomock.Any()).Times(0)
+
+			Expect(newFlowContext().DeleteSLSLogstore(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,209 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"k8s.io/apimachinery/pkg/util/sets"
+	"k8s.io/utils/ptr"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const (
+	defaultIPv6InternetChargeType  = "PayByTraffic"
+	ipv6EgressOnlyRuleInstanceType = "Ipv6Address"
+)
+
+// nodeIPv6AddressInstanceTypes are the instance types of the IPv6 addresses used by nodes. The addresses of load
+// balancers are left to the cloud controller manager.
+var nodeIPv6AddressInstanceTypes = sets.New("EcsInstance", "NetworkInterface")
+
+func (c *FlowContext) ipv6InternetBandwidthEnabled() bool {
+	return c.dualStackEnabled() && c.config.DualStack.InternetBandwidth != nil
+}
+
+func (c *FlowContext) ipv6EgressOnlyRuleName() string {
+	return c.namespace + "-egress-only"
+}
+
+// listNodeIPv6Addresses returns the IPv6 addresses of the nodes in the vswitches of the shoot.
+func (c *FlowContext) listNodeIPv6Addresses(ctx context.Context) ([]*aliclient.IPv6Address, error) {
+	var addresses []*aliclient.IPv6Address
+	processedZones := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+
+		vswitchId := c.getZoneChild(zone.Name).Get(IdentifierZoneVSwitch)
+		if vswitchId == nil {
+			continue
+		}
+		list, err := c.actor.ListIpv6AddressesByVSwitch(ctx, *vswitchId)
+		if err != nil {
+			return nil, err
+		}
+		for _, item := range list {
+			if nodeIPv6AddressInstanceTypes.Has(item.AssociatedInstanceType) {
+				addresses = append(addresses, item)
+			}
+		}
+	}
+	return addresses, nil
+}
+
+// listIPv6EgressOnlyRules returns the egress-only rules created for the shoot by IPv6 address id.
+func (c *FlowContext) listIPv6EgressOnlyRules(ctx context.Context, ipv6GatewayId string) (map[string]*aliclient.IPv6EgressOnlyRule, error) {
+	rules, err := c.actor.ListIpv6EgressOnlyRules(ctx, ipv6GatewayId)
+	if err != nil {
+		return nil, err
+	}
+	result := map[string]*aliclient.IPv6EgressOnlyRule{}
+	for _, rule := range rules {
+		if rule.Name == c.ipv6EgressOnlyRuleName() && rule.InstanceType == ipv6EgressOnlyRuleInstanceType {
+			result[rule.InstanceId] = rule
+		}
+	}
+	return result, nil
+}
+
+// ensureIPv6InternetBandwidth opens the IPv6 internet bandwidth on the IPv6 gateway for the node addresses in the
+// vswitches of the shoot and restricts them to outbound traffic with egress-only rules. If the bandwidth is disabled,
+// it is released again. Nodes created or deleted later are handled by EnsureIPv6InternetBandwidthOfNodes.
+func (c *FlowContext) ensureIPv6InternetBandwidth(ctx context.Context) error {
+	ipv6GatewayId := c.state.Get(IdentifierIPV6Gateway)
+	if ipv6GatewayId == nil {
+		return fmt.Errorf("IdentifierIPV6Gateway is nil")
+	}
+	if err := c.reconcileIPv6InternetBandwidth(ctx, *ipv6GatewayId); err != nil {
+		return err
+	}
+
+	if c.config.DualStack.InternetBandwidth == nil {
+		c.state.SetAsDeleted(IdentifierIPv6InternetBandwidth)
+	} else {
+		c.state.Set(IdentifierIPv6InternetBandwidth, *ipv6GatewayId)
+	}
+	return c.PersistState(ctx, true)
+}
+
+// EnsureIPv6InternetBandwidthOfNodes opens the IPv6 internet bandwidth for the addresses of nodes created after the
+// last reconciliation of the infrastructure and deletes the egress-only rules of deleted nodes. It is called outside
+// of the infrastructure flow whenever machines change and does nothing if the bandwidth has not been opened by the
+// flow yet.
+func (c *FlowContext) EnsureIPv6InternetBandwidthOfNodes(ctx context.Context) error {
+	ipv6GatewayId := c.state.Get(IdentifierIPv6InternetBandwidth)
+	if !c.ipv6InternetBandwidthEnabled() || ipv6GatewayId == nil {
+		return nil
+	}
+	return c.reconcileIPv6InternetBandwidth(ctx, *ipv6GatewayId)
+}
+
+func (c *FlowContext) reconcileIPv6InternetBandwidth(ctx context.Context, ipv6GatewayId string) error {
+	log := c.LogFromContext(ctx)
+	config := c.config.DualStack.InternetBandwidth
+	var (
+		bandwidth  int
+		chargeType = defaultIPv6InternetChargeType
+		egressOnly bool
+	)
+	if config != nil {
+		bandwidth = int(config.Bandwidth)
+		chargeType = ptr.Deref(config.InternetChargeType, defaultIPv6InternetChargeType)
+		egressOnly = ptr.Deref(config.EgressOnly, true)
+	}
+
+	addresses, err := c.listNodeIPv6Addresses(ctx)
+	if err != nil {
+		return err
+	}
+	rules, err := c.listIPv6EgressOnlyRules(ctx, ipv6GatewayId)
+	if err != nil {
+		return err
+	}
+
+	for _, address := range addresses {
+		rule, hasRule := rules[address.Ipv6AddressId]
+		delete(rules, address.Ipv6AddressId)
+		if hasRule && !egressOnly {
+			log.Info("deleting IPv6 egress-only rule ...", "Ipv6EgressOnlyRuleId", rule.Ipv6EgressOnlyRuleId, "Ipv6Address", address.Ipv6Address)
+			if err := c.actor.DeleteIpv6EgressOnlyRule(ctx, ipv6GatewayId, rule.Ipv6EgressOnlyRuleId); err != nil {
+				return err
+			}
+			c.ResourceDeleted("IPv6 egress-only rule", rule.Ipv6EgressOnlyRuleId)
+		}
+
+		if address.InternetBandwidthId != "" && (config == nil || address.InternetChargeType != chargeType) {
+			log.Info("releasing IPv6 internet bandwidth ...", "Ipv6AddressId", address.Ipv6AddressId, "Ipv6Address", address.Ipv6Address)
+			if err := c.actor.DeleteIpv6InternetBandwidth(ctx, address.Ipv6AddressId, address.InternetBandwidthId); err != nil {
+				return fmt.Errorf("failed to release IPv6 internet bandwidth of %s: %w", address.Ipv6Address, err)
+			}
+			address.InternetBandwidthId = ""
+		}
+		if config == nil {
+			continue
+		}
+		if address.InternetBandwidthId == "" {
+			log.Info("allocating IPv6 internet bandwidth ...", "Ipv6AddressId", address.Ipv6AddressId, "Ipv6Address", address.Ipv6Address, "bandwidth", bandwidth)
+			if err := c.actor.AllocateIpv6InternetBandwidth(ctx, ipv6GatewayId, address.Ipv6AddressId, bandwidth, chargeType); err != nil {
+				return fmt.Errorf("failed to allocate IPv6 internet bandwidth for %s: %w", address.Ipv6Address, err)
+			}
+		} else if address.Bandwidth != bandwidth {
+			log.Info("modifying IPv6 internet bandwidth ...", "Ipv6AddressId", address.Ipv6AddressId, "Ipv6Address", address.Ipv6Address, "bandwidth", bandwidth)
+			if err := c.actor.ModifyIpv6InternetBandwidth(ctx, address.Ipv6AddressId, address.InternetBandwidthId, bandwidth); err != nil {
+				return fmt.Errorf("failed to modify IPv6 internet bandwidth of %s: %w", address.Ipv6Address, err)
+			}
+		}
+
+		if egressOnly && !hasRule {
+			log.Info("creating IPv6 egress-only rule ...", "Ipv6Address", address.Ipv6Address)
+			created, err := c.actor.CreateIpv6EgressOnlyRule(ctx, ipv6GatewayId, &aliclient.IPv6EgressOnlyRule{
+				Name:         c.ipv6EgressOnlyRuleName(),
+				InstanceType: ipv6EgressOnlyRuleInstanceType,
+				InstanceId:   address.Ipv6AddressId,
+			})
+			if err != nil {
+				return fmt.Errorf("failed to create IPv6 egress-only rule for %s: %w", address.Ipv6Address, err)
+			}
+			c.ResourceCreated("IPv6 egress-only rule", created.Ipv6EgressOnlyRuleId)
+		}
+	}
+
+	// the remaining rules belong to addresses of deleted nodes
+	return c.deleteIPv6EgressOnlyRulesOf(ctx, ipv6GatewayId, rules)
+}
+
+// deleteIPv6EgressOnlyRules deletes the egress-only rules created for the shoot, as they would block the deletion
+// of the IPv6 gateway and are left behind on the IPv6 gateway of a user-provided VPC otherwise.
+func (c *FlowContext) deleteIPv6EgressOnlyRules(ctx context.Context) error {
+	ipv6GatewayId := c.state.Get(IdentifierIPv6InternetBandwidth)
+	if ipv6GatewayId == nil {
+		return nil
+	}
+	rules, err := c.listIPv6EgressOnlyRules(ctx, *ipv6GatewayId)
+	if err != nil {
+		return err
+	}
+	if err := c.deleteIPv6EgressOnlyRulesOf(ctx, *ipv6GatewayId, rules); err != nil {
+		return err
+	}
+	c.state.SetAsDeleted(IdentifierIPv6InternetBandwidth)
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) deleteIPv6EgressOnlyRulesOf(ctx context.Context, ipv6GatewayId string, rules map[string]*aliclient.IPv6EgressOnlyRule) error {
+	for _, rule := range rules {
+		c.LogFromContext(ctx).Info("deleting IPv6 egress-only rule ...", "Ipv6EgressOnlyRuleId", rule.Ipv6EgressOnlyRuleId)
+		if err := c.actor.DeleteIpv6EgressOnlyRule(ctx, ipv6GatewayId, rule.Ipv6EgressOnlyRuleId); err != nil {
+			return err
+		}
+		c.ResourceDeleted("IPv6 egress-only rule", rule.Ipv6EgressOnlyRuleId)
+	}
+	return nil
+}
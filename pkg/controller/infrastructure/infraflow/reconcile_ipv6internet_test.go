@@ -0,0 +1,179 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("IPv6 internet bandwidth", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		ruleName  = namespace + "-egress-only"
+	)
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		nodeAddress *aliclient.IPv6Address
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19"}},
+			},
+			DualStack: &apisalicloud.DualStack{
+				Enabled:           true,
+				InternetBandwidth: &apisalicloud.IPv6InternetBandwidth{Bandwidth: 10},
+			},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC:         "vpc-1",
+			IdentifierIPV6Gateway: "ipv6gw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch: "vsw-a",
+		}
+		nodeAddress = &aliclient.IPv6Address{
+			Ipv6AddressId:          "ipv6-1",
+			Ipv6Address:            "2408:4005::1",
+			VSwitchId:              "vsw-a",
+			AssociatedInstanceId:   "i-1",
+			AssociatedInstanceType: "EcsInstance",
+		}
+	})
+
+	newFlowContext := func() *FlowContext {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil)
+	}
+
+	expectAddresses := func(addresses ...*aliclient.IPv6Address) {
+		lbAddress := &aliclient.IPv6Address{Ipv6AddressId: "ipv6-lb", VSwitchId: "vsw-a", AssociatedInstanceType: "SlbInstance"}
+		actor.EXPECT().ListIpv6AddressesByVSwitch(ctx, "vsw-a").Return(append(addresses, lbAddress), nil)
+	}
+
+	expectRules := func(rules ...*aliclient.IPv6EgressOnlyRule) {
+		foreign := &aliclient.IPv6EgressOnlyRule{Name: "other", Ipv6EgressOnlyRuleId: "rule-other", InstanceType: "Ipv6Address", InstanceId: "ipv6-other"}
+		actor.EXPECT().ListIpv6EgressOnlyRules(ctx, "ipv6gw-1").Return(append(rules, foreign), nil)
+	}
+
+	rule := func(id, ipv6AddressId string) *aliclient.IPv6EgressOnlyRule {
+		return &aliclient.IPv6EgressOnlyRule{Name: ruleName, Ipv6EgressOnlyRuleId: id, InstanceType: "Ipv6Address", InstanceId: ipv6AddressId}
+	}
+
+	It("should allocate the bandwidth and create the egress-only rule for a new node", func() {
+		expectAddresses(nodeAddress)
+		expectRules()
+		actor.EXPECT().AllocateIpv6InternetBandwidth(ctx, "ipv6gw-1", "ipv6-1", 10, "PayByTraffic")
+		actor.EXPECT().CreateIpv6EgressOnlyRule(ctx, "ipv6gw-1", &aliclient.IPv6EgressOnlyRule{
+			Name:         ruleName,
+			InstanceType: "Ipv6Address",
+			InstanceId:   "ipv6-1",
+		}).Return(rule("rule-1", "ipv6-1"), nil)
+
+		flowContext := newFlowContext()
+		Expect(flowContext.EnsureIPv6InternetBandwidth(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierIPv6InternetBandwidth, "ipv6gw-1"))
+	})
+
+	It("should modify the bandwidth and delete the rules of deleted nodes", func() {
+		nodeAddress.InternetBandwidthId = "bw-1"
+		nodeAddress.Bandwidth = 5
+		nodeAddress.InternetChargeType = "PayByTraffic"
+		expectAddresses(nodeAddress)
+		expectRules(rule("rule-1", "ipv6-1"), rule("rule-2", "ipv6-deleted"))
+		actor.EXPECT().ModifyIpv6InternetBandwidth(ctx, "ipv6-1", "bw-1", 10)
+		actor.EXPECT().DeleteIpv6EgressOnlyRule(ctx, "ipv6gw-1", "rule-2")
+
+		Expect(newFlowContext().EnsureIPv6InternetBandwidth(ctx)).To(Succeed())
+	})
+
+	It("should reallocate the bandwidth if the charge type changed and delete the rule if not egress-only", func() {
+		config.DualStack.InternetBandwidth.InternetChargeType = ptr.To("PayByBandwidth")
+		config.DualStack.InternetBandwidth.EgressOnly = ptr.To(false)
+		nodeAddress.InternetBandwidthId = "bw-1"
+		nodeAddress.Bandwidth = 10
+		nodeAddress.InternetChargeType = "PayByTraffic"
+		expectAddresses(nodeAddress)
+		expectRules(rule("rule-1", "ipv6-1"))
+		gomock.InOrder(
+			actor.EXPECT().DeleteIpv6EgressOnlyRule(ctx, "ipv6gw-1", "rule-1"),
+			actor.EXPECT().DeleteIpv6InternetBandwidth(ctx, "ipv6-1", "bw-1"),
+			actor.EXPECT().AllocateIpv6InternetBandwidth(ctx, "ipv6gw-1", "ipv6-1", 10, "PayByBandwidth"),
+		)
+
+		Expect(newFlowContext().EnsureIPv6InternetBandwidth(ctx)).To(Succeed())
+	})
+
+	It("should release the bandwidth and delete the rules if disabled", func() {
+		config.DualStack.InternetBandwidth = nil
+		state[IdentifierIPv6InternetBandwidth] = "ipv6gw-1"
+		nodeAddress.InternetBandwidthId = "bw-1"
+		nodeAddress.Bandwidth = 10
+		nodeAddress.InternetChargeType = "PayByTraffic"
+		expectAddresses(nodeAddress)
+		expectRules(rule("rule-1", "ipv6-1"))
+		actor.EXPECT().DeleteIpv6EgressOnlyRule(ctx, "ipv6gw-1", "rule-1")
+		actor.EXPECT().DeleteIpv6InternetBandwidth(ctx, "ipv6-1", "bw-1")
+
+		flowContext := newFlowContext()
+		Expect(flowContext.EnsureIPv6InternetBandwidth(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierIPv6InternetBandwidth, "<deleted>"))
+	})
+
+	It("should delete the egress-only rules of the shoot on deletion", func() {
+		state[IdentifierIPv6InternetBandwidth] = "ipv6gw-1"
+		expectRules(rule("rule-1", "ipv6-1"))
+		actor.EXPECT().DeleteIpv6EgressOnlyRule(ctx, "ipv6gw-1", "rule-1")
+
+		flowContext := newFlowContext()
+		Expect(flowContext.DeleteIPv6EgressOnlyRules(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierIPv6InternetBandwidth, "<deleted>"))
+	})
+
+	Describe("#EnsureIPv6InternetBandwidthOfNodes", func() {
+		It("should do nothing if the bandwidth has not been opened by the flow yet", func() {
+			Expect(newFlowContext().EnsureIPv6InternetBandwidthOfNodes(ctx)).To(Succeed())
+		})
+
+		It("should open the bandwidth of nodes created after the last reconciliation", func() {
+			state[IdentifierIPv6InternetBandwidth] = "ipv6gw-1"
+			expectAddresses(nodeAddress)
+			expectRules()
+			actor.EXPECT().AllocateIpv6InternetBandwidth(ctx, "ipv6gw-1", "ipv6-1", 10, "PayByTraffic")
+			actor.EXPECT().CreateIpv6EgressOnlyRule(ctx, "ipv6gw-1", gomock.Any()).Return(rule("rule-1", "ipv6-1"), nil)
+
+			Expect(newFlowContext().EnsureIPv6InternetBandwidthOfNodes(ctx)).To(Succeed())
+		})
+	})
+})
@@ -0,0 +1,174 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"k8s.io/apimachinery/pkg/util/sets"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+const defaultNASStorageType = "Capacity"
+
+// hasNASFileSystem returns true if a NAS file system is recorded in the state.
+func (c *FlowContext) hasNASFileSystem() bool {
+	return c.state.Get(IdentifierNASFileSystem) != nil
+}
+
+func (c *FlowContext) nasTags() aliclient.Tags {
+	return c.commonTagsWithSuffix("nas")
+}
+
+// ensureNAS ensures the NAS file system of the shoot in the first zone and a mount target of it in the vswitch of
+// every zone.
+func (c *FlowContext) ensureNAS(ctx context.Context) error {
+	fileSystemId, err := c.ensureNASFileSystem(ctx)
+	if err != nil {
+		return err
+	}
+	processedZones := sets.New[string]()
+	for _, zone := range c.config.Networks.Zones {
+		if processedZones.Has(zone.Name) {
+			continue
+		}
+		processedZones.Insert(zone.Name)
+
+		if err := c.ensureNASMountTarget(ctx, fileSystemId, zone.Name); err != nil {
+			return err
+		}
+	}
+	return c.PersistState(ctx, true)
+}
+
+func (c *FlowContext) ensureNASFileSystem(ctx context.Context) (string, error) {
+	storageType := defaultNASStorageType
+	if c.config.NAS.StorageType != nil {
+		storageType = *c.config.NAS.StorageType
+	}
+	desired := &aliclient.NASFileSystem{
+		Tags:        c.nasTags(),
+		Description: c.namespace + "-nas",
+		ZoneId:      c.config.Networks.Zones[0].Name,
+		StorageType: storageType,
+	}
+	current, err := findExisting(ctx, c.state.Get(IdentifierNASFileSystem), desired.Tags, c.actor.GetNASFileSystem, c.actor.FindNASFileSystemsByTags)
+	if err != nil {
+		return "", err
+	}
+	if current == nil {
+		c.LogFromContext(ctx).Info("creating NAS file system ...", "zoneName", desired.ZoneId, "storageType", storageType)
+		created, err := c.actor.CreateNASFileSystem(ctx, desired)
+		if err != nil {
+			return "", fmt.Errorf("create NAS file system failed: %w", err)
+		}
+		c.ResourceCreated("NAS file system", created.FileSystemId)
+		current = created
+	}
+	c.state.Set(IdentifierNASFileSystem, current.FileSystemId)
+	return current.FileSystemId, nil
+}
+
+func (c *FlowContext) ensureNASMountTarget(ctx context.Context, fileSystemId, zoneName string) error {
+	child := c.getZoneChild(zoneName)
+	vswitchId := child.Get(IdentifierZoneVSwitch)
+	if vswitchId == nil {
+		return fmt.Errorf("IdentifierZoneVSwitch is nil")
+	}
+	vpcId := c.state.Get(IdentifierVPC)
+	if vpcId == nil {
+		return fmt.Errorf("IdentifierVPC is nil")
+	}
+	mountTargets, err := c.actor.ListNASMountTargets(ctx, fileSystemId)
+	if err != nil {
+		return err
+	}
+	var current *aliclient.NASMountTarget
+	for _, mountTarget := range mountTargets {
+		if mountTarget.VSwitchId == *vswitchId {
+			current = mountTarget
+			break
+		}
+	}
+	if current == nil {
+		c.LogFromContext(ctx).Info("creating NAS mount target ...", "VSwitchId", *vswitchId, "zoneName", zoneName)
+		created, err := c.actor.CreateNASMountTarget(ctx, &aliclient.NASMountTarget{
+			FileSystemId: fileSystemId,
+			VpcId:        *vpcId,
+			VSwitchId:    *vswitchId,
+		})
+		if err != nil {
+			return fmt.Errorf("create NAS mount target for vswitch %s failed: %w", *vswitchId, err)
+		}
+		c.ResourceCreated("NAS mount target", created.MountTargetDomain)
+		current = created
+	}
+	child.Set(IdentifierZoneNASMountTarget, current.MountTargetDomain)
+	return nil
+}
+
+// deleteNASMountTarget deletes the mount target of the NAS file system in the vswitch of a zone, e.g. before the
+// zone is removed.
+func (c *FlowContext) deleteNASMountTarget(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		child := c.getZoneChild(zoneName)
+		if child.IsAlreadyDeleted(IdentifierZoneNASMountTarget) {
+			return nil
+		}
+		fileSystemId := c.state.Get(IdentifierNASFileSystem)
+		domain := child.Get(IdentifierZoneNASMountTarget)
+		if fileSystemId != nil && domain != nil {
+			c.LogFromContext(ctx).Info("deleting NAS mount target ...", "MountTargetDomain", *domain, "zoneName", zoneName)
+			if err := c.actor.DeleteNASMountTarget(ctx, *fileSystemId, *domain); err != nil {
+				return err
+			}
+			c.ResourceDeleted("NAS mount target", *domain)
+		}
+		child.SetAsDeleted(IdentifierZoneNASMountTarget)
+		return nil
+	}
+}
+
+// deleteNAS deletes all mount targets of the NAS file system and the file system itself.
+func (c *FlowContext) deleteNAS(ctx context.Context) error {
+	if c.state.IsAlreadyDeleted(IdentifierNASFileSystem) {
+		return nil
+	}
+	log := c.LogFromContext(ctx)
+	current, err := findExisting(ctx, c.state.Get(IdentifierNASFileSystem), c.nasTags(), c.actor.GetNASFileSystem, c.actor.FindNASFileSystemsByTags)
+	if err != nil {
+		return err
+	}
+	if current != nil {
+		mountTargets, err := c.actor.ListNASMountTargets(ctx, current.FileSystemId)
+		if err != nil {
+			return err
+		}
+		for _, mountTarget := range mountTargets {
+			log.Info("deleting NAS mount target ...", "MountTargetDomain", mountTarget.MountTargetDomain)
+			if err := c.actor.DeleteNASMountTarget(ctx, current.FileSystemId, mountTarget.MountTargetDomain); err != nil {
+				return err
+			}
+			c.ResourceDeleted("NAS mount target", mountTarget.MountTargetDomain)
+		}
+		log.Info("deleting NAS file system ...", "FileSystemId", current.FileSystemId)
+		if err := c.actor.DeleteNASFileSystem(ctx, current.FileSystemId); err != nil {
+			return err
+		}
+		c.ResourceDeleted("NAS file system", current.FileSystemId)
+	}
+
+	zones := c.state.GetChild(ChildIdZones)
+	for _, key := range zones.GetChildrenKeys() {
+		if zone := zones.GetChild(key); zone.Get(IdentifierZoneNASMountTarget) != nil {
+			zone.SetAsDeleted(IdentifierZoneNASMountTarget)
+		}
+	}
+	c.state.SetAsDeleted(IdentifierNASFileSystem)
+	return c.PersistState(ctx, true)
+}
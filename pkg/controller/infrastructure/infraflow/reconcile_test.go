@@ -0,0 +1,153 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("NAT gateways", func() {
+	const namespace = "shoot--foo--bar"
+
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		vpcNatGateway *aliclient.NatGateway
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: namespace},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC: apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{
+					{Name: "cn-beijing-a", Workers: "10.0.0.0/19", NatGateway: &apisalicloud.NatGatewayConfig{Type: ptr.To(apisalicloud.NatGatewayTypeVPC)}},
+					{Name: "cn-beijing-b", Workers: "10.0.32.0/19"},
+				},
+			},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC: "vpc-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch: "vsw-a",
+			"Zones/cn-beijing-a/" + IdentifierZoneSuffix:  "z0",
+			"Zones/cn-beijing-b/" + IdentifierZoneVSwitch: "vsw-b",
+			"Zones/cn-beijing-b/" + IdentifierZoneSuffix:  "z1",
+		}
+		vpcNatGateway = &aliclient.NatGateway{
+			NatGatewayId:     "vpc-ngw",
+			VswitchId:        ptr.To("vsw-a"),
+			SNATTableIDs:     []string{"stb-1"},
+			NetworkType:      aliclient.NatGatewayNetworkTypeIntranet,
+			PrivateIpAddress: "10.0.0.5",
+		}
+
+		actor.EXPECT().CreateTags(gomock.Any(), gomock.Any(), gomock.Any(), gomock.Any()).AnyTimes()
+	})
+
+	newFlowContext := func() *FlowContext {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil)
+	}
+
+	It("should place the internet NAT gateway only in the vswitches of zones of type Internet", func() {
+		actor.EXPECT().FindNatGatewayByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+		actor.EXPECT().ListNatGatewaysByVSwitchInVPC(gomock.Any(), "vpc-1", []string{"vsw-b"}).Return(nil, nil)
+		actor.EXPECT().CreateNatGateway(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, ngw *aliclient.NatGateway) (*aliclient.NatGateway, error) {
+			Expect(ngw.AvailableVSwitches).To(Equal([]string{"vsw-b"}))
+			return &aliclient.NatGateway{NatGatewayId: "ngw-1", VswitchId: ptr.To("vsw-b")}, nil
+		})
+
+		flowContext := newFlowContext()
+		Expect(flowContext.EnsureManagedNatGateway(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).To(HaveKeyWithValue(IdentifierNatGateway, "ngw-1"))
+	})
+
+	Context("all zones use the VPC NAT gateway", func() {
+		BeforeEach(func() {
+			config.Networks.Zones[1].NatGateway = &apisalicloud.NatGatewayConfig{Type: ptr.To(apisalicloud.NatGatewayTypeVPC)}
+			config.Networks.VPC.UseCustomRouteTable = ptr.To(true)
+		})
+
+		It("should create the custom route table without a default route to the internet NAT gateway", func() {
+			actor.EXPECT().FindRouteTablesByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+			actor.EXPECT().CreateRouteTable(gomock.Any(), gomock.Any()).Return(&aliclient.RouteTable{RouteTableId: "rt-1"}, nil)
+			actor.EXPECT().FindRouteEntryByDest(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+			actor.EXPECT().AssociateRouteTable(gomock.Any(), "rt-1", "vsw-a")
+			actor.EXPECT().AssociateRouteTable(gomock.Any(), "rt-1", "vsw-b")
+
+			Expect(newFlowContext().EnsureRouteTable(ctx)).To(Succeed())
+		})
+	})
+
+	Context("a SNAT IP address is configured", func() {
+		BeforeEach(func() {
+			config.Networks.Zones[0].NatGateway.SNATIPAddress = ptr.To("10.0.0.10")
+			state[IdentifierVPCNatGateway] = "vpc-ngw"
+		})
+
+		It("should create the NAT IP address and delete the ones no longer configured", func() {
+			actor.EXPECT().GetNatGateway(gomock.Any(), "vpc-ngw").Return(vpcNatGateway, nil)
+			actor.EXPECT().ListNatIps(gomock.Any(), "vpc-ngw").Return([]*aliclient.NatIP{
+				{NatIpId: "vpcnatip-default", IpAddress: "10.0.0.5", IsDefault: true},
+				{NatIpId: "vpcnatip-old", IpAddress: "10.0.0.9", Name: namespace + "-snat-ip"},
+				{NatIpId: "vpcnatip-user", IpAddress: "10.0.0.8", Name: "user"},
+			}, nil)
+			actor.EXPECT().DeleteNatIp(gomock.Any(), "vpcnatip-old", "vpc-ngw")
+			actor.EXPECT().CreateNatIp(gomock.Any(), &aliclient.NatIP{
+				Name:         namespace + "-snat-ip",
+				NatGatewayId: "vpc-ngw",
+				IpAddress:    "10.0.0.10",
+			}).Return(&aliclient.NatIP{NatIpId: "vpcnatip-new"}, nil)
+
+			Expect(newFlowContext().EnsureVPCNatGateway(ctx)).To(Succeed())
+		})
+
+		It("should translate the egress traffic of the zone to the SNAT IP address", func() {
+			actor.EXPECT().GetNatGateway(gomock.Any(), "vpc-ngw").Return(vpcNatGateway, nil)
+			actor.EXPECT().FindSNatEntriesByNatGateway(gomock.Any(), "vpc-ngw").Return([]*aliclient.SNATEntry{{
+				SnatEntryId: "snat-old",
+				SnatTableId: "stb-1",
+				VSwitchId:   "vsw-a",
+				IpAddress:   "10.0.0.5",
+			}}, nil)
+			actor.EXPECT().DeleteSNatEntry(gomock.Any(), "snat-old", "stb-1")
+			actor.EXPECT().CreateSNatEntry(gomock.Any(), gomock.Any()).DoAndReturn(func(_ context.Context, entry *aliclient.SNATEntry) (*aliclient.SNATEntry, error) {
+				Expect(entry.IpAddress).To(Equal("10.0.0.10"))
+				Expect(entry.VSwitchId).To(Equal("vsw-a"))
+				return &aliclient.SNATEntry{SnatEntryId: "snat-new"}, nil
+			})
+
+			flowContext := newFlowContext()
+			Expect(flowContext.EnsureVPCSnatEntry(ctx, "cn-beijing-a")).To(Succeed())
+			Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/cn-beijing-a/"+ZoneVPCNATGWIPAddress, "10.0.0.10"))
+		})
+	})
+})
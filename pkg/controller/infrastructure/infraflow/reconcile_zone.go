@@ -35,11 +35,7 @@ func (c *FlowContext) ensureZones(ctx context.Context) error {
 		c.addZoneReconcileTasks(g, zone.Name, eipIntenetChargeType)
 	}
 
-	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
-		return flow.Causes(err)
-	}
-	return nil
+	return c.RunFlow(ctx, g)
 }
 
 func (c *FlowContext) getEipInternetChargeType(ctx context.Context) string {
@@ -61,15 +57,21 @@ func (c *FlowContext) getEipInternetChargeType(ctx context.Context) string {
 	return client.DefaultInternetChargeType
 }
 func (c *FlowContext) addZoneReconcileTasks(g *flow.Graph, zoneName, eipIntenetChargeType string) {
+	if c.useVPCNatGateway(zoneName) {
+		_ = c.AddTask(g, "ensure vpc snat entry "+zoneName,
+			c.ensureVPCSnatEntry(zoneName),
+			MetricName("ensure vpc snat entry"), Timeout(defaultLongTimeout))
+		return
+	}
 	ensureElasticIP := c.AddTask(g, "ensure elastic IP "+zoneName,
 		c.ensureElasticIP(zoneName, eipIntenetChargeType),
-		Timeout(defaultLongTimeout))
+		MetricName("ensure elastic IP"), Timeout(defaultLongTimeout))
 	ensureEipAssociation := c.AddTask(g, "ensure eip association "+zoneName,
 		c.ensureEipAssociation(zoneName),
-		Timeout(defaultLongTimeout), Dependencies(ensureElasticIP))
+		MetricName("ensure eip association"), Timeout(defaultLongTimeout), Dependencies(ensureElasticIP))
 	_ = c.AddTask(g, "ensure snat entry "+zoneName,
 		c.ensureSnatEntry(zoneName),
-		Timeout(defaultLongTimeout), Dependencies(ensureEipAssociation))
+		MetricName("ensure snat entry"), Timeout(defaultLongTimeout), Dependencies(ensureEipAssociation))
 }
 
 func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
@@ -122,7 +124,7 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 			})
 		}
 
-		current, err := c.getCurrentSnatEntryForZone(ctx, zoneName)
+		current, err := c.getCurrentSnatEntryForZone(ctx, ngwId, zoneName)
 		if err != nil {
 			return err
 		}
@@ -142,6 +144,7 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 			if created == nil {
 				return fmt.Errorf("failed to create SNAT entry")
 			}
+			c.ResourceCreated("SNAT entry", created.SnatEntryId)
 			_, _ = c.updater.UpdateSNATEntry(ctx, desired, created)
 		}
 		toUnAssociateEIPs := sets.New[string]()
@@ -163,6 +166,7 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 				if created == nil {
 					return fmt.Errorf("failed to create SNAT entry")
 				}
+				c.ResourceCreated("SNAT entry", created.SnatEntryId)
 
 				_, _ = c.updater.UpdateSNATEntry(ctx, item.desired, created)
 			} else {
@@ -190,6 +194,9 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 
 			if managed_eip != nil {
 				log := c.LogFromContext(ctx)
+				if err := c.liftEIPDeletionProtection(ctx, managed_eip); err != nil {
+					return err
+				}
 				log.Info("deleting managed eip ...", "AllocationId", managed_eip.EipId)
 				waiter := informOnWaiting(log, 5*time.Second, "still deleting managed eip ...", "AllocationId", managed_eip.EipId)
 				err = c.actor.DeleteEIP(ctx, managed_eip.EipId)
@@ -197,6 +204,7 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 				if err != nil {
 					return err
 				}
+				c.ResourceDeleted("EIP", managed_eip.EipId)
 			}
 			child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
 			if err := c.PersistState(ctx, true); err != nil {
@@ -208,10 +216,88 @@ func (c *FlowContext) ensureSnatEntry(zoneName string) flow.TaskFn {
 	}
 }
 
-func (c *FlowContext) getCurrentSnatEntryForZone(ctx context.Context, zoneName string) ([]*aliclient.SNATEntry, error) {
+// ensureVPCSnatEntry ensures the SNAT entries of the zone in the VPC NAT gateway. The egress traffic of the zone is
+// translated to the configured SNAT IP address or to the private IP address of the VPC NAT gateway, so no EIP is needed.
+func (c *FlowContext) ensureVPCSnatEntry(zoneName string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		log := c.LogFromContext(ctx)
+		log.Info("ensureVPCSnatEntry", "zoneName", zoneName)
+		child := c.getZoneChild(zoneName)
+		vswitchId := child.Get(IdentifierZoneVSwitch)
+		if vswitchId == nil {
+			return fmt.Errorf("IdentifierZoneVSwitch is nil")
+		}
+		ngwId := c.state.Get(IdentifierVPCNatGateway)
+		if ngwId == nil {
+			return fmt.Errorf("IdentifierVPCNatGateway is nil")
+		}
+		ngw, err := c.actor.GetNatGateway(ctx, *ngwId)
+		if err != nil {
+			return err
+		}
+		if ngw == nil {
+			return fmt.Errorf("not find the recorded VPC NATGATEWAY %s", *ngwId)
+		}
+		snatIp := vpcSnatIPAddress(ngw, c.getSNATIPAddress(zoneName))
+		if snatIp == "" {
+			return fmt.Errorf("VPC NATGATEWAY %s has no private IP address", *ngwId)
+		}
+
+		snatSuffix := fmt.Sprintf("vpc-snat-%s", c.getZoneSuffix(zoneName))
+		var desired []*aliclient.SNATEntry
+		for _, snatTableId := range ngw.SNATTableIDs {
+			desired = append(desired, &aliclient.SNATEntry{
+				Name:         c.namespace + "-" + snatSuffix + "-" + snatTableId,
+				NatGatewayId: *ngwId,
+				VSwitchId:    *vswitchId,
+				IpAddress:    snatIp,
+				SnatTableId:  snatTableId,
+			})
+		}
+
+		current, err := c.getCurrentSnatEntryForZone(ctx, ngwId, zoneName)
+		if err != nil {
+			return err
+		}
+		toBeDeleted, toBeCreated, toBeChecked := diffByID(desired, current, func(item *aliclient.SNATEntry) string {
+			return item.SnatTableId + "-" + item.VSwitchId
+		})
+		for _, item := range toBeChecked {
+			if item.desired.IpAddress != item.current.IpAddress {
+				toBeDeleted = append(toBeDeleted, item.current)
+				toBeCreated = append(toBeCreated, item.desired)
+			} else {
+				_, _ = c.updater.UpdateSNATEntry(ctx, item.desired, item.current)
+			}
+		}
+		for _, entry := range toBeDeleted {
+			waiter := informOnWaiting(log, 5*time.Second, "still deleting snate entry ...", "SnatEntryId", entry.SnatEntryId, "SnatTableId", entry.SnatTableId)
+			err := c.actor.DeleteSNatEntry(ctx, entry.SnatEntryId, entry.SnatTableId)
+			waiter.Done(err)
+			if err != nil {
+				return err
+			}
+		}
+		for _, desired := range toBeCreated {
+			created, err := c.actor.CreateSNatEntry(ctx, desired)
+			if err != nil {
+				return err
+			}
+			if created == nil {
+				return fmt.Errorf("failed to create SNAT entry")
+			}
+			c.ResourceCreated("SNAT entry", created.SnatEntryId)
+			_, _ = c.updater.UpdateSNATEntry(ctx, desired, created)
+		}
+
+		child.Set(ZoneVPCNATGWIPAddress, snatIp)
+		return c.PersistState(ctx, true)
+	}
+}
+
+func (c *FlowContext) getCurrentSnatEntryForZone(ctx context.Context, ngwId *string, zoneName string) ([]*aliclient.SNATEntry, error) {
 	child := c.getZoneChild(zoneName)
 	vswitchId := child.Get(IdentifierZoneVSwitch)
-	ngwId := c.state.Get(IdentifierNatGateway)
 	entryList := []*aliclient.SNATEntry{}
 	if ngwId == nil || vswitchId == nil {
 		return entryList, nil
@@ -302,18 +388,27 @@ func (c *FlowContext) ensureElasticIP(zoneName, eipIntenetChargeType string) flo
 			Tags:               c.commonTagsWithSuffix(eipSuffix),
 			Bandwidth:          "100",
 			InternetChargeType: eipIntenetChargeType,
+			DeletionProtection: c.deletionProtectionEnabled(),
 		}
 		current, err := findExisting(ctx, child.Get(IdentifierZoneNATGWElasticIP), desired.Tags, c.actor.GetEIP, c.actor.FindEIPsByTags)
 		if err != nil {
 			return err
 		}
 
+		if current == nil && c.retainEIPsEnabled() {
+			current, err = c.takeEIPFromPool(ctx, desired)
+			if err != nil {
+				return err
+			}
+		}
+
 		if current != nil {
 			child.Set(IdentifierZoneNATGWElasticIP, current.EipId)
 			child.Set(ZoneNATGWElasticIPAddress, current.IpAddress)
 			if _, err := c.updater.UpdateEIP(ctx, desired, current); err != nil {
 				return err
 			}
+			c.state.GetChild(ChildIdEIPPool).Set(current.EipId, "")
 		} else {
 			log.Info("creating eip ...")
 			created, err := c.actor.CreateEIP(ctx, desired)
@@ -323,6 +418,7 @@ func (c *FlowContext) ensureElasticIP(zoneName, eipIntenetChargeType string) flo
 			if created == nil {
 				return fmt.Errorf("failed to create EIP")
 			}
+			c.ResourceCreated("EIP", created.EipId)
 			child.Set(IdentifierZoneNATGWElasticIP, created.EipId)
 			child.Set(ZoneNATGWElasticIPAddress, created.IpAddress)
 			if _, err := c.updater.UpdateEIP(ctx, desired, created); err != nil {
@@ -347,6 +443,13 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 		}
 		processedZones.Insert(zone.Name)
 
+		if zone.VSwitchID != nil {
+			if err := c.adoptVSwitch(ctx, zone, *vpcId); err != nil {
+				return err
+			}
+			continue
+		}
+
 		zoneSuffix := c.getZoneSuffix(zone.Name)
 		workerSuffix := fmt.Sprintf("nodes-%s", zoneSuffix)
 		cidrBlock := zone.Workers
@@ -363,10 +466,17 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 			})
 	}
 
-	current, err := c.collectExistingVSwitches(ctx)
+	existing, err := c.collectExistingVSwitches(ctx)
 	if err != nil {
 		return err
 	}
+	// existing vswitches configured for a zone are not owned by the shoot and must never be deleted
+	var current []*aliclient.VSwitch
+	for _, vsw := range existing {
+		if !c.isExistingVSwitch(vsw.VSwitchId) {
+			current = append(current, vsw)
+		}
+	}
 	vpc_vsw, err := c.actor.FindVSwitchesByVPC(ctx, *vpcId)
 	if err != nil {
 		return err
@@ -376,7 +486,7 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 	// excluding user-created VSwitches that happen to share the same zone+CIDR.
 	var filteredVpcVsw []*aliclient.VSwitch
 	for _, vsw := range vpc_vsw {
-		if strings.HasPrefix(vsw.Name, c.namespace+"-") {
+		if strings.HasPrefix(vsw.Name, c.namespace+"-") && !c.isExistingVSwitch(vsw.VSwitchId) {
 			filteredVpcVsw = append(filteredVpcVsw, vsw)
 		}
 	}
@@ -394,7 +504,7 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 		}
 		return fmt.Errorf("protected: attempt to DeleteZoneByVSwitches during reconcile. Details: %s", strings.Join(details, "; "))
 	}
-	if err := c.DeleteZoneByVSwitches(ctx, toBeDeleted); err != nil {
+	if err := c.DeleteZoneByVSwitches(ctx, toBeDeleted, c.retainEIPsEnabled()); err != nil {
 		return err
 	}
 
@@ -407,6 +517,7 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 		if created == nil {
 			return fmt.Errorf("failed to create vswitch")
 		}
+		c.ResourceCreated("vswitch", created.VSwitchId)
 		c.state.GetChild(ChildIdZones).GetChild(desired.ZoneId).Set(IdentifierZoneVSwitch, created.VSwitchId)
 		_, err = c.updater.UpdateVSwitch(ctx, desired, created)
 		if err != nil {
@@ -458,8 +569,75 @@ func (c *FlowContext) ensureVSwitches(ctx context.Context) error {
 	return nil
 }
 
-// DeleteZoneByVSwitches is called to delete zone per vswitch
-func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*aliclient.VSwitch) error {
+// adoptVSwitch uses the existing vswitch configured for the zone. The vswitch is neither tagged nor modified,
+// it is only checked to belong to the VPC and the zone.
+func (c *FlowContext) adoptVSwitch(ctx context.Context, zone alicloud.Zone, vpcId string) error {
+	log := c.LogFromContext(ctx)
+	vsw, err := c.actor.GetVSwitch(ctx, *zone.VSwitchID)
+	if err != nil {
+		return err
+	}
+	if vsw == nil {
+		return fmt.Errorf("configured vswitch %s of zone %s has not been found", *zone.VSwitchID, zone.Name)
+	}
+	if vsw.VpcId == nil || *vsw.VpcId != vpcId {
+		return fmt.Errorf("configured vswitch %s of zone %s does not belong to VPC %s", vsw.VSwitchId, zone.Name, vpcId)
+	}
+	if vsw.ZoneId != zone.Name {
+		return fmt.Errorf("configured vswitch %s is in zone %s, expected %s", vsw.VSwitchId, vsw.ZoneId, zone.Name)
+	}
+	cidrBlock := zone.Workers
+	if cidrBlock == "" {
+		cidrBlock = zone.Worker
+	}
+	if cidrBlock != "" && vsw.CidrBlock != cidrBlock {
+		return fmt.Errorf("configured vswitch %s of zone %s has CIDR %s, expected %s", vsw.VSwitchId, zone.Name, vsw.CidrBlock, cidrBlock)
+	}
+	log.Info("using configured vswitch", "VSwitchId", vsw.VSwitchId, "zoneName", zone.Name)
+	child := c.getZoneChild(zone.Name)
+	child.Set(IdentifierZoneVSwitch, vsw.VSwitchId)
+	// the marker keeps the vswitch from being deleted after the zone has been removed from the config
+	child.Set(MarkerZoneVSwitchNotOwned, "true")
+	return nil
+}
+
+// isExistingVSwitch returns true if the vswitch with the given ID is configured as existing vswitch of a zone.
+func (c *FlowContext) isExistingVSwitch(vswitchId string) bool {
+	for _, zone := range c.config.Networks.Zones {
+		if zone.VSwitchID != nil && *zone.VSwitchID == vswitchId {
+			return true
+		}
+	}
+	return false
+}
+
+// isNotOwnedVSwitch returns true if the vswitch with the given ID is an existing vswitch which is not owned by the shoot,
+// either because it is configured for a zone or because it has been adopted for a zone which has been removed since.
+func (c *FlowContext) isNotOwnedVSwitch(vswitchId string) bool {
+	if c.isExistingVSwitch(vswitchId) {
+		return true
+	}
+	zones := c.state.GetChild(ChildIdZones)
+	for _, key := range zones.GetChildrenKeys() {
+		child := zones.GetChild(key)
+		if id := child.Get(IdentifierZoneVSwitch); id != nil && *id == vswitchId && child.Get(MarkerZoneVSwitchNotOwned) != nil {
+			return true
+		}
+	}
+	return false
+}
+
+// DeleteZoneByVSwitches is called to delete zone per vswitch.
+// Existing vswitches not owned by the shoot are kept, only the resources of the zone are deleted. Neither are they
+// unassociated from the route table, nor are NAT gateways located in them deleted.
+// If retainEIPs is set, the EIPs of the NAT gateway of the zones are moved to the EIP pool instead of being released.
+func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*aliclient.VSwitch, retainEIPs bool) error {
+	return c.deleteZoneByVSwitches(ctx, toBeDeleted, retainEIPs, false)
+}
+
+// deleteZoneByVSwitches deletes the zones of the given vswitches. If deleteManagedNatGateways is set, the NAT gateways
+// managed by the shoot are deleted even if they are located in a vswitch not owned by the shoot.
+func (c *FlowContext) deleteZoneByVSwitches(ctx context.Context, toBeDeleted []*aliclient.VSwitch, retainEIPs, deleteManagedNatGateways bool) error {
 	// Check if toBeDeleted is empty
 	if len(toBeDeleted) == 0 {
 		return nil // Return immediately if there is nothing to delete
@@ -468,7 +646,9 @@ func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*
 	g := flow.NewGraph("Alicloud infrastructure deletion: zones")
 
 	toBeDeletedZones := sets.New[string]()
+	notOwned := sets.New[string]()
 	vswitchIds := []string{}
+	managedNatGatewayVSwitchIds := []string{}
 	for _, vsw := range toBeDeleted {
 		targetZoneName := getZoneName(vsw)
 		if c.state.GetChild(ChildIdZones).HasChild(targetZoneName) {
@@ -478,29 +658,45 @@ func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*
 				toBeDeletedZones.Insert(targetZoneName)
 			}
 		}
+		if deleteManagedNatGateways {
+			managedNatGatewayVSwitchIds = append(managedNatGatewayVSwitchIds, vsw.VSwitchId)
+		}
+		if c.isNotOwnedVSwitch(vsw.VSwitchId) {
+			notOwned.Insert(vsw.VSwitchId)
+			continue
+		}
 		vswitchIds = append(vswitchIds, vsw.VSwitchId)
 	}
+	if !deleteManagedNatGateways {
+		managedNatGatewayVSwitchIds = vswitchIds
+	}
 	dependencies := []flow.TaskIDer{}
 	for zoneName := range toBeDeletedZones {
-		taskID := c.addZoneDeletionTasks(g, zoneName)
+		taskID := c.addZoneDeletionTasks(g, zoneName, retainEIPs)
 		if taskID != nil {
 			dependencies = append(dependencies, taskID)
 		}
 	}
 
 	deleteNatGateway := c.AddTask(g, "delete managed NatGateway",
-		c.deleteNatGatewayInVSwitches(vswitchIds),
+		c.deleteNatGatewayInVSwitches(managedNatGatewayVSwitchIds, vswitchIds),
 		DoIf(needDeleteNatGateway), Timeout(defaultLongTimeout), Dependencies(dependencies...))
 
+	deleteVPCNatGateway := c.AddTask(g, "delete managed VPC NatGateway",
+		c.deleteVPCNatGatewayInVSwitches(managedNatGatewayVSwitchIds),
+		Timeout(defaultLongTimeout), Dependencies(dependencies...))
+
 	for _, vsw := range toBeDeleted {
+		if notOwned.Has(vsw.VSwitchId) {
+			continue
+		}
 		c.AddTask(g, "delete vswitch resource "+getZoneName(vsw)+" "+vsw.VSwitchId,
 			c.deleteVSwitch(vsw),
-			Timeout(defaultTimeout), Dependencies(deleteNatGateway))
+			MetricName("delete vswitch resource"), Timeout(defaultTimeout), Dependencies(deleteNatGateway, deleteVPCNatGateway))
 	}
 
-	f := g.Compile()
-	if err := f.Run(ctx, flow.Opts{Log: c.Log}); err != nil {
-		return flow.Causes(err)
+	if err := c.RunFlow(ctx, g); err != nil {
+		return err
 	}
 
 	zones := c.state.GetChild(ChildIdZones)
@@ -511,18 +707,26 @@ func (c *FlowContext) DeleteZoneByVSwitches(ctx context.Context, toBeDeleted []*
 	return c.PersistState(ctx, true)
 }
 
-func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string) flow.TaskIDer {
+func (c *FlowContext) addZoneDeletionTasks(g *flow.Graph, zoneName string, retainEIPs bool) flow.TaskIDer {
+	deleteFlowLog := c.AddTask(g, "delete flow log for zone "+zoneName,
+		c.deleteFlowLog(zoneName),
+		MetricName("delete flow log for zone"), Timeout(defaultTimeout))
+
+	deleteNASMountTarget := c.AddTask(g, "delete NAS mount target for zone "+zoneName,
+		c.deleteNASMountTarget(zoneName),
+		MetricName("delete NAS mount target for zone"), Timeout(defaultLongTimeout))
+
 	deleteSNatEntryForZone := c.AddTask(g, "delete snat entry for zone "+zoneName,
 		c.deleteSNatEntryForZone(zoneName),
-		Timeout(defaultTimeout))
+		MetricName("delete snat entry for zone"), Timeout(defaultTimeout), Dependencies(deleteFlowLog, deleteNASMountTarget))
 
 	deleteEipAssociation := c.AddTask(g, "delete eip association "+zoneName,
 		c.deleteEipAssociation(zoneName),
-		Timeout(defaultTimeout), Dependencies(deleteSNatEntryForZone))
+		MetricName("delete eip association"), Timeout(defaultTimeout), Dependencies(deleteSNatEntryForZone))
 
 	deleteElasticIP := c.AddTask(g, "delete elastic IP "+zoneName,
-		c.deleteElasticIP(zoneName),
-		Timeout(defaultTimeout), Dependencies(deleteEipAssociation))
+		c.deleteElasticIP(zoneName, retainEIPs),
+		MetricName("delete elastic IP"), Timeout(defaultTimeout), Dependencies(deleteEipAssociation))
 
 	return deleteElasticIP
 }
@@ -532,10 +736,15 @@ func (c *FlowContext) deleteSNatEntryForZone(zoneName string) flow.TaskFn {
 		log := c.LogFromContext(ctx)
 		log.Info("deleting snate entry for zone ...", "zoneName", zoneName)
 
-		current, err := c.getCurrentSnatEntryForZone(ctx, zoneName)
+		current, err := c.getCurrentSnatEntryForZone(ctx, c.state.Get(IdentifierNatGateway), zoneName)
+		if err != nil {
+			return err
+		}
+		vpcEntries, err := c.getCurrentSnatEntryForZone(ctx, c.state.Get(IdentifierVPCNatGateway), zoneName)
 		if err != nil {
 			return err
 		}
+		current = append(current, vpcEntries...)
 		toUnAssociateEIPs := sets.New[string]()
 		for _, entry := range current {
 			toUnAssociateEIPs.Insert(entry.IpAddress)
@@ -589,7 +798,7 @@ func (c *FlowContext) deleteEipAssociation(zoneName string) flow.TaskFn {
 	}
 }
 
-func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
+func (c *FlowContext) deleteElasticIP(zoneName string, retain bool) flow.TaskFn {
 	return func(ctx context.Context) error {
 		log := c.LogFromContext(ctx)
 		log.Info("deleting Eip for zone ...", "zoneName", zoneName)
@@ -604,7 +813,14 @@ func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
 		if err != nil {
 			return err
 		}
-		if current != nil {
+		if current != nil && retain {
+			if err := c.moveEIPToPool(ctx, current); err != nil {
+				return err
+			}
+		} else if current != nil {
+			if err := c.liftEIPDeletionProtection(ctx, current); err != nil {
+				return err
+			}
 			log.Info("deleting eip ...", "AllocationId", current.EipId)
 			waiter := informOnWaiting(log, 5*time.Second, "still deleting eip ...", "AllocationId", current.EipId)
 			err = c.actor.DeleteEIP(ctx, current.EipId)
@@ -612,13 +828,25 @@ func (c *FlowContext) deleteElasticIP(zoneName string) flow.TaskFn {
 			if err != nil {
 				return err
 			}
+			c.ResourceDeleted("EIP", current.EipId)
 		}
 		child.SetAsDeleted(IdentifierZoneNATGWElasticIP)
 		return nil
 	}
 }
 
-func (c *FlowContext) deleteNatGatewayInVSwitches(vswitchIds []string) flow.TaskFn {
+// liftEIPDeletionProtection disables the deletion protection of the EIP right before it is deleted.
+func (c *FlowContext) liftEIPDeletionProtection(ctx context.Context, eip *aliclient.EIP) error {
+	if !eip.DeletionProtection {
+		return nil
+	}
+	c.LogFromContext(ctx).Info("disabling deletion protection of eip ...", "AllocationId", eip.EipId)
+	return c.actor.SetEIPDeletionProtection(ctx, eip.EipId, false)
+}
+
+// deleteNatGatewayInVSwitches deletes the managed NAT gateway if it is located in one of the managedVSwitchIds.
+// If the NAT gateway can neither be found by its id nor its tags, it is searched in the ownedVSwitchIds only.
+func (c *FlowContext) deleteNatGatewayInVSwitches(managedVSwitchIds, ownedVSwitchIds []string) flow.TaskFn {
 	return func(ctx context.Context) error {
 		log := c.LogFromContext(ctx)
 		log.Info("deleting managed natgateway in vswitches ...")
@@ -632,14 +860,45 @@ func (c *FlowContext) deleteNatGatewayInVSwitches(vswitchIds []string) flow.Task
 			if vpcId == nil {
 				return fmt.Errorf("IdentifierVPC is nil")
 			}
-			ngw_in_vsw_list, err := c.actor.ListNatGatewaysByVSwitchInVPC(ctx, *vpcId, vswitchIds)
+			if len(ownedVSwitchIds) == 0 {
+				return nil
+			}
+			ngw_in_vsw_list, err := c.actor.ListNatGatewaysByVSwitchInVPC(ctx, *vpcId, ownedVSwitchIds)
 			if err != nil {
 				return err
 			}
-			if len(ngw_in_vsw_list) > 0 {
-				current = ngw_in_vsw_list[0]
+			for _, ngw := range ngw_in_vsw_list {
+				if ngw.NetworkType != aliclient.NatGatewayNetworkTypeIntranet {
+					current = ngw
+					break
+				}
+			}
+		}
+		if current != nil && contains(managedVSwitchIds, *current.VswitchId) {
+			if err := c.deleteSNatEntryForNatGateway(ctx, current); err != nil {
+				return err
+			}
+
+			if err := c.deleteNatGateway(ctx, current); err != nil {
+				return err
 			}
 		}
+		return nil
+	}
+}
+
+func (c *FlowContext) deleteVPCNatGatewayInVSwitches(vswitchIds []string) flow.TaskFn {
+	return func(ctx context.Context) error {
+		if c.state.IsAlreadyDeleted(IdentifierVPCNatGateway) {
+			return nil
+		}
+		log := c.LogFromContext(ctx)
+		log.Info("deleting managed vpc natgateway in vswitches ...")
+		current, err := findExisting(ctx, c.state.Get(IdentifierVPCNatGateway), c.commonTagsWithSuffix("vpc-natgw"),
+			c.actor.GetNatGateway, c.actor.FindNatGatewayByTags)
+		if err != nil {
+			return err
+		}
 		if current != nil && contains(vswitchIds, *current.VswitchId) {
 			if err := c.deleteSNatEntryForNatGateway(ctx, current); err != nil {
 				return err
@@ -676,6 +935,12 @@ func (c *FlowContext) deleteSNatEntryForNatGateway(ctx context.Context, ngw *ali
 
 func (c *FlowContext) deleteNatGateway(ctx context.Context, ngw *aliclient.NatGateway) error {
 	log := c.LogFromContext(ctx)
+	if ngw.DeletionProtection {
+		log.Info("disabling deletion protection of natgateway ...", "NatgatewayId", ngw.NatGatewayId)
+		if err := c.actor.SetNatGatewayDeletionProtection(ctx, ngw.NatGatewayId, false); err != nil {
+			return err
+		}
+	}
 	log.Info("deleting natgateway ...", "NatgatewayId", ngw.NatGatewayId)
 	waiter := informOnWaiting(log, 10*time.Second, "still deleting natgateway ...", "NatGatewayID", ngw.NatGatewayId)
 	err := c.actor.DeleteNatGateway(ctx, ngw.NatGatewayId)
@@ -683,9 +948,11 @@ func (c *FlowContext) deleteNatGateway(ctx context.Context, ngw *aliclient.NatGa
 	if err != nil {
 		return err
 	}
-	ngwId := c.state.Get(IdentifierNatGateway)
-	if ngwId != nil && *ngwId == ngw.NatGatewayId {
-		c.state.SetAsDeleted(IdentifierNatGateway)
+	c.ResourceDeleted("NAT gateway", ngw.NatGatewayId)
+	for _, key := range []string{IdentifierNatGateway, IdentifierVPCNatGateway} {
+		if ngwId := c.state.Get(key); ngwId != nil && *ngwId == ngw.NatGatewayId {
+			c.state.SetAsDeleted(key)
+		}
 	}
 	return nil
 }
@@ -705,6 +972,7 @@ func (c *FlowContext) deleteVSwitch(vsw *aliclient.VSwitch) flow.TaskFn {
 		if err := c.actor.DeleteVSwitch(ctx, vsw.VSwitchId); err != nil {
 			return err
 		}
+		c.ResourceDeleted("vswitch", vsw.VSwitchId)
 		targetZoneName := getZoneName(vsw)
 		if c.state.GetChild(ChildIdZones).HasChild(targetZoneName) {
 			child := c.getZoneChild(targetZoneName)
@@ -723,7 +991,7 @@ func (c *FlowContext) deleteZones(ctx context.Context) error {
 	if err != nil {
 		return err
 	}
-	return c.DeleteZoneByVSwitches(ctx, current)
+	return c.deleteZoneByVSwitches(ctx, current, false, true)
 }
 
 func (c *FlowContext) getZoneConfig(zoneName string) *alicloud.Zone {
@@ -738,3 +1006,11 @@ func (c *FlowContext) getZoneConfig(zoneName string) *alicloud.Zone {
 func (c *FlowContext) getZoneChild(zoneName string) Whiteboard {
 	return c.state.GetChild(ChildIdZones).GetChild(zoneName)
 }
+
+// vpcSnatIPAddress returns the IP address the egress traffic of a zone is translated to by the VPC NAT gateway.
+func vpcSnatIPAddress(ngw *aliclient.NatGateway, snatIPAddress *string) string {
+	if snatIPAddress != nil {
+		return *snatIPAddress
+	}
+	return ngw.PrivateIpAddress
+}
@@ -0,0 +1,182 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package infraflow_test
+
+import (
+	"context"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/log"
+
+	apisalicloud "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("Zones", func() {
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+
+		vswA *aliclient.VSwitch
+		vswB *aliclient.VSwitch
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{
+				Name:        "infra",
+				Namespace:   "shoot--foo--bar",
+				Annotations: map[string]string{apisalicloud.AnnotationKeyFlowReconcileCanDeleteResource: "true"},
+			},
+			Spec: extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{ID: ptr.To("vpc-1")},
+				Zones: []apisalicloud.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19", VSwitchID: ptr.To("vsw-a")}},
+			},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC: "vpc-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch:     "vsw-a",
+			"Zones/cn-beijing-a/" + MarkerZoneVSwitchNotOwned: "true",
+			"Zones/cn-beijing-b/" + IdentifierZoneVSwitch:     "vsw-b",
+			"Zones/cn-beijing-b/" + MarkerZoneVSwitchNotOwned: "true",
+			"Zones/cn-beijing-b/" + IdentifierZoneSuffix:      "z1",
+		}
+		vswA = &aliclient.VSwitch{VSwitchId: "vsw-a", VpcId: ptr.To("vpc-1"), ZoneId: "cn-beijing-a", CidrBlock: "10.0.0.0/19"}
+		vswB = &aliclient.VSwitch{VSwitchId: "vsw-b", VpcId: ptr.To("vpc-1"), ZoneId: "cn-beijing-b", CidrBlock: "10.0.32.0/19"}
+
+		actor.EXPECT().ListVSwitches(ctx, gomock.Any()).Return([]*aliclient.VSwitch{vswA, vswB}, nil).AnyTimes()
+		actor.EXPECT().FindVSwitchesByTags(ctx, gomock.Any()).Return(nil, nil).AnyTimes()
+		actor.EXPECT().FindFlowLogsByTags(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		actor.EXPECT().FindEIPsByTags(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		actor.EXPECT().FindNatGatewayByTags(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		actor.EXPECT().FindSNatEntriesByNatGateway(gomock.Any(), gomock.Any()).Return(nil, nil).AnyTimes()
+		actor.EXPECT().DeleteVSwitch(gomock.Any(), gomock.Any()).Times(0)
+		actor.EXPECT().UnassociateRouteTable(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+	})
+
+	newFlowContext := func() *FlowContext {
+		return NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil)
+	}
+
+	It("should keep the adopted vswitch of a removed zone", func() {
+		actor.EXPECT().GetVSwitch(ctx, "vsw-a").Return(vswA, nil)
+		actor.EXPECT().FindVSwitchesByVPC(ctx, "vpc-1").Return([]*aliclient.VSwitch{vswA, vswB}, nil)
+
+		flowContext := newFlowContext()
+		Expect(flowContext.EnsureVSwitches(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).NotTo(HaveKey("Zones/cn-beijing-b/" + IdentifierZoneVSwitch))
+		Expect(flowContext.ExportState()).To(HaveKeyWithValue("Zones/cn-beijing-a/"+IdentifierZoneVSwitch, "vsw-a"))
+	})
+
+	It("should keep adopted vswitches but delete the managed NAT gateway located in them on deletion", func() {
+		config.Networks.VPC.GardenerManagedNATGateway = ptr.To(true)
+		state[IdentifierNatGateway] = "ngw-1"
+		ngw := &aliclient.NatGateway{NatGatewayId: "ngw-1", VswitchId: ptr.To("vsw-a")}
+		actor.EXPECT().GetNatGateway(gomock.Any(), "ngw-1").Return(ngw, nil)
+		actor.EXPECT().DeleteNatGateway(gomock.Any(), "ngw-1")
+
+		flowContext := newFlowContext()
+		Expect(flowContext.DeleteZones(ctx)).To(Succeed())
+		Expect(flowContext.ExportState()).NotTo(HaveKey("Zones/cn-beijing-b/" + IdentifierZoneVSwitch))
+	})
+
+	It("should not search NAT gateways in vswitches not owned by the shoot", func() {
+		config.Networks.VPC.GardenerManagedNATGateway = ptr.To(true)
+		actor.EXPECT().ListNatGatewaysByVSwitchInVPC(gomock.Any(), gomock.Any(), gomock.Any()).Times(0)
+
+		Expect(newFlowContext().DeleteZones(ctx)).To(Succeed())
+	})
+})
+
+var _ = Describe("Deletion protection", func() {
+	var (
+		ctx   context.Context
+		ctrl  *gomock.Controller
+		actor *mockaliclient.MockActor
+
+		infra  *extensionsv1alpha1.Infrastructure
+		config *apisalicloud.InfrastructureConfig
+		state  shared.FlatMap
+	)
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar"},
+			Spec:       extensionsv1alpha1.InfrastructureSpec{Region: "cn-beijing"},
+		}
+		config = &apisalicloud.InfrastructureConfig{
+			Networks: apisalicloud.Networks{
+				VPC:   apisalicloud.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []apisalicloud.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19"}},
+			},
+		}
+		state = shared.FlatMap{
+			IdentifierVPC:        "vpc-1",
+			IdentifierNatGateway: "ngw-1",
+			"Zones/cn-beijing-a/" + IdentifierZoneVSwitch:        "vsw-a",
+			"Zones/cn-beijing-a/" + IdentifierZoneSuffix:         "z0",
+			"Zones/cn-beijing-a/" + IdentifierZoneNATGWElasticIP: "eip-1",
+		}
+
+		actor.EXPECT().ListVSwitches(ctx, []string{"vsw-a"}).Return([]*aliclient.VSwitch{
+			{VSwitchId: "vsw-a", VpcId: ptr.To("vpc-1"), ZoneId: "cn-beijing-a", CidrBlock: "10.0.0.0/19"},
+		}, nil)
+		actor.EXPECT().FindVSwitchesByTags(ctx, gomock.Any()).Return(nil, nil)
+		actor.EXPECT().FindFlowLogsByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+		actor.EXPECT().FindSNatEntriesByNatGateway(gomock.Any(), "ngw-1").Return(nil, nil).AnyTimes()
+		actor.EXPECT().FindNatGatewayByTags(gomock.Any(), gomock.Any()).Return(nil, nil)
+		actor.EXPECT().DeleteVSwitch(gomock.Any(), "vsw-a")
+	})
+
+	It("should lift the deletion protection right before deleting the EIP and the NAT gateway", func() {
+		eip := &aliclient.EIP{EipId: "eip-1", Status: ptr.To("Available"), DeletionProtection: true}
+		actor.EXPECT().GetEIP(gomock.Any(), "eip-1").Return(eip, nil).Times(2)
+		gomock.InOrder(
+			actor.EXPECT().SetEIPDeletionProtection(gomock.Any(), "eip-1", false),
+			actor.EXPECT().DeleteEIP(gomock.Any(), "eip-1"),
+		)
+		actor.EXPECT().GetNatGateway(gomock.Any(), "ngw-1").Return(&aliclient.NatGateway{NatGatewayId: "ngw-1", VswitchId: ptr.To("vsw-a"), DeletionProtection: true}, nil)
+		gomock.InOrder(
+			actor.EXPECT().SetNatGatewayDeletionProtection(gomock.Any(), "ngw-1", false),
+			actor.EXPECT().DeleteNatGateway(gomock.Any(), "ngw-1"),
+		)
+
+		Expect(NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil).DeleteZones(ctx)).To(Succeed())
+	})
+
+	It("should not lift the deletion protection of unprotected resources", func() {
+		eip := &aliclient.EIP{EipId: "eip-1", Status: ptr.To("Available")}
+		actor.EXPECT().GetEIP(gomock.Any(), "eip-1").Return(eip, nil).Times(2)
+		actor.EXPECT().DeleteEIP(gomock.Any(), "eip-1")
+		actor.EXPECT().GetNatGateway(gomock.Any(), "ngw-1").Return(&aliclient.NatGateway{NatGatewayId: "ngw-1", VswitchId: ptr.To("vsw-a")}, nil)
+		actor.EXPECT().DeleteNatGateway(gomock.Any(), "ngw-1")
+
+		Expect(NewFlowContextWithActor(log.Log, actor, infra, config, state, nil, nil).DeleteZones(ctx)).To(Succeed())
+	})
+})
@@ -12,7 +12,9 @@ import (
 
 	"github.com/gardener/gardener/pkg/utils/flow"
 	"github.com/go-logr/logr"
+	corev1 "k8s.io/api/core/v1"
 	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
 	logf "sigs.k8s.io/controller-runtime/pkg/log"
 )
 
@@ -21,6 +23,9 @@ type TaskOption struct {
 	Dependencies []flow.TaskIDer
 	Timeout      time.Duration
 	DoIf         *bool
+	// MetricName is the static task name used as label of the task metrics.
+	// It must be set for tasks whose name contains zone names or resource IDs.
+	MetricName string
 }
 
 // Dependencies creates a TaskOption for dependencies
@@ -38,6 +43,11 @@ func DoIf(condition bool) TaskOption {
 	return TaskOption{DoIf: ptr.To(condition)}
 }
 
+// MetricName creates a TaskOption for the static task name used in the task metrics
+func MetricName(name string) TaskOption {
+	return TaskOption{MetricName: name}
+}
+
 // FlowStatePersistor persists the flat map to the provider status
 type FlowStatePersistor func(ctx context.Context, flatMap FlatMap) error
 
@@ -51,6 +61,13 @@ type BasicFlowContext struct {
 	lastPersistedGeneration int64
 	lastPersistedAt         time.Time
 	PersistInterval         time.Duration
+	// Owner identifies the object the flows are run for, e.g. the namespace of the shoot.
+	// It is used to detect retries of previously failed tasks for the task metrics.
+	Owner string
+
+	eventRecorder *EventRecorder
+	eventObject   client.Object
+	graphs        *GraphRecorder
 }
 
 // StateExporter knows how to export the internal state to a flat string map.
@@ -68,10 +85,37 @@ func NewBasicFlowContext(log logr.Logger, exporter StateExporter, persistor Flow
 		exporter:           exporter,
 		flowStatePersistor: persistor,
 		PersistInterval:    10 * time.Second,
+		graphs:             NewGraphRecorder(),
 	}
 	return flowContext
 }
 
+// SetGraphRecorder sets the recorder used to record the built graphs and their last runs.
+func (c *BasicFlowContext) SetGraphRecorder(recorder *GraphRecorder) {
+	c.graphs = recorder
+}
+
+// GraphRecorder returns the recorder of the built graphs and their last runs.
+func (c *BasicFlowContext) GraphRecorder() *GraphRecorder {
+	return c.graphs
+}
+
+// SetEventRecorder sets the recorder used to record the progress of the flows as events on the given object.
+func (c *BasicFlowContext) SetEventRecorder(recorder *EventRecorder, object client.Object) {
+	c.eventRecorder = recorder
+	c.eventObject = object
+}
+
+// ResourceCreated records an event for a cloud resource created by a flow task, e.g. "created NAT gateway ngw-xxx".
+func (c *BasicFlowContext) ResourceCreated(kind, id string) {
+	c.eventRecorder.Eventf(c.eventObject, corev1.EventTypeNormal, EventReasonResourceCreated, eventActionCreate, "created %s %s", kind, id)
+}
+
+// ResourceDeleted records an event for a cloud resource deleted by a flow task.
+func (c *BasicFlowContext) ResourceDeleted(kind, id string) {
+	c.eventRecorder.Eventf(c.eventObject, corev1.EventTypeNormal, EventReasonResourceDeleted, eventActionDelete, "deleted %s %s", kind, id)
+}
+
 // PersistState persists the internal state to the provider status if it has changed and force is true
 // or it has not been persisted during the `PersistInterval`.
 func (c *BasicFlowContext) PersistState(ctx context.Context, force bool) error {
@@ -115,6 +159,9 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 		if opt.Timeout > 0 {
 			allOptions.Timeout = opt.Timeout
 		}
+		if opt.MetricName != "" {
+			allOptions.MetricName = opt.MetricName
+		}
 		if opt.DoIf != nil {
 			condition := true
 			if allOptions.DoIf != nil {
@@ -125,13 +172,17 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 		}
 	}
 
+	metricName := name
+	if allOptions.MetricName != "" {
+		metricName = allOptions.MetricName
+	}
 	tunedFn := fn
 	if allOptions.Timeout > 0 {
 		tunedFn = tunedFn.Timeout(allOptions.Timeout)
 	}
 	task := flow.Task{
 		Name:   name,
-		Fn:     c.wrapTaskFn(g.Name(), name, tunedFn),
+		Fn:     c.wrapTaskFn(g.Name(), name, metricName, tunedFn),
 		SkipIf: allOptions.DoIf != nil && !*allOptions.DoIf,
 	}
 
@@ -139,16 +190,53 @@ func (c *BasicFlowContext) AddTask(g *flow.Graph, name string, fn flow.TaskFn, o
 		task.Dependencies = flow.NewTaskIDs(allOptions.Dependencies...)
 	}
 
+	c.graphs.addTask(g, name, allOptions)
 	return g.Add(task)
 }
 
-func (c *BasicFlowContext) wrapTaskFn(flowName, taskName string, fn flow.TaskFn) flow.TaskFn {
+// RunFlow compiles and runs the given graph and records its duration in the flow metrics.
+func (c *BasicFlowContext) RunFlow(ctx context.Context, g *flow.Graph) error {
+	start := time.Now()
+	c.graphs.startFlow(g)
+	err := g.Compile().Run(ctx, flow.Opts{Log: c.Log})
+	c.graphs.finishFlow(g, err)
+	FlowDuration.WithLabelValues(g.Name(), resultLabel(err)).Observe(time.Since(start).Seconds())
+	if err != nil {
+		return flow.Causes(err)
+	}
+	// tasks which failed before but were skipped in this run are not retried anymore
+	forgetFailedTasks(c.Owner, g.Name())
+	return nil
+}
+
+// ForgetFailedTasks drops the failed tasks recorded for the owner of the flow context, e.g. after its resources were deleted.
+func (c *BasicFlowContext) ForgetFailedTasks() {
+	forgetFailedTasks(c.Owner, "")
+}
+
+// wrapTaskFn wraps the task function with logging, events, metrics and state persistence.
+// The metrics are labeled with the static metricName to keep their cardinality bounded, the taskName
+// is used for logs, events, the graph recorder and to track failed tasks for the retry metric.
+func (c *BasicFlowContext) wrapTaskFn(flowName, taskName, metricName string, fn flow.TaskFn) flow.TaskFn {
 	return func(ctx context.Context) error {
 		taskCtx := logf.IntoContext(ctx, c.Log.WithValues("flow", flowName, "task", taskName))
+		if recordTaskStart(c.Owner, flowName, taskName) {
+			TaskRetries.WithLabelValues(flowName, metricName).Inc()
+		}
+		c.eventRecorder.Eventf(c.eventObject, corev1.EventTypeNormal, EventReasonTaskStarted, eventActionRunTask, "task %q started", taskName)
+		c.graphs.startTask(flowName, taskName)
+		start := time.Now()
 		err := fn(taskCtx)
+		c.graphs.finishTask(flowName, taskName, err)
+		TaskDuration.WithLabelValues(flowName, metricName, resultLabel(err)).Observe(time.Since(start).Seconds())
+		recordTaskResult(c.Owner, flowName, taskName, err)
 		if err != nil {
+			TaskFailures.WithLabelValues(flowName, metricName, errorCodeLabel(err)).Inc()
+			c.eventRecorder.Eventf(c.eventObject, corev1.EventTypeWarning, EventReasonTaskFailed, eventActionRunTask, "task %q failed: %s", taskName, err)
 			// don't wrap error with '%w', as otherwise the error context get lost
 			err = fmt.Errorf("failed to %s: %s", taskName, err)
+		} else {
+			c.eventRecorder.Eventf(c.eventObject, corev1.EventTypeNormal, EventReasonTaskSucceeded, eventActionRunTask, "task %q succeeded", taskName)
 		}
 		if perr := c.PersistState(taskCtx, true); perr != nil {
 			if err != nil {
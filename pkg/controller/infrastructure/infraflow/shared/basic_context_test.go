@@ -14,6 +14,7 @@ import (
 	"github.com/go-logr/logr"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
+	"github.com/prometheus/client_golang/prometheus/testutil"
 	"k8s.io/utils/ptr"
 	"sigs.k8s.io/controller-runtime/pkg/log/zap"
 
@@ -152,4 +153,89 @@ var _ = Describe("BasicFlowContext", func() {
 			Expect(err).ToNot(HaveOccurred())
 		})
 	})
+
+	It("should record task metrics", func() {
+		var (
+			ctx      = context.Background()
+			failTask = true
+			flowName = "metrics test"
+			taskName = "create resource"
+			c        = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+			g        = flow.NewGraph(flowName)
+			failures = shared.TaskFailures.WithLabelValues(flowName, taskName, "ERR_INFRA_QUOTA_EXCEEDED")
+			retries  = shared.TaskRetries.WithLabelValues(flowName, taskName)
+		)
+		c.Owner = "shoot--foo--bar"
+		_ = c.AddTask(g, taskName, func(_ context.Context) error {
+			if failTask {
+				return fmt.Errorf("QuotaExceeded.Eip: quota exceeded")
+			}
+			return nil
+		})
+
+		Expect(c.RunFlow(ctx, g)).To(HaveOccurred())
+		Expect(testutil.ToFloat64(failures)).To(Equal(1.0))
+		Expect(testutil.ToFloat64(retries)).To(Equal(0.0))
+
+		failTask = false
+		Expect(c.RunFlow(ctx, g)).To(Succeed())
+		Expect(testutil.ToFloat64(failures)).To(Equal(1.0))
+		Expect(testutil.ToFloat64(retries)).To(Equal(1.0))
+		Expect(testutil.CollectAndCount(shared.TaskDuration)).To(BeNumerically(">=", 2))
+		Expect(testutil.CollectAndCount(shared.FlowDuration)).To(BeNumerically(">=", 2))
+
+		Expect(c.RunFlow(ctx, g)).To(Succeed())
+		Expect(testutil.ToFloat64(retries)).To(Equal(1.0))
+	})
+
+	It("should label the task metrics with the static metric name", func() {
+		var (
+			ctx      = context.Background()
+			flowName = "metric name test"
+			c        = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+			g        = flow.NewGraph(flowName)
+		)
+		c.Owner = "shoot--foo--metricname"
+		for _, zone := range []string{"zone-a", "zone-b"} {
+			_ = c.AddTask(g, "create resource "+zone+" vsw-"+zone, func(_ context.Context) error {
+				return fmt.Errorf("failed")
+			}, shared.MetricName("create resource"))
+		}
+
+		Expect(c.RunFlow(ctx, g)).To(HaveOccurred())
+		Expect(testutil.ToFloat64(shared.TaskFailures.WithLabelValues(flowName, "create resource", "unknown"))).To(Equal(2.0))
+		Expect(shared.TaskFailures.DeleteLabelValues(flowName, "create resource zone-a vsw-zone-a", "unknown")).To(BeFalse())
+		Expect(shared.FailedTasksOfOwner(c.Owner)).To(Equal(2))
+	})
+
+	It("should forget the failed tasks of a succeeded flow and of a deleted owner", func() {
+		var (
+			ctx      = context.Background()
+			runTask  = true
+			flowName = "cleanup test"
+			c        = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+		)
+		c.Owner = "shoot--foo--cleanup"
+		newGraph := func() *flow.Graph {
+			g := flow.NewGraph(flowName)
+			_ = c.AddTask(g, "failing task", func(_ context.Context) error {
+				return fmt.Errorf("failed")
+			}, shared.DoIf(runTask))
+			return g
+		}
+
+		Expect(c.RunFlow(ctx, newGraph())).To(HaveOccurred())
+		Expect(shared.FailedTasksOfOwner(c.Owner)).To(Equal(1))
+
+		runTask = false
+		Expect(c.RunFlow(ctx, newGraph())).To(Succeed())
+		Expect(shared.FailedTasksOfOwner(c.Owner)).To(Equal(0))
+
+		runTask = true
+		Expect(c.RunFlow(ctx, newGraph())).To(HaveOccurred())
+		Expect(shared.FailedTasksOfOwner(c.Owner)).To(Equal(1))
+
+		c.ForgetFailedTasks()
+		Expect(shared.FailedTasksOfOwner(c.Owner)).To(Equal(0))
+	})
 })
@@ -0,0 +1,124 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"fmt"
+	"sync"
+	"time"
+
+	"golang.org/x/time/rate"
+	corev1 "k8s.io/api/core/v1"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/client-go/tools/events"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+)
+
+const (
+	// EventReasonTaskStarted is the event reason used when a flow task is started.
+	EventReasonTaskStarted = "FlowTaskStarted"
+	// EventReasonTaskSucceeded is the event reason used when a flow task has succeeded.
+	EventReasonTaskSucceeded = "FlowTaskSucceeded"
+	// EventReasonTaskFailed is the event reason used when a flow task has failed.
+	EventReasonTaskFailed = "FlowTaskFailed"
+	// EventReasonResourceCreated is the event reason used when a flow task has created a cloud resource.
+	EventReasonResourceCreated = "ResourceCreated"
+	// EventReasonResourceDeleted is the event reason used when a flow task has deleted a cloud resource.
+	EventReasonResourceDeleted = "ResourceDeleted"
+
+	// eventActionRunTask is the event action for the flow task events.
+	eventActionRunTask = "RunFlowTask"
+	// eventActionCreate is the event action for created cloud resources.
+	eventActionCreate = "Create"
+	// eventActionDelete is the event action for deleted cloud resources.
+	eventActionDelete = "Delete"
+
+	// DefaultEventDeduplicationInterval is the interval during which identical events for the same object are dropped.
+	DefaultEventDeduplicationInterval = 30 * time.Minute
+	// defaultEventRate is the number of events per second which may be recorded for the same object.
+	defaultEventRate = rate.Limit(0.2)
+	// defaultEventBurst is the number of events which may be recorded for the same object at once.
+	defaultEventBurst = 25
+)
+
+// EventRecorder records events on the objects the flows are run for. Identical events for the same object are
+// deduplicated within the deduplication interval and the rate of normal events per object is limited, so that retries
+// of failing flows do not flood the API server. Warning events are only deduplicated, so that failures are not dropped
+// after a busy reconciliation has used up the burst of the progress events.
+type EventRecorder struct {
+	recorder              events.EventRecorder
+	deduplicationInterval time.Duration
+
+	lock     sync.Mutex
+	limiters map[types.UID]*eventLimiter
+}
+
+type eventLimiter struct {
+	limiter  *rate.Limiter
+	lastSeen time.Time
+	recorded map[string]time.Time
+}
+
+// NewEventRecorder creates a new EventRecorder.
+func NewEventRecorder(recorder events.EventRecorder, deduplicationInterval time.Duration) *EventRecorder {
+	return &EventRecorder{
+		recorder:              recorder,
+		deduplicationInterval: deduplicationInterval,
+		limiters:              map[types.UID]*eventLimiter{},
+	}
+}
+
+// Eventf records an event on the given object if it is not a duplicate and, for normal events, does not exceed the rate limit.
+func (r *EventRecorder) Eventf(object client.Object, eventtype, reason, action, note string, args ...any) {
+	if r == nil || object == nil {
+		return
+	}
+	message := fmt.Sprintf(note, args...)
+	if !r.allow(object.GetUID(), eventtype+"/"+reason+"/"+message, eventtype != corev1.EventTypeWarning) {
+		return
+	}
+	r.recorder.Eventf(object, nil, eventtype, reason, action, "%s", message)
+}
+
+func (r *EventRecorder) allow(uid types.UID, key string, rateLimited bool) bool {
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	now := time.Now()
+	r.prune(now)
+
+	l := r.limiters[uid]
+	if l == nil {
+		l = &eventLimiter{
+			limiter:  rate.NewLimiter(defaultEventRate, defaultEventBurst),
+			recorded: map[string]time.Time{},
+		}
+		r.limiters[uid] = l
+	}
+	l.lastSeen = now
+	if recordedAt, ok := l.recorded[key]; ok && now.Sub(recordedAt) < r.deduplicationInterval {
+		return false
+	}
+	if rateLimited && !l.limiter.AllowN(now, 1) {
+		return false
+	}
+	l.recorded[key] = now
+	return true
+}
+
+// prune drops the deduplication state which has expired. It must be called with the lock held.
+func (r *EventRecorder) prune(now time.Time) {
+	for uid, l := range r.limiters {
+		if now.Sub(l.lastSeen) >= r.deduplicationInterval {
+			delete(r.limiters, uid)
+			continue
+		}
+		for key, recordedAt := range l.recorded {
+			if now.Sub(recordedAt) >= r.deduplicationInterval {
+				delete(l.recorded, key)
+			}
+		}
+	}
+}
@@ -0,0 +1,95 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared_test
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/client-go/tools/events"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("EventRecorder", func() {
+	var (
+		fakeRecorder *events.FakeRecorder
+		recorder     *shared.EventRecorder
+		infra        *extensionsv1alpha1.Infrastructure
+	)
+
+	BeforeEach(func() {
+		fakeRecorder = events.NewFakeRecorder(100)
+		recorder = shared.NewEventRecorder(fakeRecorder, time.Hour)
+		infra = &extensionsv1alpha1.Infrastructure{ObjectMeta: metav1.ObjectMeta{Name: "infra", Namespace: "shoot--foo--bar", UID: "uid"}}
+	})
+
+	It("should deduplicate identical events", func() {
+		recorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "created %s", "ngw-1")
+		recorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "created %s", "ngw-1")
+		recorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "created %s", "ngw-2")
+
+		Expect(fakeRecorder.Events).To(HaveLen(2))
+		Expect(<-fakeRecorder.Events).To(Equal("Normal Reason created ngw-1"))
+		Expect(<-fakeRecorder.Events).To(Equal("Normal Reason created ngw-2"))
+	})
+
+	It("should limit the rate of events per object", func() {
+		for i := 0; i < 50; i++ {
+			recorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "event %d", i)
+		}
+		Expect(len(fakeRecorder.Events)).To(BeNumerically("<", 50))
+	})
+
+	It("should record failures after the burst of normal events is exhausted", func() {
+		for i := 0; i < 50; i++ {
+			recorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "event %d", i)
+		}
+		recorded := len(fakeRecorder.Events)
+
+		recorder.Eventf(infra, corev1.EventTypeWarning, shared.EventReasonTaskFailed, "Action", "task %q failed", "create eip")
+		recorder.Eventf(infra, corev1.EventTypeWarning, shared.EventReasonTaskFailed, "Action", "task %q failed", "create eip")
+
+		Expect(fakeRecorder.Events).To(HaveLen(recorded + 1))
+		for range recorded {
+			<-fakeRecorder.Events
+		}
+		Expect(<-fakeRecorder.Events).To(Equal(`Warning FlowTaskFailed task "create eip" failed`))
+	})
+
+	It("should ignore a nil recorder", func() {
+		var nilRecorder *shared.EventRecorder
+		nilRecorder.Eventf(infra, corev1.EventTypeNormal, "Reason", "Action", "event")
+	})
+
+	It("should record task progress and created resources", func() {
+		c := newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+		c.SetEventRecorder(recorder, infra)
+		g := flow.NewGraph("events")
+		task1 := c.AddTask(g, "create natgateway", func(_ context.Context) error {
+			c.ResourceCreated("NAT gateway", "ngw-xxx")
+			return nil
+		})
+		_ = c.AddTask(g, "create eip", func(_ context.Context) error {
+			return fmt.Errorf("out of stock")
+		}, shared.Dependencies(task1))
+
+		Expect(c.RunFlow(context.Background(), g)).To(HaveOccurred())
+		Expect(fakeRecorder.Events).To(HaveLen(5))
+		Expect(<-fakeRecorder.Events).To(Equal(`Normal FlowTaskStarted task "create natgateway" started`))
+		Expect(<-fakeRecorder.Events).To(Equal(`Normal ResourceCreated created NAT gateway ngw-xxx`))
+		Expect(<-fakeRecorder.Events).To(Equal(`Normal FlowTaskSucceeded task "create natgateway" succeeded`))
+		Expect(<-fakeRecorder.Events).To(Equal(`Normal FlowTaskStarted task "create eip" started`))
+		Expect(<-fakeRecorder.Events).To(Equal(`Warning FlowTaskFailed task "create eip" failed: out of stock`))
+	})
+})
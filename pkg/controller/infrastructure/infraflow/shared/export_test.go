@@ -0,0 +1,20 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import "strings"
+
+// FailedTasksOfOwner returns the number of tasks recorded as failed for the given owner.
+func FailedTasksOfOwner(owner string) int {
+	failedTasksLock.Lock()
+	defer failedTasksLock.Unlock()
+	count := 0
+	for key := range failedTasks {
+		if strings.HasPrefix(key, owner+Separator) {
+			count++
+		}
+	}
+	return count
+}
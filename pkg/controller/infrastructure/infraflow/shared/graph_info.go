@@ -0,0 +1,257 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+	"strings"
+	"sync"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"k8s.io/utils/ptr"
+)
+
+const (
+	// RunStatusRunning is the status of a task or flow which is currently running.
+	RunStatusRunning = "Running"
+	// RunStatusSucceeded is the status of a task or flow which has succeeded.
+	RunStatusSucceeded = "Succeeded"
+	// RunStatusFailed is the status of a task or flow which has failed.
+	RunStatusFailed = "Failed"
+)
+
+// RunInfo contains the timing and the status of the last run of a task or flow.
+type RunInfo struct {
+	StartedAt time.Time `json:"startedAt"`
+	Duration  string    `json:"duration,omitempty"`
+	Status    string    `json:"status"`
+	Error     string    `json:"error,omitempty"`
+}
+
+// TaskInfo describes a task of a flow graph.
+type TaskInfo struct {
+	Name         string   `json:"name"`
+	Dependencies []string `json:"dependencies,omitempty"`
+	// DoIf is the combined condition of all DoIf options of the task. It is nil if the task has no condition.
+	DoIf    *bool    `json:"doIf,omitempty"`
+	Timeout string   `json:"timeout,omitempty"`
+	LastRun *RunInfo `json:"lastRun,omitempty"`
+}
+
+// GraphInfo describes a flow graph with its tasks.
+type GraphInfo struct {
+	Name    string      `json:"name"`
+	Tasks   []*TaskInfo `json:"tasks"`
+	LastRun *RunInfo    `json:"lastRun,omitempty"`
+
+	graph *flow.Graph
+}
+
+// GraphRecorder records the graphs built with `BasicFlowContext.AddTask` together with the results of their last runs,
+// so that they can be rendered for debugging. Graphs which are built during a flow run, e.g. per-zone graphs, are
+// recorded as soon as they are built.
+type GraphRecorder struct {
+	lock   sync.RWMutex
+	graphs map[string]*GraphInfo
+}
+
+// NewGraphRecorder creates a new GraphRecorder.
+func NewGraphRecorder() *GraphRecorder {
+	return &GraphRecorder{graphs: map[string]*GraphInfo{}}
+}
+
+func (r *GraphRecorder) addTask(g *flow.Graph, name string, options TaskOption) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	info := r.graphs[g.Name()]
+	if info == nil || info.graph != g {
+		// the graph has been (re-)built, forget the previous one with the same name
+		info = &GraphInfo{Name: g.Name(), graph: g}
+		r.graphs[g.Name()] = info
+	}
+	task := &TaskInfo{
+		Name: name,
+		DoIf: options.DoIf,
+	}
+	if options.Timeout > 0 {
+		task.Timeout = options.Timeout.String()
+	}
+	for _, dep := range options.Dependencies {
+		task.Dependencies = append(task.Dependencies, flow.NewTaskIDs(dep).StringList()...)
+	}
+	sort.Strings(task.Dependencies)
+	info.Tasks = append(info.Tasks, task)
+}
+
+func (r *GraphRecorder) startFlow(g *flow.Graph) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	info := r.graphs[g.Name()]
+	if info == nil || info.graph != g {
+		info = &GraphInfo{Name: g.Name(), graph: g}
+		r.graphs[g.Name()] = info
+	}
+	info.LastRun = &RunInfo{StartedAt: time.Now(), Status: RunStatusRunning}
+}
+
+func (r *GraphRecorder) finishFlow(g *flow.Graph, err error) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if info := r.graphs[g.Name()]; info != nil && info.LastRun != nil {
+		info.LastRun.finish(err)
+	}
+}
+
+func (r *GraphRecorder) startTask(flowName, taskName string) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if task := r.findTask(flowName, taskName); task != nil {
+		task.LastRun = &RunInfo{StartedAt: time.Now(), Status: RunStatusRunning}
+	}
+}
+
+func (r *GraphRecorder) finishTask(flowName, taskName string, err error) {
+	if r == nil {
+		return
+	}
+	r.lock.Lock()
+	defer r.lock.Unlock()
+
+	if task := r.findTask(flowName, taskName); task != nil && task.LastRun != nil {
+		task.LastRun.finish(err)
+	}
+}
+
+func (r *GraphRecorder) findTask(flowName, taskName string) *TaskInfo {
+	info := r.graphs[flowName]
+	if info == nil {
+		return nil
+	}
+	for _, task := range info.Tasks {
+		if task.Name == taskName {
+			return task
+		}
+	}
+	return nil
+}
+
+func (i *RunInfo) finish(err error) {
+	i.Duration = time.Since(i.StartedAt).Round(time.Millisecond).String()
+	i.Status = RunStatusSucceeded
+	if err != nil {
+		i.Status = RunStatusFailed
+		i.Error = err.Error()
+	}
+}
+
+// Graphs returns a snapshot of the recorded graphs sorted by name.
+// Methods of GraphRecorder may be called on a nil recorder, which records nothing.
+func (r *GraphRecorder) Graphs() []GraphInfo {
+	if r == nil {
+		return nil
+	}
+	r.lock.RLock()
+	defer r.lock.RUnlock()
+
+	var result []GraphInfo
+	for _, info := range r.graphs {
+		graph := GraphInfo{Name: info.Name}
+		if info.LastRun != nil {
+			graph.LastRun = ptr.To(*info.LastRun)
+		}
+		for _, task := range info.Tasks {
+			copied := *task
+			if task.LastRun != nil {
+				copied.LastRun = ptr.To(*task.LastRun)
+			}
+			graph.Tasks = append(graph.Tasks, &copied)
+		}
+		result = append(result, graph)
+	}
+	sort.Slice(result, func(i, j int) bool { return result[i].Name < result[j].Name })
+	return result
+}
+
+// ToJSON renders the recorded graphs as JSON.
+func (r *GraphRecorder) ToJSON() ([]byte, error) {
+	return json.MarshalIndent(r.Graphs(), "", "  ")
+}
+
+// ToDOT renders the recorded graphs in the DOT language, one cluster per graph.
+// Tasks are colored by the status of their last run, tasks with a false DoIf condition are dashed.
+func (r *GraphRecorder) ToDOT() string {
+	var sb strings.Builder
+	sb.WriteString("digraph infraflow {\n")
+	sb.WriteString("  rankdir=LR;\n")
+	sb.WriteString("  node [shape=box, style=rounded];\n")
+	for i, graph := range r.Graphs() {
+		fmt.Fprintf(&sb, "  subgraph cluster_%d {\n", i)
+		fmt.Fprintf(&sb, "    label=\"%s\";\n", dotEscape(graph.Name))
+		for _, task := range graph.Tasks {
+			var attrs []string
+			label := dotEscape(task.Name)
+			if task.LastRun != nil {
+				status := task.LastRun.Status
+				if task.LastRun.Duration != "" {
+					status += " (" + task.LastRun.Duration + ")"
+				}
+				// "\n" is the line break within DOT labels
+				label += `\n` + dotEscape(status)
+				attrs = append(attrs, "color="+dotColor(task.LastRun.Status))
+			}
+			if task.DoIf != nil && !*task.DoIf {
+				attrs = append(attrs, `style="rounded,dashed"`)
+			}
+			attrs = append(attrs, `label="`+label+`"`)
+			fmt.Fprintf(&sb, "    \"%s\" [%s];\n", dotNodeID(graph.Name, task.Name), strings.Join(attrs, ", "))
+		}
+		for _, task := range graph.Tasks {
+			for _, dep := range task.Dependencies {
+				fmt.Fprintf(&sb, "    \"%s\" -> \"%s\";\n", dotNodeID(graph.Name, dep), dotNodeID(graph.Name, task.Name))
+			}
+		}
+		sb.WriteString("  }\n")
+	}
+	sb.WriteString("}\n")
+	return sb.String()
+}
+
+func dotNodeID(graphName, taskName string) string {
+	return dotEscape(graphName + Separator + taskName)
+}
+
+func dotEscape(s string) string {
+	return strings.NewReplacer(`\`, `\\`, `"`, `\"`).Replace(s)
+}
+
+func dotColor(status string) string {
+	switch status {
+	case RunStatusSucceeded:
+		return "green"
+	case RunStatusFailed:
+		return "red"
+	default:
+		return "orange"
+	}
+}
@@ -0,0 +1,87 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared_test
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+	"time"
+
+	"github.com/gardener/gardener/pkg/utils/flow"
+	"github.com/go-logr/logr"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+)
+
+var _ = Describe("GraphRecorder", func() {
+	var (
+		recorder *shared.GraphRecorder
+		c        *testFlowContext
+		g        *flow.Graph
+	)
+
+	BeforeEach(func() {
+		recorder = shared.NewGraphRecorder()
+		c = newTestFlowContext(logr.Discard(), shared.NewWhiteboard(), nil)
+		c.SetGraphRecorder(recorder)
+		g = flow.NewGraph("reconcile")
+		vpc := c.AddTask(g, "ensure vpc", func(_ context.Context) error {
+			return nil
+		}, shared.Timeout(1*time.Minute))
+		_ = c.AddTask(g, "ensure natgateway", func(_ context.Context) error {
+			return fmt.Errorf("quota exceeded")
+		}, shared.Dependencies(vpc))
+		_ = c.AddTask(g, "ensure ipv6 gateway", func(_ context.Context) error {
+			return nil
+		}, shared.Dependencies(vpc), shared.DoIf(false))
+	})
+
+	It("should record the tasks and the last run as JSON", func() {
+		Expect(c.RunFlow(context.Background(), g)).To(HaveOccurred())
+
+		data, err := recorder.ToJSON()
+		Expect(err).NotTo(HaveOccurred())
+		var graphs []shared.GraphInfo
+		Expect(json.Unmarshal(data, &graphs)).To(Succeed())
+
+		Expect(graphs).To(HaveLen(1))
+		Expect(graphs[0].Name).To(Equal("reconcile"))
+		Expect(graphs[0].LastRun.Status).To(Equal(shared.RunStatusFailed))
+		Expect(graphs[0].Tasks).To(HaveLen(3))
+
+		vpc, ngw, ipv6 := graphs[0].Tasks[0], graphs[0].Tasks[1], graphs[0].Tasks[2]
+		Expect(vpc.Timeout).To(Equal("1m0s"))
+		Expect(vpc.LastRun.Status).To(Equal(shared.RunStatusSucceeded))
+		Expect(vpc.LastRun.Duration).NotTo(BeEmpty())
+		Expect(ngw.Dependencies).To(ConsistOf("ensure vpc"))
+		Expect(ngw.LastRun.Status).To(Equal(shared.RunStatusFailed))
+		Expect(ngw.LastRun.Error).To(Equal("quota exceeded"))
+		Expect(*ipv6.DoIf).To(BeFalse())
+		Expect(ipv6.LastRun).To(BeNil())
+	})
+
+	It("should render the graph as DOT", func() {
+		Expect(c.RunFlow(context.Background(), g)).To(HaveOccurred())
+
+		dot := recorder.ToDOT()
+		Expect(dot).To(HavePrefix("digraph infraflow {"))
+		Expect(dot).To(ContainSubstring(`label="reconcile";`))
+		Expect(dot).To(ContainSubstring(`"reconcile/ensure vpc" -> "reconcile/ensure natgateway";`))
+		Expect(dot).To(ContainSubstring(`"reconcile/ensure natgateway" [color=red, label="ensure natgateway\nFailed (`))
+		Expect(dot).To(ContainSubstring(`"reconcile/ensure ipv6 gateway" [style="rounded,dashed", label="ensure ipv6 gateway"];`))
+	})
+
+	It("should forget tasks of a previous build of the graph", func() {
+		g2 := flow.NewGraph("reconcile")
+		_ = c.AddTask(g2, "ensure vpc", func(_ context.Context) error { return nil })
+
+		graphs := recorder.Graphs()
+		Expect(graphs).To(HaveLen(1))
+		Expect(graphs[0].Tasks).To(HaveLen(1))
+	})
+})
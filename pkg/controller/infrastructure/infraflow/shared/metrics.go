@@ -0,0 +1,146 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package shared
+
+import (
+	"slices"
+	"strings"
+	"sync"
+
+	"github.com/gardener/gardener/extensions/pkg/util"
+	"github.com/prometheus/client_golang/prometheus"
+	"sigs.k8s.io/controller-runtime/pkg/metrics"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+)
+
+const (
+	metricsNamespace = "gardener_extension_alicloud"
+	metricsSubsystem = "infraflow"
+
+	labelFlow      = "flow"
+	labelTask      = "task"
+	labelResult    = "result"
+	labelErrorCode = "error_code"
+
+	resultSucceeded = "succeeded"
+	resultFailed    = "failed"
+	// errorCodeUnknown is used as error code label if the error could not be classified.
+	errorCodeUnknown = "unknown"
+)
+
+var (
+	durationBuckets = []float64{0.5, 1, 5, 10, 30, 60, 120, 300, 600, 900}
+
+	// FlowDuration observes the duration of complete flow runs.
+	FlowDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "flow_duration_seconds",
+		Help:      "Duration of infrastructure flow runs in seconds.",
+		Buckets:   durationBuckets,
+	}, []string{labelFlow, labelResult})
+
+	// TaskDuration observes the duration of single flow tasks.
+	TaskDuration = prometheus.NewHistogramVec(prometheus.HistogramOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "task_duration_seconds",
+		Help:      "Duration of infrastructure flow tasks in seconds.",
+		Buckets:   durationBuckets,
+	}, []string{labelFlow, labelTask, labelResult})
+
+	// TaskFailures counts failed flow tasks by the classified Gardener error code.
+	TaskFailures = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "task_failures_total",
+		Help:      "Number of failed infrastructure flow tasks by Gardener error code.",
+	}, []string{labelFlow, labelTask, labelErrorCode})
+
+	// TaskRetries counts flow tasks which are run again after they have failed for the same owner.
+	TaskRetries = prometheus.NewCounterVec(prometheus.CounterOpts{
+		Namespace: metricsNamespace,
+		Subsystem: metricsSubsystem,
+		Name:      "task_retries_total",
+		Help:      "Number of infrastructure flow tasks retried after a previous failure.",
+	}, []string{labelFlow, labelTask})
+
+	// failedTasks keeps track of the tasks which failed on their last run, keyed by owner, flow and metric name of the task.
+	failedTasks     = map[string]struct{}{}
+	failedTasksLock sync.Mutex
+)
+
+func init() {
+	metrics.Registry.MustRegister(FlowDuration, TaskDuration, TaskFailures, TaskRetries)
+}
+
+func resultLabel(err error) string {
+	if err != nil {
+		return resultFailed
+	}
+	return resultSucceeded
+}
+
+// errorCodeLabel returns the Gardener error codes determined for the given error as a sorted, comma separated list.
+func errorCodeLabel(err error) string {
+	var codes []string
+	for _, code := range util.DetermineErrorCodes(err, helper.KnownCodes) {
+		codes = append(codes, string(code))
+	}
+	if len(codes) == 0 {
+		return errorCodeUnknown
+	}
+	slices.Sort(codes)
+	return strings.Join(codes, ",")
+}
+
+// recordTaskStart returns true if the task failed on its last run for the same owner.
+func recordTaskStart(owner, flowName, taskName string) bool {
+	if owner == "" {
+		return false
+	}
+	failedTasksLock.Lock()
+	defer failedTasksLock.Unlock()
+	_, retry := failedTasks[failedTaskKey(owner, flowName, taskName)]
+	return retry
+}
+
+func recordTaskResult(owner, flowName, taskName string, err error) {
+	if owner == "" {
+		return
+	}
+	key := failedTaskKey(owner, flowName, taskName)
+	failedTasksLock.Lock()
+	defer failedTasksLock.Unlock()
+	if err != nil {
+		failedTasks[key] = struct{}{}
+	} else {
+		delete(failedTasks, key)
+	}
+}
+
+// forgetFailedTasks removes the failed tasks of the given owner. If flowName is not empty, only the tasks
+// of this flow are removed.
+func forgetFailedTasks(owner, flowName string) {
+	if owner == "" {
+		return
+	}
+	prefix := owner + Separator
+	if flowName != "" {
+		prefix += flowName + Separator
+	}
+	failedTasksLock.Lock()
+	defer failedTasksLock.Unlock()
+	for key := range failedTasks {
+		if strings.HasPrefix(key, prefix) {
+			delete(failedTasks, key)
+		}
+	}
+}
+
+func failedTaskKey(owner, flowName, taskName string) string {
+	return owner + Separator + flowName + Separator + taskName
+}
@@ -16,7 +16,9 @@ import (
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
 )
 
-func migrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones []aliapi.Zone) (*infraflow.PersistentState, error) {
+// MigrateTerraformStateToFlowState converts the Terraformer raw state of an infrastructure into a flow state.
+// The zones are needed to map the Terraform resources of the zones to the zone children of the flow state.
+func MigrateTerraformStateToFlowState(rawExtension *runtime.RawExtension, zones []aliapi.Zone) (*infraflow.PersistentState, error) {
 	var (
 		tfRawState *terraformer.RawState
 		tfState    *shared.TerraformState
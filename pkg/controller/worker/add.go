@@ -49,6 +49,10 @@ func AddToManagerWithOptions(ctx context.Context, mgr manager.Manager, opts AddO
 		return err
 	}
 
+	if err := AddIPv6BandwidthControllerToManager(mgr, opts.Controller); err != nil {
+		return err
+	}
+
 	return worker.Add(ctx, mgr, worker.AddArgs{
 		Actuator:               NewActuator(mgr, opts.GardenCluster),
 		ControllerOptions:      opts.Controller,
@@ -0,0 +1,143 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker
+
+import (
+	"context"
+	"fmt"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	machinev1alpha1 "github.com/gardener/machine-controller-manager/pkg/apis/machine/v1alpha1"
+	apierrors "k8s.io/apimachinery/pkg/api/errors"
+	"k8s.io/apimachinery/pkg/types"
+	"sigs.k8s.io/controller-runtime/pkg/builder"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	"sigs.k8s.io/controller-runtime/pkg/controller"
+	"sigs.k8s.io/controller-runtime/pkg/event"
+	"sigs.k8s.io/controller-runtime/pkg/handler"
+	logf "sigs.k8s.io/controller-runtime/pkg/log"
+	"sigs.k8s.io/controller-runtime/pkg/manager"
+	"sigs.k8s.io/controller-runtime/pkg/predicate"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+)
+
+// IPv6BandwidthControllerName is the name of the controller opening the IPv6 internet bandwidth of new nodes.
+const IPv6BandwidthControllerName = "alicloud-ipv6-bandwidth"
+
+// IPv6BandwidthReconciler opens the IPv6 internet bandwidth configured in `dualStack.internetBandwidth` for the
+// nodes of a shoot whenever its machines are created or deleted. The IPv6 addresses of nodes only exist once their
+// instances are created, so they cannot be handled by the reconciliation of the infrastructure alone.
+type IPv6BandwidthReconciler struct {
+	client       client.Client
+	actorFactory aliclient.Factory
+}
+
+// NewIPv6BandwidthReconciler creates a new IPv6BandwidthReconciler.
+func NewIPv6BandwidthReconciler(c client.Client, actorFactory aliclient.Factory) *IPv6BandwidthReconciler {
+	return &IPv6BandwidthReconciler{
+		client:       c,
+		actorFactory: actorFactory,
+	}
+}
+
+// Reconcile reconciles the IPv6 internet bandwidth of the nodes for the given Infrastructure.
+func (r *IPv6BandwidthReconciler) Reconcile(ctx context.Context, request reconcile.Request) (reconcile.Result, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := r.client.Get(ctx, request.NamespacedName, infra); err != nil {
+		if apierrors.IsNotFound(err) {
+			return reconcile.Result{}, nil
+		}
+		return reconcile.Result{}, err
+	}
+
+	if !infra.DeletionTimestamp.IsZero() || infra.Status.State == nil ||
+		infra.Status.LastOperation == nil || infra.Status.LastOperation.State != gardencorev1beta1.LastOperationStateSucceeded {
+		// the infrastructure flow handles all nodes on its next successful run
+		return reconcile.Result{}, nil
+	}
+
+	config, err := helper.InfrastructureConfigFromInfrastructure(infra)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	if config.DualStack == nil || !config.DualStack.Enabled || config.DualStack.InternetBandwidth == nil {
+		return reconcile.Result{}, nil
+	}
+	state, err := infraflow.NewPersistentStateFromJSON(infra.Status.State.Raw)
+	if err != nil {
+		return reconcile.Result{}, fmt.Errorf("unable to decode flow state of infrastructure %q: %w", request.String(), err)
+	}
+	if state == nil {
+		return reconcile.Result{}, nil
+	}
+
+	credentials, err := alicloud.ReadCredentialsFromSecretRef(ctx, r.client, &infra.Spec.SecretRef)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	actor, err := r.actorFactory.NewActor(credentials.AccessKeyID, credentials.AccessKeySecret, infra.Spec.Region)
+	if err != nil {
+		return reconcile.Result{}, err
+	}
+	flowContext := infraflow.NewFlowContextWithActor(logf.FromContext(ctx), actor, infra, config, state.ToFlatMap(), nil, nil)
+	return reconcile.Result{}, flowContext.EnsureIPv6InternetBandwidthOfNodes(ctx)
+}
+
+// mapMachineToInfrastructures maps a machine to the Infrastructures in its namespace.
+func (r *IPv6BandwidthReconciler) mapMachineToInfrastructures(ctx context.Context, obj client.Object) []reconcile.Request {
+	infraList := &extensionsv1alpha1.InfrastructureList{}
+	if err := r.client.List(ctx, infraList, client.InNamespace(obj.GetNamespace())); err != nil {
+		logf.FromContext(ctx).Error(err, "Failed to list infrastructures", "namespace", obj.GetNamespace())
+		return nil
+	}
+	var requests []reconcile.Request
+	for _, infra := range infraList.Items {
+		if infra.Spec.Type == alicloud.Type {
+			requests = append(requests, reconcile.Request{NamespacedName: types.NamespacedName{Namespace: infra.Namespace, Name: infra.Name}})
+		}
+	}
+	return requests
+}
+
+// machineInstanceChanged is a predicate for machines whose instance has been created or deleted.
+func machineInstanceChanged() predicate.Predicate {
+	return predicate.Funcs{
+		CreateFunc: func(event.CreateEvent) bool { return true },
+		UpdateFunc: func(e event.UpdateEvent) bool {
+			oldMachine, ok := e.ObjectOld.(*machinev1alpha1.Machine)
+			if !ok {
+				return false
+			}
+			newMachine, ok := e.ObjectNew.(*machinev1alpha1.Machine)
+			if !ok {
+				return false
+			}
+			return oldMachine.Spec.ProviderID != newMachine.Spec.ProviderID || oldMachine.Status.CurrentStatus.Phase != newMachine.Status.CurrentStatus.Phase
+		},
+		DeleteFunc:  func(event.DeleteEvent) bool { return true },
+		GenericFunc: func(event.GenericEvent) bool { return false },
+	}
+}
+
+// AddIPv6BandwidthControllerToManager adds the controller opening the IPv6 internet bandwidth of new nodes to the
+// given manager.
+func AddIPv6BandwidthControllerToManager(mgr manager.Manager, opts controller.Options) error {
+	r := NewIPv6BandwidthReconciler(mgr.GetClient(), aliclient.FactoryFunc(aliclient.NewActor))
+	return builder.ControllerManagedBy(mgr).
+		Named(IPv6BandwidthControllerName).
+		WithOptions(controller.Options{MaxConcurrentReconciles: opts.MaxConcurrentReconciles}).
+		Watches(
+			&machinev1alpha1.Machine{},
+			handler.EnqueueRequestsFromMapFunc(r.mapMachineToInfrastructures),
+			builder.WithPredicates(machineInstanceChanged()),
+		).
+		Complete(r)
+}
@@ -0,0 +1,169 @@
+// SPDX-FileCopyrightText: SAP SE or an SAP affiliate company and Gardener contributors
+//
+// SPDX-License-Identifier: Apache-2.0
+
+package worker_test
+
+import (
+	"context"
+	"encoding/json"
+
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
+	. "github.com/onsi/ginkgo/v2"
+	. "github.com/onsi/gomega"
+	"go.uber.org/mock/gomock"
+	corev1 "k8s.io/api/core/v1"
+	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/apimachinery/pkg/types"
+	"k8s.io/utils/ptr"
+	"sigs.k8s.io/controller-runtime/pkg/client"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
+	"sigs.k8s.io/controller-runtime/pkg/reconcile"
+
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient"
+	mockaliclient "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/aliclient/mock"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/infrastructure/infraflow/shared"
+	. "github.com/gardener/gardener-extension-provider-alicloud/pkg/controller/worker"
+)
+
+var _ = Describe("IPv6BandwidthReconciler", func() {
+	const (
+		namespace = "shoot--foo--bar"
+		name      = "bar"
+		region    = "cn-beijing"
+	)
+
+	var (
+		ctx          context.Context
+		ctrl         *gomock.Controller
+		actor        *mockaliclient.MockActor
+		actorFactory *mockaliclient.MockFactory
+		fakeClient   client.Client
+		reconciler   *IPv6BandwidthReconciler
+
+		request reconcile.Request
+		infra   *extensionsv1alpha1.Infrastructure
+		config  *v1alpha1.InfrastructureConfig
+		state   shared.FlatMap
+	)
+
+	encode := func(obj any) []byte {
+		data, err := json.Marshal(obj)
+		Expect(err).NotTo(HaveOccurred())
+		return data
+	}
+
+	BeforeEach(func() {
+		ctx = context.TODO()
+		ctrl = gomock.NewController(GinkgoT())
+		actor = mockaliclient.NewMockActor(ctrl)
+		actorFactory = mockaliclient.NewMockFactory(ctrl)
+
+		request = reconcile.Request{NamespacedName: types.NamespacedName{Namespace: namespace, Name: name}}
+		config = &v1alpha1.InfrastructureConfig{
+			TypeMeta: metav1.TypeMeta{APIVersion: v1alpha1.SchemeGroupVersion.String(), Kind: "InfrastructureConfig"},
+			Networks: v1alpha1.Networks{
+				VPC:   v1alpha1.VPC{CIDR: ptr.To("10.0.0.0/16")},
+				Zones: []v1alpha1.Zone{{Name: "cn-beijing-a", Workers: "10.0.0.0/19"}},
+			},
+			DualStack: &v1alpha1.DualStack{
+				Enabled:           true,
+				InternetBandwidth: &v1alpha1.IPv6InternetBandwidth{Bandwidth: 10},
+			},
+		}
+		state = shared.FlatMap{
+			infraflow.IdentifierVPC:                                 "vpc-1",
+			infraflow.IdentifierIPV6Gateway:                         "ipv6gw-1",
+			infraflow.IdentifierIPv6InternetBandwidth:               "ipv6gw-1",
+			"Zones/cn-beijing-a/" + infraflow.IdentifierZoneVSwitch: "vsw-a",
+		}
+	})
+
+	JustBeforeEach(func() {
+		infra = &extensionsv1alpha1.Infrastructure{
+			ObjectMeta: metav1.ObjectMeta{Name: name, Namespace: namespace},
+			Spec: extensionsv1alpha1.InfrastructureSpec{
+				DefaultSpec: extensionsv1alpha1.DefaultSpec{
+					Type:           alicloud.Type,
+					ProviderConfig: &runtime.RawExtension{Raw: encode(config)},
+				},
+				Region:    region,
+				SecretRef: corev1.SecretReference{Name: "cloudprovider", Namespace: namespace},
+			},
+			Status: extensionsv1alpha1.InfrastructureStatus{
+				DefaultStatus: extensionsv1alpha1.DefaultStatus{
+					LastOperation: &gardencorev1beta1.LastOperation{State: gardencorev1beta1.LastOperationStateSucceeded},
+					State:         &runtime.RawExtension{Raw: encode(infraflow.NewPersistentStateFromFlatMap(state))},
+				},
+			},
+		}
+		secret := &corev1.Secret{
+			ObjectMeta: metav1.ObjectMeta{Name: "cloudprovider", Namespace: namespace},
+			Data: map[string][]byte{
+				alicloud.AccessKeyID:     []byte("id"),
+				alicloud.AccessKeySecret: []byte("secret"),
+			},
+		}
+
+		scheme := runtime.NewScheme()
+		Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+		Expect(corev1.AddToScheme(scheme)).To(Succeed())
+		fakeClient = fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(infra, secret).WithStatusSubresource(infra).Build()
+		Expect(fakeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		reconciler = NewIPv6BandwidthReconciler(fakeClient, actorFactory)
+	})
+
+	It("should open the IPv6 internet bandwidth of new nodes", func() {
+		actorFactory.EXPECT().NewActor("id", "secret", region).Return(actor, nil)
+		actor.EXPECT().ListIpv6AddressesByVSwitch(gomock.Any(), "vsw-a").Return([]*aliclient.IPv6Address{{
+			Ipv6AddressId:          "ipv6-1",
+			VSwitchId:              "vsw-a",
+			AssociatedInstanceType: "EcsInstance",
+		}}, nil)
+		actor.EXPECT().ListIpv6EgressOnlyRules(gomock.Any(), "ipv6gw-1").Return(nil, nil)
+		actor.EXPECT().AllocateIpv6InternetBandwidth(gomock.Any(), "ipv6gw-1", "ipv6-1", 10, "PayByTraffic")
+		actor.EXPECT().CreateIpv6EgressOnlyRule(gomock.Any(), "ipv6gw-1", gomock.Any()).
+			Return(&aliclient.IPv6EgressOnlyRule{Ipv6EgressOnlyRuleId: "rule-1"}, nil)
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+	})
+
+	Context("the IPv6 internet bandwidth is not configured", func() {
+		BeforeEach(func() {
+			config.DualStack.InternetBandwidth = nil
+		})
+
+		It("should do nothing", func() {
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	Context("the bandwidth has not been opened by the infrastructure flow yet", func() {
+		BeforeEach(func() {
+			delete(state, infraflow.IdentifierIPv6InternetBandwidth)
+		})
+
+		It("should do nothing", func() {
+			actorFactory.EXPECT().NewActor("id", "secret", region).Return(actor, nil)
+
+			_, err := reconciler.Reconcile(ctx, request)
+			Expect(err).NotTo(HaveOccurred())
+		})
+	})
+
+	It("should do nothing while the infrastructure is reconciled", func() {
+		infra.Status.LastOperation.State = gardencorev1beta1.LastOperationStateProcessing
+		Expect(fakeClient.Status().Update(ctx, infra)).To(Succeed())
+
+		_, err := reconciler.Reconcile(ctx, request)
+		Expect(err).NotTo(HaveOccurred())
+	})
+})
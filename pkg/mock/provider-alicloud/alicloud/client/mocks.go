@@ -78,6 +78,21 @@ func (mr *MockClientFactoryMockRecorder) NewECSClient(region, accessKeyID, acces
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewECSClient", reflect.TypeOf((*MockClientFactory)(nil).NewECSClient), region, accessKeyID, accessKeySecret)
 }
 
+// NewNASClient mocks base method.
+func (m *MockClientFactory) NewNASClient(region, accessKeyID, accessKeySecret string) (client.NAS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewNASClient", region, accessKeyID, accessKeySecret)
+	ret0, _ := ret[0].(client.NAS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewNASClient indicates an expected call of NewNASClient.
+func (mr *MockClientFactoryMockRecorder) NewNASClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewNASClient", reflect.TypeOf((*MockClientFactory)(nil).NewNASClient), region, accessKeyID, accessKeySecret)
+}
+
 // NewNLBClient mocks base method.
 func (m *MockClientFactory) NewNLBClient(region, accessKeyID, accessKeySecret string) (client.NLB, error) {
 	m.ctrl.T.Helper()
@@ -168,6 +183,21 @@ func (mr *MockClientFactoryMockRecorder) NewSLBClient(region, accessKeyID, acces
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLBClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLBClient), region, accessKeyID, accessKeySecret)
 }
 
+// NewSLSClient mocks base method.
+func (m *MockClientFactory) NewSLSClient(region, accessKeyID, accessKeySecret string) (client.SLS, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "NewSLSClient", region, accessKeyID, accessKeySecret)
+	ret0, _ := ret[0].(client.SLS)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// NewSLSClient indicates an expected call of NewSLSClient.
+func (mr *MockClientFactoryMockRecorder) NewSLSClient(region, accessKeyID, accessKeySecret any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "NewSLSClient", reflect.TypeOf((*MockClientFactory)(nil).NewSLSClient), region, accessKeyID, accessKeySecret)
+}
+
 // NewSTSClient mocks base method.
 func (m *MockClientFactory) NewSTSClient(region, accessKeyID, accessKeySecret string) (client.STS, error) {
 	m.ctrl.T.Helper()
@@ -487,6 +517,21 @@ func (mr *MockECSMockRecorder) GetAvailableInstanceType(core, zoneID any) *gomoc
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetAvailableInstanceType", reflect.TypeOf((*MockECS)(nil).GetAvailableInstanceType), core, zoneID)
 }
 
+// GetCloudAssistantStatus mocks base method.
+func (m *MockECS) GetCloudAssistantStatus(instanceID string) (*ecs.InstanceCloudAssistantStatus, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "GetCloudAssistantStatus", instanceID)
+	ret0, _ := ret[0].(*ecs.InstanceCloudAssistantStatus)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// GetCloudAssistantStatus indicates an expected call of GetCloudAssistantStatus.
+func (mr *MockECSMockRecorder) GetCloudAssistantStatus(instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetCloudAssistantStatus", reflect.TypeOf((*MockECS)(nil).GetCloudAssistantStatus), instanceID)
+}
+
 // GetImageInfo mocks base method.
 func (m *MockECS) GetImageInfo(imageID string) (*ecs.DescribeImagesResponse, error) {
 	m.ctrl.T.Helper()
@@ -547,6 +592,35 @@ func (mr *MockECSMockRecorder) GetSecurityGroupWithID(id any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "GetSecurityGroupWithID", reflect.TypeOf((*MockECS)(nil).GetSecurityGroupWithID), id)
 }
 
+// InstallCloudAssistant mocks base method.
+func (m *MockECS) InstallCloudAssistant(instanceID string) error {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "InstallCloudAssistant", instanceID)
+	ret0, _ := ret[0].(error)
+	return ret0
+}
+
+// InstallCloudAssistant indicates an expected call of InstallCloudAssistant.
+func (mr *MockECSMockRecorder) InstallCloudAssistant(instanceID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "InstallCloudAssistant", reflect.TypeOf((*MockECS)(nil).InstallCloudAssistant), instanceID)
+}
+
+// IsInstanceTypeAvailable mocks base method.
+func (m *MockECS) IsInstanceTypeAvailable(instanceTypeID, zoneID string) (bool, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "IsInstanceTypeAvailable", instanceTypeID, zoneID)
+	ret0, _ := ret[0].(bool)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// IsInstanceTypeAvailable indicates an expected call of IsInstanceTypeAvailable.
+func (mr *MockECSMockRecorder) IsInstanceTypeAvailable(instanceTypeID, zoneID any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "IsInstanceTypeAvailable", reflect.TypeOf((*MockECS)(nil).IsInstanceTypeAvailable), instanceTypeID, zoneID)
+}
+
 // ListAllInstanceType mocks base method.
 func (m *MockECS) ListAllInstanceType() (*ecs.DescribeInstanceTypesResponse, error) {
 	m.ctrl.T.Helper()
@@ -839,6 +913,21 @@ func (mr *MockVPCMockRecorder) AllocateEipAddress(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateEipAddress", reflect.TypeOf((*MockVPC)(nil).AllocateEipAddress), request)
 }
 
+// AllocateIpv6InternetBandwidth mocks base method.
+func (m *MockVPC) AllocateIpv6InternetBandwidth(request *vpc.AllocateIpv6InternetBandwidthRequest) (*vpc.AllocateIpv6InternetBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AllocateIpv6InternetBandwidth", request)
+	ret0, _ := ret[0].(*vpc.AllocateIpv6InternetBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AllocateIpv6InternetBandwidth indicates an expected call of AllocateIpv6InternetBandwidth.
+func (mr *MockVPCMockRecorder) AllocateIpv6InternetBandwidth(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AllocateIpv6InternetBandwidth", reflect.TypeOf((*MockVPC)(nil).AllocateIpv6InternetBandwidth), request)
+}
+
 // AssociateEipAddress mocks base method.
 func (m *MockVPC) AssociateEipAddress(request *vpc.AssociateEipAddressRequest) (*vpc.AssociateEipAddressResponse, error) {
 	m.ctrl.T.Helper()
@@ -869,6 +958,51 @@ func (mr *MockVPCMockRecorder) AssociateRouteTable(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateRouteTable", reflect.TypeOf((*MockVPC)(nil).AssociateRouteTable), request)
 }
 
+// AssociateVpcCidrBlock mocks base method.
+func (m *MockVPC) AssociateVpcCidrBlock(request *vpc.AssociateVpcCidrBlockRequest) (*vpc.AssociateVpcCidrBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "AssociateVpcCidrBlock", request)
+	ret0, _ := ret[0].(*vpc.AssociateVpcCidrBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// AssociateVpcCidrBlock indicates an expected call of AssociateVpcCidrBlock.
+func (mr *MockVPCMockRecorder) AssociateVpcCidrBlock(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "AssociateVpcCidrBlock", reflect.TypeOf((*MockVPC)(nil).AssociateVpcCidrBlock), request)
+}
+
+// CreateFlowLog mocks base method.
+func (m *MockVPC) CreateFlowLog(request *vpc.CreateFlowLogRequest) (*vpc.CreateFlowLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateFlowLog", request)
+	ret0, _ := ret[0].(*vpc.CreateFlowLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateFlowLog indicates an expected call of CreateFlowLog.
+func (mr *MockVPCMockRecorder) CreateFlowLog(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateFlowLog", reflect.TypeOf((*MockVPC)(nil).CreateFlowLog), request)
+}
+
+// CreateIpv6EgressOnlyRule mocks base method.
+func (m *MockVPC) CreateIpv6EgressOnlyRule(request *vpc.CreateIpv6EgressOnlyRuleRequest) (*vpc.CreateIpv6EgressOnlyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateIpv6EgressOnlyRule", request)
+	ret0, _ := ret[0].(*vpc.CreateIpv6EgressOnlyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateIpv6EgressOnlyRule indicates an expected call of CreateIpv6EgressOnlyRule.
+func (mr *MockVPCMockRecorder) CreateIpv6EgressOnlyRule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateIpv6EgressOnlyRule", reflect.TypeOf((*MockVPC)(nil).CreateIpv6EgressOnlyRule), request)
+}
+
 // CreateIpv6Gateway mocks base method.
 func (m *MockVPC) CreateIpv6Gateway(request *vpc.CreateIpv6GatewayRequest) (*vpc.CreateIpv6GatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -899,6 +1033,21 @@ func (mr *MockVPCMockRecorder) CreateNatGateway(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatGateway", reflect.TypeOf((*MockVPC)(nil).CreateNatGateway), request)
 }
 
+// CreateNatIp mocks base method.
+func (m *MockVPC) CreateNatIp(request *vpc.CreateNatIpRequest) (*vpc.CreateNatIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "CreateNatIp", request)
+	ret0, _ := ret[0].(*vpc.CreateNatIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// CreateNatIp indicates an expected call of CreateNatIp.
+func (mr *MockVPCMockRecorder) CreateNatIp(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateNatIp", reflect.TypeOf((*MockVPC)(nil).CreateNatIp), request)
+}
+
 // CreateRouteEntry mocks base method.
 func (m *MockVPC) CreateRouteEntry(request *vpc.CreateRouteEntryRequest) (*vpc.CreateRouteEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -974,6 +1123,36 @@ func (mr *MockVPCMockRecorder) CreateVpc(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "CreateVpc", reflect.TypeOf((*MockVPC)(nil).CreateVpc), request)
 }
 
+// DeleteFlowLog mocks base method.
+func (m *MockVPC) DeleteFlowLog(request *vpc.DeleteFlowLogRequest) (*vpc.DeleteFlowLogResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteFlowLog", request)
+	ret0, _ := ret[0].(*vpc.DeleteFlowLogResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteFlowLog indicates an expected call of DeleteFlowLog.
+func (mr *MockVPCMockRecorder) DeleteFlowLog(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteFlowLog", reflect.TypeOf((*MockVPC)(nil).DeleteFlowLog), request)
+}
+
+// DeleteIpv6EgressOnlyRule mocks base method.
+func (m *MockVPC) DeleteIpv6EgressOnlyRule(request *vpc.DeleteIpv6EgressOnlyRuleRequest) (*vpc.DeleteIpv6EgressOnlyRuleResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6EgressOnlyRule", request)
+	ret0, _ := ret[0].(*vpc.DeleteIpv6EgressOnlyRuleResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv6EgressOnlyRule indicates an expected call of DeleteIpv6EgressOnlyRule.
+func (mr *MockVPCMockRecorder) DeleteIpv6EgressOnlyRule(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6EgressOnlyRule", reflect.TypeOf((*MockVPC)(nil).DeleteIpv6EgressOnlyRule), request)
+}
+
 // DeleteIpv6Gateway mocks base method.
 func (m *MockVPC) DeleteIpv6Gateway(request *vpc.DeleteIpv6GatewayRequest) (*vpc.DeleteIpv6GatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -989,6 +1168,21 @@ func (mr *MockVPCMockRecorder) DeleteIpv6Gateway(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6Gateway", reflect.TypeOf((*MockVPC)(nil).DeleteIpv6Gateway), request)
 }
 
+// DeleteIpv6InternetBandwidth mocks base method.
+func (m *MockVPC) DeleteIpv6InternetBandwidth(request *vpc.DeleteIpv6InternetBandwidthRequest) (*vpc.DeleteIpv6InternetBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteIpv6InternetBandwidth", request)
+	ret0, _ := ret[0].(*vpc.DeleteIpv6InternetBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteIpv6InternetBandwidth indicates an expected call of DeleteIpv6InternetBandwidth.
+func (mr *MockVPCMockRecorder) DeleteIpv6InternetBandwidth(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteIpv6InternetBandwidth", reflect.TypeOf((*MockVPC)(nil).DeleteIpv6InternetBandwidth), request)
+}
+
 // DeleteNatGateway mocks base method.
 func (m *MockVPC) DeleteNatGateway(request *vpc.DeleteNatGatewayRequest) (*vpc.DeleteNatGatewayResponse, error) {
 	m.ctrl.T.Helper()
@@ -1004,6 +1198,21 @@ func (mr *MockVPCMockRecorder) DeleteNatGateway(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatGateway", reflect.TypeOf((*MockVPC)(nil).DeleteNatGateway), request)
 }
 
+// DeleteNatIp mocks base method.
+func (m *MockVPC) DeleteNatIp(request *vpc.DeleteNatIpRequest) (*vpc.DeleteNatIpResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeleteNatIp", request)
+	ret0, _ := ret[0].(*vpc.DeleteNatIpResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeleteNatIp indicates an expected call of DeleteNatIp.
+func (mr *MockVPCMockRecorder) DeleteNatIp(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteNatIp", reflect.TypeOf((*MockVPC)(nil).DeleteNatIp), request)
+}
+
 // DeleteRouteEntry mocks base method.
 func (m *MockVPC) DeleteRouteEntry(request *vpc.DeleteRouteEntryRequest) (*vpc.DeleteRouteEntryResponse, error) {
 	m.ctrl.T.Helper()
@@ -1079,6 +1288,21 @@ func (mr *MockVPCMockRecorder) DeleteVpc(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeleteVpc", reflect.TypeOf((*MockVPC)(nil).DeleteVpc), request)
 }
 
+// DeletionProtection mocks base method.
+func (m *MockVPC) DeletionProtection(request *vpc.DeletionProtectionRequest) (*vpc.DeletionProtectionResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DeletionProtection", request)
+	ret0, _ := ret[0].(*vpc.DeletionProtectionResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DeletionProtection indicates an expected call of DeletionProtection.
+func (mr *MockVPCMockRecorder) DeletionProtection(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DeletionProtection", reflect.TypeOf((*MockVPC)(nil).DeletionProtection), request)
+}
+
 // DescribeEipAddresses mocks base method.
 func (m *MockVPC) DescribeEipAddresses(request *vpc.DescribeEipAddressesRequest) (*vpc.DescribeEipAddressesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1094,6 +1318,51 @@ func (mr *MockVPCMockRecorder) DescribeEipAddresses(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeEipAddresses", reflect.TypeOf((*MockVPC)(nil).DescribeEipAddresses), request)
 }
 
+// DescribeFlowLogs mocks base method.
+func (m *MockVPC) DescribeFlowLogs(request *vpc.DescribeFlowLogsRequest) (*vpc.DescribeFlowLogsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeFlowLogs", request)
+	ret0, _ := ret[0].(*vpc.DescribeFlowLogsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeFlowLogs indicates an expected call of DescribeFlowLogs.
+func (mr *MockVPCMockRecorder) DescribeFlowLogs(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeFlowLogs", reflect.TypeOf((*MockVPC)(nil).DescribeFlowLogs), request)
+}
+
+// DescribeIpv6Addresses mocks base method.
+func (m *MockVPC) DescribeIpv6Addresses(request *vpc.DescribeIpv6AddressesRequest) (*vpc.DescribeIpv6AddressesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeIpv6Addresses", request)
+	ret0, _ := ret[0].(*vpc.DescribeIpv6AddressesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeIpv6Addresses indicates an expected call of DescribeIpv6Addresses.
+func (mr *MockVPCMockRecorder) DescribeIpv6Addresses(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeIpv6Addresses", reflect.TypeOf((*MockVPC)(nil).DescribeIpv6Addresses), request)
+}
+
+// DescribeIpv6EgressOnlyRules mocks base method.
+func (m *MockVPC) DescribeIpv6EgressOnlyRules(request *vpc.DescribeIpv6EgressOnlyRulesRequest) (*vpc.DescribeIpv6EgressOnlyRulesResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "DescribeIpv6EgressOnlyRules", request)
+	ret0, _ := ret[0].(*vpc.DescribeIpv6EgressOnlyRulesResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// DescribeIpv6EgressOnlyRules indicates an expected call of DescribeIpv6EgressOnlyRules.
+func (mr *MockVPCMockRecorder) DescribeIpv6EgressOnlyRules(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "DescribeIpv6EgressOnlyRules", reflect.TypeOf((*MockVPC)(nil).DescribeIpv6EgressOnlyRules), request)
+}
+
 // DescribeIpv6Gateways mocks base method.
 func (m *MockVPC) DescribeIpv6Gateways(request *vpc.DescribeIpv6GatewaysRequest) (*vpc.DescribeIpv6GatewaysResponse, error) {
 	m.ctrl.T.Helper()
@@ -1334,6 +1603,21 @@ func (mr *MockVPCMockRecorder) ListEnhanhcedNatGatewayAvailableZones(request any
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListEnhanhcedNatGatewayAvailableZones", reflect.TypeOf((*MockVPC)(nil).ListEnhanhcedNatGatewayAvailableZones), request)
 }
 
+// ListNatIps mocks base method.
+func (m *MockVPC) ListNatIps(request *vpc.ListNatIpsRequest) (*vpc.ListNatIpsResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ListNatIps", request)
+	ret0, _ := ret[0].(*vpc.ListNatIpsResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ListNatIps indicates an expected call of ListNatIps.
+func (mr *MockVPCMockRecorder) ListNatIps(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ListNatIps", reflect.TypeOf((*MockVPC)(nil).ListNatIps), request)
+}
+
 // ListTagResources mocks base method.
 func (m *MockVPC) ListTagResources(request *vpc.ListTagResourcesRequest) (*vpc.ListTagResourcesResponse, error) {
 	m.ctrl.T.Helper()
@@ -1364,6 +1648,21 @@ func (mr *MockVPCMockRecorder) ModifyEipAddressAttribute(request any) *gomock.Ca
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyEipAddressAttribute", reflect.TypeOf((*MockVPC)(nil).ModifyEipAddressAttribute), request)
 }
 
+// ModifyIpv6InternetBandwidth mocks base method.
+func (m *MockVPC) ModifyIpv6InternetBandwidth(request *vpc.ModifyIpv6InternetBandwidthRequest) (*vpc.ModifyIpv6InternetBandwidthResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "ModifyIpv6InternetBandwidth", request)
+	ret0, _ := ret[0].(*vpc.ModifyIpv6InternetBandwidthResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// ModifyIpv6InternetBandwidth indicates an expected call of ModifyIpv6InternetBandwidth.
+func (mr *MockVPCMockRecorder) ModifyIpv6InternetBandwidth(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "ModifyIpv6InternetBandwidth", reflect.TypeOf((*MockVPC)(nil).ModifyIpv6InternetBandwidth), request)
+}
+
 // ModifyVSwitchAttribute mocks base method.
 func (m *MockVPC) ModifyVSwitchAttribute(request *vpc.ModifyVSwitchAttributeRequest) (*vpc.ModifyVSwitchAttributeResponse, error) {
 	m.ctrl.T.Helper()
@@ -1469,6 +1768,21 @@ func (mr *MockVPCMockRecorder) UnassociateRouteTable(request any) *gomock.Call {
 	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateRouteTable", reflect.TypeOf((*MockVPC)(nil).UnassociateRouteTable), request)
 }
 
+// UnassociateVpcCidrBlock mocks base method.
+func (m *MockVPC) UnassociateVpcCidrBlock(request *vpc.UnassociateVpcCidrBlockRequest) (*vpc.UnassociateVpcCidrBlockResponse, error) {
+	m.ctrl.T.Helper()
+	ret := m.ctrl.Call(m, "UnassociateVpcCidrBlock", request)
+	ret0, _ := ret[0].(*vpc.UnassociateVpcCidrBlockResponse)
+	ret1, _ := ret[1].(error)
+	return ret0, ret1
+}
+
+// UnassociateVpcCidrBlock indicates an expected call of UnassociateVpcCidrBlock.
+func (mr *MockVPCMockRecorder) UnassociateVpcCidrBlock(request any) *gomock.Call {
+	mr.mock.ctrl.T.Helper()
+	return mr.mock.ctrl.RecordCallWithMethodType(mr.mock, "UnassociateVpcCidrBlock", reflect.TypeOf((*MockVPC)(nil).UnassociateVpcCidrBlock), request)
+}
+
 // MockOSS is a mock of OSS interface.
 type MockOSS struct {
 	ctrl     *gomock.Controller
@@ -7,6 +7,7 @@ package shoot
 import (
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
 	"github.com/gardener/gardener/extensions/pkg/webhook/shoot"
+	v1beta1constants "github.com/gardener/gardener/pkg/apis/core/v1beta1/constants"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
 	"sigs.k8s.io/controller-runtime/pkg/log"
@@ -15,6 +16,9 @@ import (
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/config"
 )
 
+// ServiceWebhookName is the name of the shoot webhook for the Services outside of the kube-system namespace.
+const ServiceWebhookName = "shoot-service"
+
 var (
 	// DefaultAddOptions are the default AddOptions for AddToManager.
 	DefaultAddOptions = AddOptions{}
@@ -35,7 +39,7 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionsw
 		Types: []extensionswebhook.Type{
 			{Obj: &corev1.Service{}},
 		},
-		Mutator: NewMutator(&opts.Service),
+		Mutator: NewMutator(mgr.GetClient(), &opts.Service),
 		ObjectSelector: &metav1.LabelSelector{
 			MatchLabels: map[string]string{
 				"app":       "nginx-ingress",
@@ -50,3 +54,33 @@ func AddToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionsw
 func AddToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
 	return AddToManagerWithOptions(mgr, DefaultAddOptions)
 }
+
+// AddServiceWebhookToManagerWithOptions creates a webhook for the Services outside of the kube-system namespace of
+// the shoot with the given options and adds it to the manager. It applies the load balancer defaults of the shoot.
+func AddServiceWebhookToManagerWithOptions(mgr manager.Manager, opts AddOptions) (*extensionswebhook.Webhook, error) {
+	logger.Info("Adding service webhook to manager")
+	webhook, err := shoot.New(mgr, shoot.Args{
+		Types: []extensionswebhook.Type{
+			{Obj: &corev1.Service{}},
+		},
+		Mutator: NewServiceMutator(mgr.GetClient(), &opts.Service),
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	// the Services of the addons in the kube-system namespace are handled by the shoot webhook
+	webhook.Name = ServiceWebhookName
+	webhook.Path = ServiceWebhookName
+	webhook.NamespaceSelector = &metav1.LabelSelector{
+		MatchExpressions: []metav1.LabelSelectorRequirement{
+			{Key: v1beta1constants.GardenerPurpose, Operator: metav1.LabelSelectorOpNotIn, Values: []string{metav1.NamespaceSystem}},
+		},
+	}
+	return webhook, nil
+}
+
+// AddServiceWebhookToManager creates a service webhook with the default options and adds it to the manager.
+func AddServiceWebhookToManager(mgr manager.Manager) (*extensionswebhook.Webhook, error) {
+	return AddServiceWebhookToManagerWithOptions(mgr, DefaultAddOptions)
+}
@@ -7,32 +7,58 @@ package shoot
 import (
 	"context"
 	"fmt"
+	"strings"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	extensionswebhook "github.com/gardener/gardener/extensions/pkg/webhook"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	"github.com/go-logr/logr"
 	corev1 "k8s.io/api/core/v1"
 	"sigs.k8s.io/controller-runtime/pkg/client"
 	"sigs.k8s.io/controller-runtime/pkg/log"
 
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/helper"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/config"
 	webhookutils "github.com/gardener/gardener-extension-provider-alicloud/pkg/webhook/utils"
 )
 
 type mutator struct {
 	logger  logr.Logger
+	client  client.Client
 	service *config.Service
+	// addons is true for the Services of the shoot addons, which also get the backend load balancer spec and the
+	// local external traffic policy.
+	addons bool
 }
 
-// NewMutator creates a new Mutator that mutates resources in the shoot cluster.
-func NewMutator(service *config.Service) extensionswebhook.Mutator {
+// NewMutator creates a new Mutator that mutates the Services of the addons in the shoot cluster.
+func NewMutator(c client.Client, service *config.Service) extensionswebhook.Mutator {
 	return &mutator{
 		logger:  log.Log.WithName("shoot-mutator"),
+		client:  c,
 		service: service,
+		addons:  true,
 	}
 }
 
+// NewServiceMutator creates a new Mutator that applies the load balancer defaults to the Services in the shoot
+// cluster.
+func NewServiceMutator(c client.Client, service *config.Service) extensionswebhook.Mutator {
+	return &mutator{
+		logger:  log.Log.WithName("shoot-service-mutator"),
+		client:  c,
+		service: service,
+	}
+}
+
+// WantsClusterObject returns true, as the infrastructure configuration of the shoot is needed to mutate services.
+func (m *mutator) WantsClusterObject() bool {
+	return true
+}
+
 // Mutate mutates resources.
-func (m *mutator) Mutate(_ context.Context, newObject, oldObject client.Object) error {
+func (m *mutator) Mutate(ctx context.Context, newObject, oldObject client.Object) error {
 	svc, ok := newObject.(*corev1.Service)
 	if !ok {
 		return fmt.Errorf("wrong object type %T", newObject)
@@ -52,8 +78,97 @@ func (m *mutator) Mutate(_ context.Context, newObject, oldObject client.Object)
 	}
 
 	extensionswebhook.LogMutation(logger, svc.Kind, svc.Namespace, svc.Name)
-	webhookutils.MutateAnnotation(svc, oldSvc, m.service.BackendLoadBalancerSpec)
-	webhookutils.MutateExternalTrafficPolicy(svc, oldSvc)
+
+	cluster, _ := ctx.Value(extensionswebhook.ClusterObjectContextKey{}).(*extensionscontroller.Cluster)
+	cpConfig, err := helper.ControlPlaneConfigFromCluster(cluster)
+	if err != nil {
+		return err
+	}
+	var loadBalancer *alicloudapi.LoadBalancerConfig
+	if cpConfig != nil {
+		loadBalancer = cpConfig.LoadBalancer
+	}
+	if m.loadBalancerType(cluster, cpConfig) == alicloudapi.LoadBalancerTypeNLB {
+		webhookutils.MutateLoadBalancerClass(svc, oldSvc)
+		if svc.Spec.LoadBalancerClass != nil && *svc.Spec.LoadBalancerClass == webhookutils.NLBLoadBalancerClass {
+			zoneMaps, securityGroupIDs, err := m.nlbNetworks(ctx, cluster)
+			if err != nil {
+				return err
+			}
+			addressType := alicloudapi.LoadBalancerAddressTypeInternet
+			if loadBalancer != nil && loadBalancer.AddressType != nil {
+				addressType = *loadBalancer.AddressType
+			}
+			webhookutils.MutateNLBAnnotations(svc, zoneMaps, securityGroupIDs, addressType)
+		}
+	} else {
+		webhookutils.MutateCLBAnnotations(svc, oldSvc, loadBalancer)
+		if m.addons {
+			loadBalancerSpec := m.service.BackendLoadBalancerSpec
+			if loadBalancer != nil && loadBalancer.Spec != nil {
+				loadBalancerSpec = *loadBalancer.Spec
+			}
+			webhookutils.MutateAnnotation(svc, oldSvc, loadBalancerSpec)
+		}
+	}
+	if m.addons {
+		webhookutils.MutateExternalTrafficPolicy(svc, oldSvc)
+	}
+
+	ipv6Internet, err := hasIPv6InternetBandwidth(ctx)
+	if err != nil {
+		return err
+	}
+	if ipv6Internet {
+		webhookutils.MutateIPv6AddressType(svc)
+	}
 
 	return nil
 }
+
+// loadBalancerType returns the type of the load balancers of the Services of the shoot of the Cluster object.
+func (m *mutator) loadBalancerType(cluster *extensionscontroller.Cluster, cpConfig *alicloudapi.ControlPlaneConfig) alicloudapi.LoadBalancerType {
+	if cluster == nil || cluster.Shoot == nil {
+		return alicloudapi.LoadBalancerTypeCLB
+	}
+	return helper.LoadBalancerType(cpConfig, m.service.LoadBalancerType)
+}
+
+// nlbNetworks returns the zone mappings of the node vswitches and the node security group of the shoot of the Cluster
+// object in the format of the NLB annotations.
+func (m *mutator) nlbNetworks(ctx context.Context, cluster *extensionscontroller.Cluster) (string, string, error) {
+	infra := &extensionsv1alpha1.Infrastructure{}
+	if err := m.client.Get(ctx, client.ObjectKey{Namespace: cluster.ObjectMeta.Name, Name: cluster.Shoot.Name}, infra); err != nil {
+		return "", "", fmt.Errorf("could not get infrastructure of shoot: %w", err)
+	}
+	infraStatus, err := helper.InfrastructureStatusFromRaw(infra.Status.ProviderStatus)
+	if err != nil {
+		return "", "", err
+	}
+
+	var zoneMaps []string
+	for _, vSwitch := range infraStatus.VPC.VSwitches {
+		if vSwitch.Purpose == alicloudapi.PurposeNodes {
+			zoneMaps = append(zoneMaps, vSwitch.Zone+":"+vSwitch.ID)
+		}
+	}
+	securityGroup, err := helper.FindSecurityGroupByPurpose(infraStatus.VPC.SecurityGroups, alicloudapi.PurposeNodes)
+	if err != nil {
+		return "", "", err
+	}
+	return strings.Join(zoneMaps, ","), securityGroup.ID, nil
+}
+
+// hasIPv6InternetBandwidth returns true if the IPv6 internet bandwidth is opened for the shoot of the Cluster object in
+// the context.
+func hasIPv6InternetBandwidth(ctx context.Context) (bool, error) {
+	cluster, ok := ctx.Value(extensionswebhook.ClusterObjectContextKey{}).(*extensionscontroller.Cluster)
+	if !ok {
+		return false, nil
+	}
+	infraConfig, err := helper.InfrastructureConfigFromCluster(cluster)
+	if err != nil {
+		return false, err
+	}
+	return infraConfig != nil && infraConfig.DualStack != nil && infraConfig.DualStack.Enabled && infraConfig.DualStack.InternetBandwidth != nil, nil
+}
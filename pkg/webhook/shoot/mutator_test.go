@@ -6,13 +6,21 @@ package shoot
 
 import (
 	"context"
+	"encoding/json"
 
+	extensionscontroller "github.com/gardener/gardener/extensions/pkg/controller"
 	"github.com/gardener/gardener/extensions/pkg/webhook"
+	gardencorev1beta1 "github.com/gardener/gardener/pkg/apis/core/v1beta1"
+	extensionsv1alpha1 "github.com/gardener/gardener/pkg/apis/extensions/v1alpha1"
 	. "github.com/onsi/ginkgo/v2"
 	. "github.com/onsi/gomega"
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/apimachinery/pkg/runtime"
+	"k8s.io/utils/ptr"
+	fakeclient "sigs.k8s.io/controller-runtime/pkg/client/fake"
 
+	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud/v1alpha1"
 	"github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/config"
 )
 
@@ -24,7 +32,7 @@ var _ = Describe("Mutator", func() {
 	)
 
 	BeforeEach(func() {
-		mutator = NewMutator(serviceConfig)
+		mutator = NewMutator(nil, serviceConfig)
 
 		nginxIngressSvc = &corev1.Service{
 			ObjectMeta: metav1.ObjectMeta{
@@ -57,5 +65,202 @@ var _ = Describe("Mutator", func() {
 			Expect(oldNginxIngressSvc.Spec.ExternalTrafficPolicy).To(Equal(corev1.ServiceExternalTrafficPolicyTypeLocal))
 			Expect(oldNginxIngressSvc.Spec.HealthCheckNodePort).To(Equal(int32(31280)))
 		})
+
+		Context("NLB load balancer type", func() {
+			var (
+				ctx     context.Context
+				svc     *corev1.Service
+				mutator webhook.Mutator
+			)
+
+			BeforeEach(func() {
+				infraStatus, err := json.Marshal(&v1alpha1.InfrastructureStatus{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureStatus",
+					},
+					VPC: v1alpha1.VPCStatus{
+						VSwitches: []v1alpha1.VSwitch{
+							{ID: "vsw-a", Zone: "cn-beijing-a", Purpose: v1alpha1.PurposeNodes},
+							{ID: "vsw-b", Zone: "cn-beijing-b", Purpose: v1alpha1.PurposeNodes},
+						},
+						SecurityGroups: []v1alpha1.SecurityGroup{{ID: "sg-nodes", Purpose: v1alpha1.PurposeNodes}},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+
+				scheme := runtime.NewScheme()
+				Expect(extensionsv1alpha1.AddToScheme(scheme)).To(Succeed())
+				fakeClient := fakeclient.NewClientBuilder().WithScheme(scheme).WithObjects(&extensionsv1alpha1.Infrastructure{
+					ObjectMeta: metav1.ObjectMeta{Name: "bar", Namespace: "shoot--foo--bar"},
+					Status: extensionsv1alpha1.InfrastructureStatus{
+						DefaultStatus: extensionsv1alpha1.DefaultStatus{ProviderStatus: &runtime.RawExtension{Raw: infraStatus}},
+					},
+				}).Build()
+				mutator = NewServiceMutator(fakeClient, &config.Service{LoadBalancerType: "NLB"})
+
+				ctx = context.WithValue(context.TODO(), webhook.ClusterObjectContextKey{}, &extensionscontroller.Cluster{
+					ObjectMeta: metav1.ObjectMeta{Name: "shoot--foo--bar"},
+					Shoot:      &gardencorev1beta1.Shoot{ObjectMeta: metav1.ObjectMeta{Name: "bar"}},
+				})
+
+				svc = &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				}
+			})
+
+			It("should default the load balancer class and the networks of a new Service", func() {
+				Expect(mutator.Mutate(ctx, svc, nil)).To(Succeed())
+
+				Expect(svc.Spec.LoadBalancerClass).To(Equal(ptr.To("alibabacloud.com/nlb")))
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-zone-maps", "cn-beijing-a:vsw-a,cn-beijing-b:vsw-b"))
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-security-group-ids", "sg-nodes"))
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type", "internet"))
+			})
+
+			It("should not overwrite explicit annotations", func() {
+				svc.Annotations = map[string]string{"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type": "intranet"}
+
+				Expect(mutator.Mutate(ctx, svc, nil)).To(Succeed())
+
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type", "intranet"))
+			})
+
+			It("should not change the load balancer of an existing Service", func() {
+				oldSvc := svc.DeepCopy()
+
+				Expect(mutator.Mutate(ctx, svc, oldSvc)).To(Succeed())
+
+				Expect(svc.Spec.LoadBalancerClass).To(BeNil())
+				Expect(svc.Annotations).To(BeEmpty())
+			})
+		})
+
+		Context("CLB defaults of the shoot", func() {
+			var (
+				ctx     context.Context
+				svc     *corev1.Service
+				mutator webhook.Mutator
+			)
+
+			BeforeEach(func() {
+				cpConfig, err := json.Marshal(&v1alpha1.ControlPlaneConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						Kind:       "ControlPlaneConfig",
+					},
+					LoadBalancer: &v1alpha1.LoadBalancerConfig{
+						AddressType: ptr.To(v1alpha1.LoadBalancerAddressTypeIntranet),
+						Bandwidth:   ptr.To[int32](50),
+						PaymentType: ptr.To(v1alpha1.LoadBalancerPaymentTypePayByBandwidth),
+						Spec:        ptr.To("slb.s2.medium"),
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				ctx = context.WithValue(context.TODO(), webhook.ClusterObjectContextKey{}, &extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								ControlPlaneConfig: &runtime.RawExtension{Raw: cpConfig},
+							},
+						},
+					},
+				})
+				mutator = NewServiceMutator(nil, serviceConfig)
+
+				svc = &corev1.Service{
+					ObjectMeta: metav1.ObjectMeta{Name: "app", Namespace: "default"},
+					Spec:       corev1.ServiceSpec{Type: corev1.ServiceTypeLoadBalancer},
+				}
+			})
+
+			It("should apply the defaults to a new Service", func() {
+				Expect(mutator.Mutate(ctx, svc, nil)).To(Succeed())
+
+				Expect(svc.Annotations).To(Equal(map[string]string{
+					"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type": "intranet",
+					"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-bandwidth":    "50",
+					"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-charge-type":  "paybybandwidth",
+					"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec":         "slb.s2.medium",
+				}))
+			})
+
+			It("should not overwrite explicit annotations", func() {
+				svc.Annotations = map[string]string{"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type": "internet"}
+
+				Expect(mutator.Mutate(ctx, svc, nil)).To(Succeed())
+
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type", "internet"))
+				Expect(svc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec", "slb.s2.medium"))
+			})
+
+			It("should not apply the defaults to an existing Service", func() {
+				Expect(mutator.Mutate(ctx, svc, svc.DeepCopy())).To(Succeed())
+
+				Expect(svc.Annotations).To(BeEmpty())
+			})
+
+			It("should prefer the spec of the shoot for the addons", func() {
+				Expect(NewMutator(nil, serviceConfig).Mutate(ctx, nginxIngressSvc, nil)).To(Succeed())
+
+				Expect(nginxIngressSvc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec", "slb.s2.medium"))
+			})
+		})
+
+		Context("IPv6 internet bandwidth", func() {
+			var (
+				ctx    context.Context
+				nlbSvc *corev1.Service
+			)
+
+			BeforeEach(func() {
+				infraConfig, err := json.Marshal(&v1alpha1.InfrastructureConfig{
+					TypeMeta: metav1.TypeMeta{
+						APIVersion: v1alpha1.SchemeGroupVersion.String(),
+						Kind:       "InfrastructureConfig",
+					},
+					DualStack: &v1alpha1.DualStack{
+						Enabled:           true,
+						InternetBandwidth: &v1alpha1.IPv6InternetBandwidth{Bandwidth: 10},
+					},
+				})
+				Expect(err).NotTo(HaveOccurred())
+				ctx = context.WithValue(context.TODO(), webhook.ClusterObjectContextKey{}, &extensionscontroller.Cluster{
+					Shoot: &gardencorev1beta1.Shoot{
+						Spec: gardencorev1beta1.ShootSpec{
+							Provider: gardencorev1beta1.Provider{
+								InfrastructureConfig: &runtime.RawExtension{Raw: infraConfig},
+							},
+						},
+					},
+				})
+
+				nlbSvc = nginxIngressSvc.DeepCopy()
+				nlbSvc.Spec.LoadBalancerClass = ptr.To("alibabacloud.com/nlb")
+				nlbSvc.Spec.IPFamilyPolicy = ptr.To(corev1.IPFamilyPolicyPreferDualStack)
+			})
+
+			It("should default the annotations for public IPv6 addresses of a dual-stack NLB", func() {
+				Expect(mutator.Mutate(ctx, nlbSvc, nil)).To(Succeed())
+
+				Expect(nlbSvc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ip-version", "DualStack"))
+				Expect(nlbSvc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ipv6-address-type", "internet"))
+			})
+
+			It("should not overwrite an explicit IPv6 address type", func() {
+				nlbSvc.Annotations = map[string]string{"service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ipv6-address-type": "intranet"}
+
+				Expect(mutator.Mutate(ctx, nlbSvc, nil)).To(Succeed())
+
+				Expect(nlbSvc.Annotations).To(HaveKeyWithValue("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ipv6-address-type", "intranet"))
+			})
+
+			It("should not set the annotations without IPv6 internet bandwidth", func() {
+				Expect(mutator.Mutate(context.TODO(), nlbSvc, nil)).To(Succeed())
+
+				Expect(nlbSvc.Annotations).NotTo(HaveKey("service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ipv6-address-type"))
+			})
+		})
 	})
 })
@@ -5,12 +5,28 @@
 package utils
 
 import (
+	"strconv"
+	"strings"
+
 	corev1 "k8s.io/api/core/v1"
 	metav1 "k8s.io/apimachinery/pkg/apis/meta/v1"
+	"k8s.io/utils/ptr"
+
+	alicloudapi "github.com/gardener/gardener-extension-provider-alicloud/pkg/apis/alicloud"
 )
 
 const (
-	alicloudLoadBalancerSpecAnnotationKey = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec"
+	alicloudLoadBalancerSpecAnnotationKey            = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-spec"
+	alicloudLoadBalancerIPVersionAnnotationKey       = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ip-version"
+	alicloudLoadBalancerIPv6AddressTypeAnnotationKey = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-ipv6-address-type"
+	alicloudLoadBalancerAddressTypeAnnotationKey     = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-address-type"
+	alicloudLoadBalancerZoneMapsAnnotationKey        = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-zone-maps"
+	alicloudLoadBalancerSecurityGroupsAnnotationKey  = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-security-group-ids"
+	alicloudLoadBalancerBandwidthAnnotationKey       = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-bandwidth"
+	alicloudLoadBalancerChargeTypeAnnotationKey      = "service.beta.kubernetes.io/alibaba-cloud-loadbalancer-charge-type"
+
+	// NLBLoadBalancerClass is the load balancer class of services exposed with an NLB.
+	NLBLoadBalancerClass = "alibabacloud.com/nlb"
 )
 
 // MutateExternalTrafficPolicy mutates ServiceExternalTrafficPolicyType to Local of LoadBalancer type service
@@ -34,3 +50,80 @@ func MutateAnnotation(newObj, _ *corev1.Service, loadBalancerSpec string) {
 		metav1.SetMetaDataAnnotation(&newObj.ObjectMeta, alicloudLoadBalancerSpecAnnotationKey, loadBalancerSpec)
 	}
 }
+
+// MutateIPv6AddressType sets the IP version of a dual-stack LoadBalancer service exposed with an NLB to DualStack and
+// its IPv6 address type to internet, if not specified otherwise. It is only applied if the IPv6 internet bandwidth
+// is opened for the shoot.
+func MutateIPv6AddressType(newObj *corev1.Service) {
+	if newObj.Spec.Type != corev1.ServiceTypeLoadBalancer || newObj.Spec.LoadBalancerClass == nil || *newObj.Spec.LoadBalancerClass != NLBLoadBalancerClass {
+		return
+	}
+	if newObj.Spec.IPFamilyPolicy == nil || *newObj.Spec.IPFamilyPolicy == corev1.IPFamilyPolicySingleStack {
+		return
+	}
+	if _, ok := newObj.Annotations[alicloudLoadBalancerIPVersionAnnotationKey]; !ok {
+		metav1.SetMetaDataAnnotation(&newObj.ObjectMeta, alicloudLoadBalancerIPVersionAnnotationKey, "DualStack")
+	}
+	if _, ok := newObj.Annotations[alicloudLoadBalancerIPv6AddressTypeAnnotationKey]; !ok {
+		metav1.SetMetaDataAnnotation(&newObj.ObjectMeta, alicloudLoadBalancerIPv6AddressTypeAnnotationKey, "internet")
+	}
+}
+
+// MutateLoadBalancerClass exposes a LoadBalancer service without load balancer class with an NLB. As the load
+// balancer class is immutable, it is only set when the service becomes of type LoadBalancer.
+func MutateLoadBalancerClass(newObj, oldObj *corev1.Service) {
+	if newObj.Spec.Type != corev1.ServiceTypeLoadBalancer || newObj.Spec.LoadBalancerClass != nil {
+		return
+	}
+	if oldObj != nil && oldObj.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return
+	}
+	newObj.Spec.LoadBalancerClass = ptr.To(NLBLoadBalancerClass)
+}
+
+// MutateNLBAnnotations sets the zone mappings, the address type and the security groups of a LoadBalancer service
+// exposed with an NLB, if not specified otherwise.
+func MutateNLBAnnotations(newObj *corev1.Service, zoneMaps, securityGroupIDs string, addressType alicloudapi.LoadBalancerAddressType) {
+	if newObj.Spec.Type != corev1.ServiceTypeLoadBalancer || newObj.Spec.LoadBalancerClass == nil || *newObj.Spec.LoadBalancerClass != NLBLoadBalancerClass {
+		return
+	}
+	for key, value := range map[string]string{
+		alicloudLoadBalancerZoneMapsAnnotationKey:       zoneMaps,
+		alicloudLoadBalancerAddressTypeAnnotationKey:    string(addressType),
+		alicloudLoadBalancerSecurityGroupsAnnotationKey: securityGroupIDs,
+	} {
+		if _, ok := newObj.Annotations[key]; !ok && value != "" {
+			metav1.SetMetaDataAnnotation(&newObj.ObjectMeta, key, value)
+		}
+	}
+}
+
+// MutateCLBAnnotations sets the address type, bandwidth, payment type and spec of the given load balancer defaults on
+// a LoadBalancer service exposed with a CLB, if not specified otherwise. As changes of some of them recreate the CLB,
+// they are only set when the service becomes of type LoadBalancer.
+func MutateCLBAnnotations(newObj, oldObj *corev1.Service, defaults *alicloudapi.LoadBalancerConfig) {
+	if defaults == nil || newObj.Spec.Type != corev1.ServiceTypeLoadBalancer || newObj.Spec.LoadBalancerClass != nil {
+		return
+	}
+	if oldObj != nil && oldObj.Spec.Type == corev1.ServiceTypeLoadBalancer {
+		return
+	}
+	annotations := map[string]string{}
+	if defaults.AddressType != nil {
+		annotations[alicloudLoadBalancerAddressTypeAnnotationKey] = string(*defaults.AddressType)
+	}
+	if defaults.Bandwidth != nil {
+		annotations[alicloudLoadBalancerBandwidthAnnotationKey] = strconv.Itoa(int(*defaults.Bandwidth))
+	}
+	if defaults.PaymentType != nil {
+		annotations[alicloudLoadBalancerChargeTypeAnnotationKey] = strings.ToLower(string(*defaults.PaymentType))
+	}
+	if defaults.Spec != nil {
+		annotations[alicloudLoadBalancerSpecAnnotationKey] = *defaults.Spec
+	}
+	for key, value := range annotations {
+		if _, ok := newObj.Annotations[key]; !ok {
+			metav1.SetMetaDataAnnotation(&newObj.ObjectMeta, key, value)
+		}
+	}
+}